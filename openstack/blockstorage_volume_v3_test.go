@@ -1,6 +1,7 @@
 package openstack
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -61,3 +62,14 @@ func TestBlockStorageVolumeV3AttachmentHash(t *testing.T) {
 
 	assert.Equal(t, expectedHashcode, actualHashcode)
 }
+
+func TestBlockStorageVolumeV3DeletionProtection(t *testing.T) {
+	d := resourceBlockStorageVolumeV3().TestResourceData()
+	d.SetId("289da7f8-6440-407c-9fb4-7db01ec49164")
+	d.Set("deletion_protection", true)
+
+	diags := resourceBlockStorageVolumeV3Delete(context.Background(), d, nil)
+
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "Error deleting openstack_blockstorage_volume_v3 289da7f8-6440-407c-9fb4-7db01ec49164: deletion_protection is enabled", diags[0].Summary)
+}
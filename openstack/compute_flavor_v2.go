@@ -1,6 +1,12 @@
 package openstack
 
-import "github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
+import (
+	"sort"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
+	flavorsutils "github.com/gophercloud/utils/openstack/compute/v2/flavors"
+)
 
 func expandComputeFlavorV2ExtraSpecs(raw map[string]interface{}) flavors.ExtraSpecsOpts {
 	extraSpecs := make(flavors.ExtraSpecsOpts, len(raw))
@@ -10,3 +16,102 @@ func expandComputeFlavorV2ExtraSpecs(raw map[string]interface{}) flavors.ExtraSp
 
 	return extraSpecs
 }
+
+// computeFlavorV2MatchExtraSpecs checks, whether all the wanted extra specs
+// are set to the wanted values.
+func computeFlavorV2MatchExtraSpecs(extraSpecs map[string]string, wanted map[string]interface{}) bool {
+	for k, v := range wanted {
+		if value, ok := extraSpecs[k]; !ok || value != v.(string) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// computeFlavorsV2Sort sorts the flavors by the orderBy attribute. Flavors
+// with the same value are sorted by RAM, VCPUs, disk, name and ID.
+func computeFlavorsV2Sort(allFlavors []flavors.Flavor, orderBy string, ascending bool) {
+	compare := func(a, b flavors.Flavor) int {
+		switch {
+		case a.RAM != b.RAM:
+			return a.RAM - b.RAM
+		case a.VCPUs != b.VCPUs:
+			return a.VCPUs - b.VCPUs
+		case a.Disk != b.Disk:
+			return a.Disk - b.Disk
+		case a.Name != b.Name:
+			if a.Name < b.Name {
+				return -1
+			}
+			return 1
+		case a.ID < b.ID:
+			return -1
+		case a.ID > b.ID:
+			return 1
+		}
+		return 0
+	}
+
+	sort.SliceStable(allFlavors, func(i, j int) bool {
+		a, b := allFlavors[i], allFlavors[j]
+
+		var c int
+		switch orderBy {
+		case "vcpus":
+			c = a.VCPUs - b.VCPUs
+		case "disk":
+			c = a.Disk - b.Disk
+		case "name":
+			if a.Name < b.Name {
+				c = -1
+			} else if a.Name > b.Name {
+				c = 1
+			}
+		}
+		if c == 0 {
+			c = compare(a, b)
+		}
+
+		if ascending {
+			return c < 0
+		}
+		return c > 0
+	})
+}
+
+// computeFlavorV2IDFromName resolves the name of a flavor to its ID. The
+// result is cached for the configured provider.
+func computeFlavorV2IDFromName(config *Config, computeClient *gophercloud.ServiceClient, name string) (string, error) {
+	v, err := config.lookupCache.get("flavor-id|"+computeClient.Endpoint+"|"+name, func() (interface{}, error) {
+		return flavorsutils.IDFromName(computeClient, name)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return v.(string), nil
+}
+
+// computeFlavorsV2ListDetail lists the flavors matching the list options. The
+// result is cached for the configured provider, so a copy is returned.
+func computeFlavorsV2ListDetail(config *Config, computeClient *gophercloud.ServiceClient, listOpts flavors.ListOpts) ([]flavors.Flavor, error) {
+	query, err := listOpts.ToFlavorListQuery()
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := config.lookupCache.get("flavors|"+computeClient.Endpoint+"|"+query, func() (interface{}, error) {
+		allPages, err := flavors.ListDetail(computeClient, listOpts).AllPages()
+		if err != nil {
+			return nil, err
+		}
+
+		return flavors.ExtractFlavors(allPages)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]flavors.Flavor(nil), v.([]flavors.Flavor)...), nil
+}
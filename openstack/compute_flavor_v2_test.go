@@ -4,6 +4,8 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
 )
 
@@ -24,3 +26,44 @@ func TestExpandComputeFlavorV2ExtraSpecs(t *testing.T) {
 		t.Fatalf("Results differ. Want: %#v, but got %#v", expected, actual)
 	}
 }
+
+func TestComputeFlavorV2MatchExtraSpecs(t *testing.T) {
+	extraSpecs := map[string]string{
+		"hw:cpu_policy": "dedicated",
+		"hw:numa_nodes": "1",
+	}
+
+	assert.True(t, computeFlavorV2MatchExtraSpecs(extraSpecs, nil))
+	assert.True(t, computeFlavorV2MatchExtraSpecs(extraSpecs, map[string]interface{}{"hw:cpu_policy": "dedicated"}))
+	assert.False(t, computeFlavorV2MatchExtraSpecs(extraSpecs, map[string]interface{}{"hw:cpu_policy": "shared"}))
+	assert.False(t, computeFlavorV2MatchExtraSpecs(extraSpecs, map[string]interface{}{"hw:mem_page_size": "large"}))
+}
+
+func TestComputeFlavorsV2Sort(t *testing.T) {
+	allFlavors := []flavors.Flavor{
+		{ID: "1", Name: "m1.large", RAM: 8192, VCPUs: 4, Disk: 80},
+		{ID: "2", Name: "c1.large", RAM: 4096, VCPUs: 8, Disk: 40},
+		{ID: "3", Name: "m1.medium", RAM: 4096, VCPUs: 2, Disk: 40},
+		{ID: "4", Name: "m1.small", RAM: 2048, VCPUs: 1, Disk: 20},
+	}
+
+	ids := func() []string {
+		var ids []string
+		for _, flavor := range allFlavors {
+			ids = append(ids, flavor.ID)
+		}
+		return ids
+	}
+
+	computeFlavorsV2Sort(allFlavors, "ram", true)
+	assert.Equal(t, []string{"4", "3", "2", "1"}, ids())
+
+	computeFlavorsV2Sort(allFlavors, "vcpus", false)
+	assert.Equal(t, []string{"2", "1", "3", "4"}, ids())
+
+	computeFlavorsV2Sort(allFlavors, "name", true)
+	assert.Equal(t, []string{"2", "1", "3", "4"}, ids())
+
+	computeFlavorsV2Sort(allFlavors, "disk", true)
+	assert.Equal(t, []string{"4", "3", "2", "1"}, ids())
+}
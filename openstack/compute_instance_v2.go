@@ -28,8 +28,19 @@ const (
 	computeV2InstanceCreateServerWithTagsMicroversion        = "2.52"
 	computeV2TagsExtensionMicroversion                       = "2.26"
 	computeV2InstanceBlockDeviceVolumeTypeMicroversion       = "2.67"
+	computeV2InstanceRebuildWithUserDataMicroversion         = "2.57"
 )
 
+const (
+	computeV2InstanceUserDataUpdateReplace           = "replace"
+	computeV2InstanceUserDataUpdateRebuild           = "rebuild"
+	computeV2InstanceUserDataUpdateIgnoreAfterCreate = "ignore_after_create"
+)
+
+// computeV2InstanceReadConcurrency limits the number of concurrent lookups
+// issued while reading a single instance.
+const computeV2InstanceReadConcurrency = 4
+
 // InstanceNIC is a structured representation of a Gophercloud servers.Server
 // virtual NIC.
 type InstanceNIC struct {
@@ -77,9 +88,12 @@ type InstanceNetwork struct {
 //
 // So, let's begin the journey.
 func getAllInstanceNetworks(d *schema.ResourceData, meta interface{}) ([]InstanceNetwork, error) {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
 	networks := d.Get("network").([]interface{})
 
 	instanceNetworks := make([]InstanceNetwork, 0, len(networks))
+	var lookups []func() error
 	for _, v := range networks {
 		network := v.(map[string]interface{})
 		networkID := network["uuid"].(string)
@@ -125,24 +139,33 @@ func getAllInstanceNetworks(d *schema.ResourceData, meta interface{}) ([]Instanc
 			queryTerm = portID
 		}
 
-		networkInfo, err := getInstanceNetworkInfo(d, meta, queryType, queryTerm)
-		if err != nil {
-			return nil, err
-		}
-
-		v := InstanceNetwork{
+		instanceNetworks = append(instanceNetworks, InstanceNetwork{
 			Port:          portID,
 			FixedIP:       network["fixed_ip_v4"].(string),
 			AccessNetwork: network["access_network"].(bool),
-		}
-		if networkInfo["uuid"] != nil {
-			v.UUID = networkInfo["uuid"].(string)
-		}
-		if networkInfo["name"] != nil {
-			v.Name = networkInfo["name"].(string)
-		}
+		})
 
-		instanceNetworks = append(instanceNetworks, v)
+		// The lookups are done concurrently once all networks are known.
+		v := &instanceNetworks[len(instanceNetworks)-1]
+		lookups = append(lookups, func() error {
+			networkInfo, err := getInstanceNetworkInfo(config, region, queryType, queryTerm)
+			if err != nil {
+				return err
+			}
+
+			if networkInfo["uuid"] != nil {
+				v.UUID = networkInfo["uuid"].(string)
+			}
+			if networkInfo["name"] != nil {
+				v.Name = networkInfo["name"].(string)
+			}
+
+			return nil
+		})
+	}
+
+	if err := runTasks(lookups, computeV2InstanceReadConcurrency); err != nil {
+		return nil, err
 	}
 
 	log.Printf("[DEBUG] getAllInstanceNetworks: %#v", instanceNetworks)
@@ -157,11 +180,9 @@ func getAllInstanceNetworks(d *schema.ResourceData, meta interface{}) ([]Instanc
 //
 // If OS_NOVA_NETWORK is set, query nova-network even if Neutron is available.
 // This is to be able to explicitly test the nova-network API.
-func getInstanceNetworkInfo(d *schema.ResourceData, meta interface{}, queryType, queryTerm string) (map[string]interface{}, error) {
-	config := meta.(*Config)
-
+func getInstanceNetworkInfo(config *Config, region, queryType, queryTerm string) (map[string]interface{}, error) {
 	if _, ok := os.LookupEnv("OS_NOVA_NETWORK"); !ok {
-		networkClient, err := config.NetworkingV2Client(GetRegion(d, config))
+		networkClient, err := config.NetworkingV2Client(region)
 		if err == nil {
 			networkInfo, err := getInstanceNetworkInfoNeutron(networkClient, queryType, queryTerm)
 			if err != nil {
@@ -174,7 +195,7 @@ func getInstanceNetworkInfo(d *schema.ResourceData, meta interface{}, queryType,
 
 	log.Printf("[DEBUG] Unable to obtain a network client")
 
-	computeClient, err := config.ComputeV2Client(GetRegion(d, config))
+	computeClient, err := config.ComputeV2Client(region)
 	if err != nil {
 		return nil, fmt.Errorf("Error creating OpenStack compute client: %s", err)
 	}
@@ -433,18 +454,7 @@ func expandInstanceNetworks(allInstanceNetworks []InstanceNetwork) []servers.Net
 
 // flattenInstanceNetworks collects instance network information from different
 // sources and aggregates it all together into a map array.
-func flattenInstanceNetworks(d *schema.ResourceData, meta interface{}) ([]map[string]interface{}, error) {
-	config := meta.(*Config)
-	computeClient, err := config.ComputeV2Client(GetRegion(d, config))
-	if err != nil {
-		return nil, fmt.Errorf("Error creating OpenStack compute client: %s", err)
-	}
-
-	server, err := servers.Get(computeClient, d.Id()).Extract()
-	if err != nil {
-		return nil, CheckDeleted(d, err, "server")
-	}
-
+func flattenInstanceNetworks(d *schema.ResourceData, meta interface{}, server *servers.Server) ([]map[string]interface{}, error) {
 	allInstanceAddresses := getInstanceAddresses(server.Addresses)
 	allInstanceNetworks, err := getAllInstanceNetworks(d, meta)
 	if err != nil {
@@ -459,6 +469,10 @@ func flattenInstanceNetworks(d *schema.ResourceData, meta interface{}) ([]map[st
 	// is available. If there isn't, the instance will fail to launch, so
 	// this is a safe assumption at this point.
 	if len(allInstanceNetworks) == 0 {
+		config := meta.(*Config)
+		region := GetRegion(d, config)
+
+		var lookups []func() error
 		for _, instanceAddresses := range allInstanceAddresses {
 			for _, instanceNIC := range instanceAddresses.InstanceNICs {
 				v := map[string]interface{}{
@@ -469,21 +483,29 @@ func flattenInstanceNetworks(d *schema.ResourceData, meta interface{}) ([]map[st
 				}
 
 				// Use the same method as getAllInstanceNetworks to get the network uuid
-				networkInfo, err := getInstanceNetworkInfo(d, meta, "name", instanceAddresses.NetworkName)
-				if err != nil {
-					log.Printf("[WARN] Error getting default network uuid: %s", err)
-				} else {
-					if v["uuid"] != nil {
-						v["uuid"] = networkInfo["uuid"].(string)
+				networkName := instanceAddresses.NetworkName
+				lookups = append(lookups, func() error {
+					networkInfo, err := getInstanceNetworkInfo(config, region, "name", networkName)
+					if err != nil {
+						log.Printf("[WARN] Error getting default network uuid: %s", err)
 					} else {
-						log.Printf("[WARN] Could not get default network uuid")
+						if v["uuid"] != nil {
+							v["uuid"] = networkInfo["uuid"].(string)
+						} else {
+							log.Printf("[WARN] Could not get default network uuid")
+						}
 					}
-				}
+
+					return nil
+				})
 
 				networks = append(networks, v)
 			}
 		}
 
+		// Errors of the lookups are only logged.
+		_ = runTasks(lookups, computeV2InstanceReadConcurrency)
+
 		log.Printf("[DEBUG] flattenInstanceNetworks: %#v", networks)
 		return networks, nil
 	}
@@ -559,10 +581,112 @@ func computeV2InstanceReadTags(d *schema.ResourceData, tags []string) {
 	expandObjectReadTags(d, tags)
 }
 
-func computeV2InstanceUpdateTags(d *schema.ResourceData) []string {
-	return expandObjectUpdateTags(d)
+func computeV2InstanceUpdateTags(d *schema.ResourceData, defaultTags []string) []string {
+	return expandObjectUpdateTags(d, defaultTags)
+}
+
+func computeV2InstanceTags(d *schema.ResourceData, defaultTags []string) []string {
+	return expandObjectCreateTags(d, defaultTags)
 }
 
-func computeV2InstanceTags(d *schema.ResourceData) []string {
-	return expandObjectTags(d)
+// computeInstanceV2ConfiguredPorts returns the IDs of the ports, which were
+// passed to the instance in the network blocks.
+func computeInstanceV2ConfiguredPorts(d *schema.ResourceData) []string {
+	var configuredPorts []string
+	for _, v := range d.Get("network").([]interface{}) {
+		if port := v.(map[string]interface{})["port"].(string); port != "" {
+			configuredPorts = append(configuredPorts, port)
+		}
+	}
+
+	return configuredPorts
+}
+
+// computeInstanceV2AutoCreatedPorts returns the IDs of the ports, which are
+// attached to the instance, but weren't passed to it in the configuration.
+// These are the ports Nova created and deletes along with the instance.
+func computeInstanceV2AutoCreatedPorts(networkingClient *gophercloud.ServiceClient, serverID string, configuredPorts []string) ([]string, error) {
+	allPages, err := ports.List(networkingClient, ports.ListOpts{DeviceID: serverID}).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("Unable to list ports of openstack_compute_instance_v2 %s: %s", serverID, err)
+	}
+
+	allPorts, err := ports.ExtractPorts(allPages)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to retrieve ports of openstack_compute_instance_v2 %s: %s", serverID, err)
+	}
+
+	portIDs := []string{}
+	for _, port := range allPorts {
+		if !strSliceContains(configuredPorts, port.ID) {
+			portIDs = append(portIDs, port.ID)
+		}
+	}
+
+	return portIDs, nil
+}
+
+// computeInstanceV2PreservePorts releases the given ports from the instance
+// by clearing their device_id and device_owner, so that Nova doesn't find
+// and delete them when the instance is deleted.
+func computeInstanceV2PreservePorts(networkingClient *gophercloud.ServiceClient, serverID string, portIDs []string) error {
+	empty := ""
+	updateOpts := ports.UpdateOpts{
+		DeviceID:    &empty,
+		DeviceOwner: &empty,
+	}
+
+	for _, portID := range portIDs {
+		log.Printf("[DEBUG] Preserving port %s of openstack_compute_instance_v2 %s", portID, serverID)
+		if _, err := ports.Update(networkingClient, portID, updateOpts).Extract(); err != nil {
+			return fmt.Errorf("Unable to preserve port %s of openstack_compute_instance_v2 %s: %s", portID, serverID, err)
+		}
+	}
+
+	return nil
+}
+
+// computeInstanceV2UserDataDiffSuppressFunc ignores a user_data change of an
+// existing instance, when user_data_update_strategy is ignore_after_create.
+func computeInstanceV2UserDataDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != "" && d.Get("user_data_update_strategy").(string) == computeV2InstanceUserDataUpdateIgnoreAfterCreate
+}
+
+// computeInstanceV2UserDataCustomizeDiff applies the user_data_update_strategy
+// to a user_data change of an existing instance: the instance is replaced by
+// default or rebuilt during the update.
+func computeInstanceV2UserDataCustomizeDiff(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" || !diff.HasChange("user_data") {
+		return nil
+	}
+
+	switch diff.Get("user_data_update_strategy").(string) {
+	case computeV2InstanceUserDataUpdateRebuild:
+		if computeInstanceV2BootFromVolume(diff.Get("block_device").([]interface{})) {
+			return fmt.Errorf("user_data_update_strategy %q can't be used for the boot-from-volume instance %s, "+
+				"because a rebuild doesn't reimage its root volume", computeV2InstanceUserDataUpdateRebuild, diff.Id())
+		}
+		return nil
+	case computeV2InstanceUserDataUpdateIgnoreAfterCreate:
+		// The change is suppressed by computeInstanceV2UserDataDiffSuppressFunc.
+		return nil
+	}
+
+	return diff.ForceNew("user_data")
+}
+
+// computeInstanceV2BootFromVolume reports whether the block devices boot the
+// instance from a volume.
+func computeInstanceV2BootFromVolume(blockDevices []interface{}) bool {
+	for _, v := range blockDevices {
+		bd, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if bd["boot_index"] == 0 && bd["destination_type"] == "volume" {
+			return true
+		}
+	}
+
+	return false
 }
@@ -0,0 +1,268 @@
+package openstack
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestComputeInstanceV2DeletionProtection(t *testing.T) {
+	d := resourceComputeInstanceV2().TestResourceData()
+	d.SetId("83ec2e3b-4321-422b-8706-a84185f52a0a")
+	d.Set("deletion_protection", true)
+
+	diags := resourceComputeInstanceV2Delete(context.Background(), d, nil)
+
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "Error deleting openstack_compute_instance_v2 83ec2e3b-4321-422b-8706-a84185f52a0a: deletion_protection is enabled", diags[0].Summary)
+}
+
+func TestComputeInstanceV2ReadPortNotFound(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/servers/server-1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"server": {"id": "server-1", "name": "instance_1", "status": "ACTIVE", "flavor": {"id": "flavor-1"}, "image": {"id": "image-1"}, "addresses": {}}}`)
+	})
+	th.Mux.HandleFunc("/flavors/flavor-1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"flavor": {"id": "flavor-1", "name": "m1.small"}}`)
+	})
+	th.Mux.HandleFunc("/images/image-1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"image": {"id": "image-1", "name": "cirros"}}`)
+	})
+	th.Mux.HandleFunc("/servers/server-1/tags", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"tags": []}`)
+	})
+	th.Mux.HandleFunc("/ports", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		if r.URL.Query().Get("id") == "port-2" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprintf(w, `{"ports": [{"id": "%s", "network_id": "network-1"}]}`, r.URL.Query().Get("id"))
+	})
+	th.Mux.HandleFunc("/networks", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"networks": [{"id": "network-1", "name": "network_1"}]}`)
+	})
+
+	config := testAccUnitConfig("compute", "network")
+
+	d := resourceComputeInstanceV2().Data(&terraform.InstanceState{
+		ID: "server-1",
+		Attributes: map[string]string{
+			"name":           "instance_1",
+			"network.#":      "3",
+			"network.0.port": "port-1",
+			"network.1.port": "port-2",
+			"network.2.port": "port-3",
+		},
+	})
+
+	diags := resourceComputeInstanceV2Read(context.Background(), d, config)
+
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "Unable to retrieve networks from the Network API")
+}
+
+func TestComputeInstanceV2ReadNetworkMode(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/servers/server-1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"server": {"id": "server-1", "name": "instance_1", "status": "ACTIVE", "flavor": {"id": "flavor-1"}, "image": {"id": "image-1"}, "addresses": {"auto_allocated_network": [{"version": 4, "addr": "10.0.0.10", "OS-EXT-IPS:type": "fixed", "OS-EXT-IPS-MAC:mac_addr": "fa:16:3e:00:00:01"}]}}}`)
+	})
+	th.Mux.HandleFunc("/flavors/flavor-1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"flavor": {"id": "flavor-1", "name": "m1.small"}}`)
+	})
+	th.Mux.HandleFunc("/images/image-1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"image": {"id": "image-1", "name": "cirros"}}`)
+	})
+	th.Mux.HandleFunc("/servers/server-1/tags", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"tags": []}`)
+	})
+	th.Mux.HandleFunc("/networks", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"networks": [{"id": "network-1", "name": "auto_allocated_network"}]}`)
+	})
+
+	config := testAccUnitConfig("compute", "network")
+
+	for _, networkMode := range []string{"", "auto"} {
+		d := resourceComputeInstanceV2().Data(&terraform.InstanceState{
+			ID: "server-1",
+			Attributes: map[string]string{
+				"name":         "instance_1",
+				"network_mode": networkMode,
+			},
+		})
+
+		diags := resourceComputeInstanceV2Read(context.Background(), d, config)
+
+		assert.False(t, diags.HasError())
+		assert.Equal(t, "10.0.0.10", d.Get("access_ip_v4"))
+		if networkMode == "" {
+			assert.Len(t, d.Get("network"), 1)
+		} else {
+			assert.Empty(t, d.Get("network"))
+		}
+	}
+}
+
+func TestComputeInstanceV2PreservePortsOnDestroy(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var requests []string
+	th.Mux.HandleFunc("/ports", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestFormValues(t, r, map[string]string{"device_id": "server-1"})
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"ports": [{"id": "port-1", "device_id": "server-1"}, {"id": "port-2", "device_id": "server-1"}]}`)
+	})
+	th.Mux.HandleFunc("/ports/port-2", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestJSONRequest(t, r, `{"port": {"device_id": "", "device_owner": ""}}`)
+		requests = append(requests, "preserve port-2")
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"port": {"id": "port-2"}}`)
+	})
+	th.Mux.HandleFunc("/servers/server-1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		requests = append(requests, "delete server-1")
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	config := testAccUnitConfig("compute", "network")
+
+	d := resourceComputeInstanceV2().Data(&terraform.InstanceState{
+		ID: "server-1",
+		Attributes: map[string]string{
+			"preserve_ports_on_destroy": "true",
+			"network.#":                 "2",
+			"network.0.port":            "port-1",
+			"network.1.uuid":            "network-1",
+		},
+	})
+
+	diags := resourceComputeInstanceV2Delete(context.Background(), d, config)
+
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, []string{"preserve port-2", "delete server-1"}, requests)
+}
+
+func TestComputeInstanceV2UserDataUpdateStrategy(t *testing.T) {
+	r := resourceComputeInstanceV2()
+
+	hash := sha1.Sum([]byte("#cloud-config"))
+	state := &terraform.InstanceState{
+		ID: "server-1",
+		Attributes: map[string]string{
+			"name":           "instance_1",
+			"image_id":       "image-1",
+			"user_data":      hex.EncodeToString(hash[:]),
+			"network.#":      "1",
+			"network.0.uuid": "network-1",
+		},
+	}
+
+	testCases := []struct {
+		strategy    string
+		changed     bool
+		requiresNew bool
+	}{
+		{"", true, true},
+		{"replace", true, true},
+		{"rebuild", true, false},
+		{"ignore_after_create", false, false},
+	}
+
+	for _, tc := range testCases {
+		raw := map[string]interface{}{
+			"name":      "instance_1",
+			"image_id":  "image-1",
+			"user_data": "#cloud-config\nhostname: instance_1",
+		}
+		if tc.strategy != "" {
+			raw["user_data_update_strategy"] = tc.strategy
+		}
+
+		diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), nil)
+		assert.NoError(t, err, tc.strategy)
+
+		var attr *terraform.ResourceAttrDiff
+		if diff != nil {
+			attr = diff.Attributes["user_data"]
+		}
+		if !tc.changed {
+			assert.Nil(t, attr, tc.strategy)
+			continue
+		}
+		if assert.NotNil(t, attr, tc.strategy) {
+			assert.Equal(t, tc.requiresNew, attr.RequiresNew, tc.strategy)
+		}
+	}
+
+	raw := map[string]interface{}{
+		"name":                      "instance_1",
+		"user_data":                 "#cloud-config\nhostname: instance_1",
+		"user_data_update_strategy": "rebuild",
+		"block_device": []interface{}{
+			map[string]interface{}{
+				"uuid":             "image-1",
+				"source_type":      "image",
+				"destination_type": "volume",
+				"boot_index":       0,
+				"volume_size":      10,
+			},
+		},
+	}
+	_, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), nil)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "can't be used for the boot-from-volume instance server-1")
+	}
+}
+
+func TestServerRebuildOpts(t *testing.T) {
+	testCases := []struct {
+		userData string
+		expected interface{}
+	}{
+		{"#cloud-config", "I2Nsb3VkLWNvbmZpZw=="},
+		{"I2Nsb3VkLWNvbmZpZw==", "I2Nsb3VkLWNvbmZpZw=="},
+		{"", nil},
+	}
+
+	for _, tc := range testCases {
+		opts := ServerRebuildOpts{
+			RebuildOpts: servers.RebuildOpts{ImageRef: "image-1"},
+			UserData:    []byte(tc.userData),
+		}
+
+		b, err := opts.ToServerRebuildMap()
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"rebuild": map[string]interface{}{
+				"imageRef":  "image-1",
+				"user_data": tc.expected,
+			},
+		}, b)
+	}
+}
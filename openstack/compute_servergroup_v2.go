@@ -1,6 +1,11 @@
 package openstack
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/servergroups"
 )
@@ -23,19 +28,29 @@ func (opts ComputeServerGroupV2CreateOpts) ToServerGroupCreateMap() (map[string]
 	return BuildRequest(opts, "server_group")
 }
 
-func expandComputeServerGroupV2Policies(client *gophercloud.ServiceClient, raw []interface{}) []string {
+// computeServerGroupV2PoliciesMicroversion is the compute API microversion,
+// which introduced the soft-affinity and soft-anti-affinity policies.
+const computeServerGroupV2PoliciesMicroversion = "2.15"
+
+// expandComputeServerGroupV2Policies returns the policies and sets the
+// microversion required by them on the compute client. The microversion of
+// the client is kept for the legacy affinity and anti-affinity policies.
+func expandComputeServerGroupV2Policies(client *gophercloud.ServiceClient, raw []interface{}) ([]string, diag.Diagnostics) {
+	var newPolicies []string
 	policies := make([]string, len(raw))
 	for i, v := range raw {
-		client.Microversion = "2.15"
-
 		policy := v.(string)
 		policies[i] = policy
 
-		// Set microversion for legacy policies to empty to not change behaviour for those policies
-		if policy == antiAffinityPolicy || policy == affinityPolicy {
-			client.Microversion = ""
+		if policy != antiAffinityPolicy && policy != affinityPolicy {
+			newPolicies = append(newPolicies, policy)
 		}
 	}
 
-	return policies
+	if len(newPolicies) == 0 {
+		return policies, nil
+	}
+
+	return policies, computeV2SetMicroversion(client, computeServerGroupV2PoliciesMicroversion,
+		fmt.Sprintf("policies %s", strings.Join(newPolicies, ", ")))
 }
@@ -54,9 +54,11 @@ func TestExpandComputeServerGroupV2PoliciesMicroversions(t *testing.T) {
 	}
 	expectedMicroversion := "2.15"
 
-	actualPolicies := expandComputeServerGroupV2Policies(client, raw)
+	actualPolicies, diags := expandComputeServerGroupV2Policies(client, raw)
 	actualMicroversion := client.Microversion
 
+	assert.False(t, diags.HasError())
+
 	assert.Equal(t, expectedMicroversion, actualMicroversion)
 	assert.Equal(t, expectedPolicies, actualPolicies)
 }
@@ -76,9 +78,26 @@ func TestExpandComputeServerGroupV2PoliciesMicroversionsLegacy(t *testing.T) {
 	}
 	expectedMicroversion := ""
 
-	actualPolicies := expandComputeServerGroupV2Policies(client, raw)
+	actualPolicies, diags := expandComputeServerGroupV2Policies(client, raw)
 	actualMicroversion := client.Microversion
 
+	assert.False(t, diags.HasError())
+
 	assert.Equal(t, expectedMicroversion, actualMicroversion)
 	assert.Equal(t, expectedPolicies, actualPolicies)
 }
+
+func TestExpandComputeServerGroupV2PoliciesMicroversionsOverride(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	client := thclient.ServiceClient()
+	client.Microversion = "2.64"
+
+	_, diags := expandComputeServerGroupV2Policies(client, []interface{}{"affinity"})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "2.64", client.Microversion)
+
+	_, diags = expandComputeServerGroupV2Policies(client, []interface{}{"soft-affinity"})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "2.64", client.Microversion)
+}
@@ -0,0 +1,75 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/compute/apiversions"
+)
+
+// computeV2MaxMicroversions caches the highest microversion supported by
+// each compute endpoint.
+var computeV2MaxMicroversions sync.Map
+
+// computeV2MaxMicroversion returns the highest microversion supported by the
+// compute API, which is read from the v2.1 version document.
+func computeV2MaxMicroversion(computeClient *gophercloud.ServiceClient) (string, error) {
+	if v, ok := computeV2MaxMicroversions.Load(computeClient.Endpoint); ok {
+		return v.(string), nil
+	}
+
+	version, err := apiversions.Get(computeClient, "v2.1").Extract()
+	if err != nil {
+		return "", fmt.Errorf("Unable to query compute API version: %s", err)
+	}
+
+	computeV2MaxMicroversions.Store(computeClient.Endpoint, version.Version)
+
+	return version.Version, nil
+}
+
+// computeV2NegotiateMicroversion returns the requested microversion, or the
+// highest microversion supported by the compute API, if it's older.
+func computeV2NegotiateMicroversion(computeClient *gophercloud.ServiceClient, requested string) string {
+	maxVersion, err := computeV2MaxMicroversion(computeClient)
+	if err != nil || maxVersion == "" {
+		log.Printf("[DEBUG] Using compute microversion %s without negotiation: %v", requested, err)
+		return requested
+	}
+
+	if supported, err := compatibleMicroversion("min", requested, maxVersion); err != nil || supported {
+		return requested
+	}
+
+	return maxVersion
+}
+
+// computeV2SetMicroversion sets the microversion required by a feature on the
+// compute client. A newer microversion set by the compute_api_microversion
+// provider argument is kept. When the compute API doesn't support the required
+// microversion, the highest supported one is used and a warning is returned.
+func computeV2SetMicroversion(computeClient *gophercloud.ServiceClient, required, feature string) diag.Diagnostics {
+	microversion := required
+	if newer, err := compatibleMicroversion("min", required, computeClient.Microversion); err == nil && newer {
+		microversion = computeClient.Microversion
+	}
+
+	microversion = computeV2NegotiateMicroversion(computeClient, microversion)
+	computeClient.Microversion = microversion
+
+	if supported, err := compatibleMicroversion("min", required, microversion); err != nil || supported {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("%s requires compute API microversion %s", feature, required),
+			Detail:   fmt.Sprintf("The compute API supports microversion %s at most, so %s may not be applied.", microversion, feature),
+		},
+	}
+}
@@ -0,0 +1,75 @@
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+func testComputeV2MicroversionClient(t *testing.T, maxVersion string) *gophercloud.ServiceClient {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/v2.1/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"version": {"id": "v2.1", "status": "CURRENT", "version": "%s", "min_version": "2.1"}}`, maxVersion)
+	})
+
+	return &gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{},
+		Endpoint:       server.URL + "/v2.1/",
+	}
+}
+
+func TestUnitComputeV2NegotiateMicroversion(t *testing.T) {
+	client := testComputeV2MicroversionClient(t, "2.60")
+
+	assert.Equal(t, "2.37", computeV2NegotiateMicroversion(client, "2.37"))
+	assert.Equal(t, "2.60", computeV2NegotiateMicroversion(client, "2.60"))
+	assert.Equal(t, "2.60", computeV2NegotiateMicroversion(client, "2.79"))
+}
+
+func TestUnitComputeV2NegotiateMicroversionUnavailable(t *testing.T) {
+	client := &gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{},
+		Endpoint:       "http://127.0.0.1:1/v2.1/",
+	}
+
+	assert.Equal(t, "2.79", computeV2NegotiateMicroversion(client, "2.79"))
+}
+
+func TestUnitComputeV2SetMicroversion(t *testing.T) {
+	client := testComputeV2MicroversionClient(t, "2.60")
+
+	diags := computeV2SetMicroversion(client, "2.52", "tags")
+	assert.Empty(t, diags)
+	assert.Equal(t, "2.52", client.Microversion)
+
+	// An older required microversion keeps the newer one.
+	diags = computeV2SetMicroversion(client, "2.37", "network_mode")
+	assert.Empty(t, diags)
+	assert.Equal(t, "2.52", client.Microversion)
+
+	diags = computeV2SetMicroversion(client, "2.67", "block_device.volume_type")
+	assert.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Equal(t, "block_device.volume_type requires compute API microversion 2.67", diags[0].Summary)
+	assert.Equal(t, "2.60", client.Microversion)
+}
+
+func TestUnitComputeV2SetMicroversionOverride(t *testing.T) {
+	client := testComputeV2MicroversionClient(t, "2.90")
+	client.Microversion = "2.79"
+
+	diags := computeV2SetMicroversion(client, "2.52", "tags")
+	assert.Empty(t, diags)
+	assert.Equal(t, "2.79", client.Microversion)
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/volumeattach"
 )
 
+const computeVolumeAttachV2MultiattachMicroversion = "2.60"
+
 func computeVolumeAttachV2ParseID(id string) (string, string, error) {
 	parts := strings.Split(id, "/")
 	if len(parts) < 2 {
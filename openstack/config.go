@@ -0,0 +1,137 @@
+package openstack
+
+import (
+	"log"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+type serviceClientInitFunc func(region string) (*gophercloud.ServiceClient, error)
+
+// endpointOverride returns the endpoint_overrides entry of the first service
+// key, which has one.
+func (c *Config) endpointOverride(keys ...string) string {
+	for _, key := range keys {
+		if v, ok := c.EndpointOverrides[key]; ok {
+			if endpoint, ok := v.(string); ok && endpoint != "" {
+				return endpoint
+			}
+		}
+	}
+
+	return ""
+}
+
+// commonServiceClientInit builds a service client. When an endpoint override
+// is set for the service, the client is built without a catalog lookup, so
+// services, which are missing from the catalog or advertise unreachable
+// endpoints, can still be used.
+func (c *Config) commonServiceClientInit(newClient serviceClientInitFunc, region, serviceType string, aliases ...string) (*gophercloud.ServiceClient, error) {
+	if err := c.Authenticate(); err != nil {
+		return nil, err
+	}
+
+	endpoint := c.endpointOverride(append([]string{serviceType}, aliases...)...)
+	if endpoint == "" {
+		return newClient(region)
+	}
+
+	log.Printf("[DEBUG] OpenStack Endpoint for %s: %s", serviceType, endpoint)
+
+	return &gophercloud.ServiceClient{
+		ProviderClient: c.OsClient,
+		Endpoint:       endpoint,
+		Type:           serviceType,
+	}, nil
+}
+
+func (c *Config) BlockStorageV1Client(region string) (*gophercloud.ServiceClient, error) {
+	return c.commonServiceClientInit(c.Config.BlockStorageV1Client, region, "volume")
+}
+
+func (c *Config) BlockStorageV2Client(region string) (*gophercloud.ServiceClient, error) {
+	return c.commonServiceClientInit(c.Config.BlockStorageV2Client, region, "volumev2")
+}
+
+func (c *Config) BlockStorageV3Client(region string) (*gophercloud.ServiceClient, error) {
+	return c.commonServiceClientInit(c.Config.BlockStorageV3Client, region, "volumev3")
+}
+
+func (c *Config) ComputeV2Client(region string) (*gophercloud.ServiceClient, error) {
+	client, err := c.commonServiceClientInit(c.Config.ComputeV2Client, region, "compute")
+	if err != nil {
+		return client, err
+	}
+
+	if c.ComputeAPIMicroversion != "" {
+		client.Microversion = computeV2NegotiateMicroversion(client, c.ComputeAPIMicroversion)
+	}
+
+	return client, nil
+}
+
+func (c *Config) ContainerInfraV1Client(region string) (*gophercloud.ServiceClient, error) {
+	if err := c.requireProjectScope("container-infra"); err != nil {
+		return nil, err
+	}
+	return c.commonServiceClientInit(c.Config.ContainerInfraV1Client, region, "container-infra")
+}
+
+func (c *Config) DatabaseV1Client(region string) (*gophercloud.ServiceClient, error) {
+	if err := c.requireProjectScope("database"); err != nil {
+		return nil, err
+	}
+	return c.commonServiceClientInit(c.Config.DatabaseV1Client, region, "database")
+}
+
+func (c *Config) DNSV2Client(region string) (*gophercloud.ServiceClient, error) {
+	return c.commonServiceClientInit(c.Config.DNSV2Client, region, "dns")
+}
+
+func (c *Config) IdentityV3Client(region string) (*gophercloud.ServiceClient, error) {
+	return c.commonServiceClientInit(c.Config.IdentityV3Client, region, "identity")
+}
+
+func (c *Config) ImageV2Client(region string) (*gophercloud.ServiceClient, error) {
+	return c.commonServiceClientInit(c.Config.ImageV2Client, region, "image")
+}
+
+func (c *Config) KeyManagerV1Client(region string) (*gophercloud.ServiceClient, error) {
+	if err := c.requireProjectScope("key-manager"); err != nil {
+		return nil, err
+	}
+	return c.commonServiceClientInit(c.Config.KeyManagerV1Client, region, "key-manager")
+}
+
+func (c *Config) LoadBalancerV2Client(region string) (*gophercloud.ServiceClient, error) {
+	return c.commonServiceClientInit(c.Config.LoadBalancerV2Client, region, "load-balancer", "octavia")
+}
+
+func (c *Config) NetworkingV2Client(region string) (*gophercloud.ServiceClient, error) {
+	return c.commonServiceClientInit(c.Config.NetworkingV2Client, region, "network")
+}
+
+func (c *Config) ObjectStorageV1Client(region string) (*gophercloud.ServiceClient, error) {
+	if err := c.requireProjectScope("object-store"); err != nil {
+		return nil, err
+	}
+
+	// The Swauth client authenticates on its own, so the override is
+	// applied after the Swauth endpoint has been retrieved.
+	if c.Swauth {
+		return c.Config.ObjectStorageV1Client(region)
+	}
+
+	return c.commonServiceClientInit(c.Config.ObjectStorageV1Client, region, "object-store")
+}
+
+func (c *Config) OrchestrationV1Client(region string) (*gophercloud.ServiceClient, error) {
+	if err := c.requireProjectScope("orchestration"); err != nil {
+		return nil, err
+	}
+	return c.commonServiceClientInit(c.Config.OrchestrationV1Client, region, "orchestration")
+}
+
+func (c *Config) SharedfilesystemV2Client(region string) (*gophercloud.ServiceClient, error) {
+	return c.commonServiceClientInit(c.Config.SharedfilesystemV2Client, region, "sharev2")
+}
@@ -0,0 +1,84 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/utils/terraform/auth"
+)
+
+func TestUnitConfigEndpointOverrides(t *testing.T) {
+	config := Config{
+		Config: auth.Config{
+			EndpointOverrides: map[string]interface{}{
+				"compute":         "https://compute.example.com/v2.1/",
+				"container-infra": "https://magnum.example.com/v1/",
+				"database":        "https://trove.example.com/v1.0/",
+				"dns":             "https://designate.example.com/v2/",
+				"identity":        "https://keystone.example.com/v3/",
+				"image":           "https://glance.example.com/v2/",
+				"key-manager":     "https://barbican.example.com/v1/",
+				"network":         "https://neutron.example.com/v2.0/",
+				"object-store":    "https://swift.example.com/v1/AUTH_project/",
+				"octavia":         "https://octavia.example.com/v2.0/",
+				"orchestration":   "https://heat.example.com/v1/project/",
+				"sharev2":         "https://manila.example.com/v2/",
+				"volume":          "https://cinder.example.com/v1/",
+				"volumev2":        "https://cinder.example.com/v2/",
+				"volumev3":        "https://cinder.example.com/v3/",
+			},
+			// The provider client has no endpoint locator, so any catalog
+			// lookup would fail.
+			OsClient: &gophercloud.ProviderClient{},
+		},
+	}
+
+	testCases := []struct {
+		newClient   serviceClientInitFunc
+		serviceType string
+		endpoint    string
+	}{
+		{config.BlockStorageV1Client, "volume", "https://cinder.example.com/v1/"},
+		{config.BlockStorageV2Client, "volumev2", "https://cinder.example.com/v2/"},
+		{config.BlockStorageV3Client, "volumev3", "https://cinder.example.com/v3/"},
+		{config.ComputeV2Client, "compute", "https://compute.example.com/v2.1/"},
+		{config.ContainerInfraV1Client, "container-infra", "https://magnum.example.com/v1/"},
+		{config.DatabaseV1Client, "database", "https://trove.example.com/v1.0/"},
+		{config.DNSV2Client, "dns", "https://designate.example.com/v2/"},
+		{config.IdentityV3Client, "identity", "https://keystone.example.com/v3/"},
+		{config.ImageV2Client, "image", "https://glance.example.com/v2/"},
+		{config.KeyManagerV1Client, "key-manager", "https://barbican.example.com/v1/"},
+		{config.LoadBalancerV2Client, "load-balancer", "https://octavia.example.com/v2.0/"},
+		{config.NetworkingV2Client, "network", "https://neutron.example.com/v2.0/"},
+		{config.ObjectStorageV1Client, "object-store", "https://swift.example.com/v1/AUTH_project/"},
+		{config.OrchestrationV1Client, "orchestration", "https://heat.example.com/v1/project/"},
+		{config.SharedfilesystemV2Client, "sharev2", "https://manila.example.com/v2/"},
+	}
+
+	for _, tc := range testCases {
+		client, err := tc.newClient("RegionOne")
+		assert.NoError(t, err)
+		assert.Equal(t, tc.serviceType, client.Type)
+		assert.Equal(t, tc.endpoint, client.Endpoint)
+		assert.Equal(t, tc.endpoint, client.ResourceBaseURL())
+		assert.Equal(t, config.OsClient, client.ProviderClient)
+	}
+}
+
+func TestUnitConfigEndpointOverride(t *testing.T) {
+	config := Config{
+		Config: auth.Config{
+			EndpointOverrides: map[string]interface{}{
+				"load-balancer": "https://octavia.example.com/v2.0/",
+				"octavia":       "https://lb.example.com/v2.0/",
+				"network":       "",
+			},
+		},
+	}
+
+	assert.Equal(t, "https://octavia.example.com/v2.0/", config.endpointOverride("load-balancer", "octavia"))
+	assert.Equal(t, "", config.endpointOverride("network"))
+	assert.Equal(t, "", config.endpointOverride("compute"))
+}
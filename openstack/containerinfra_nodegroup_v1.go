@@ -0,0 +1,85 @@
+package openstack
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/containerinfra/v1/nodegroups"
+)
+
+// containerInfraNodeGroupV1CreateOpts represents the attributes used when
+// creating a new node group.
+type containerInfraNodeGroupV1CreateOpts struct {
+	nodegroups.CreateOpts
+	MergeLabels *bool `json:"merge_labels,omitempty"`
+}
+
+// ToNodeGroupCreateMap casts a CreateOpts struct to a map.
+// It overrides nodegroups.ToNodeGroupCreateMap to add the MergeLabels field.
+func (opts containerInfraNodeGroupV1CreateOpts) ToNodeGroupCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+func containerInfraNodeGroupV1ParseID(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) != 2 {
+		return "", "", fmt.Errorf("Unable to determine openstack_containerinfra_nodegroup_v1 ID from raw ID: %s", id)
+	}
+
+	clusterID := idParts[0]
+	nodeGroupID := idParts[1]
+
+	return clusterID, nodeGroupID, nil
+}
+
+// containerInfraNodeGroupV1Labels returns the labels of a node group. When the
+// labels are merged with the cluster labels, only the labels declared in the
+// configuration are returned.
+func containerInfraNodeGroupV1Labels(d *schema.ResourceData, labels map[string]string) map[string]string {
+	if !d.Get("merge_labels").(bool) {
+		return labels
+	}
+
+	declared := d.Get("labels").(map[string]interface{})
+	res := make(map[string]string, len(declared))
+	for k := range declared {
+		if v, ok := labels[k]; ok {
+			res[k] = v
+		}
+	}
+
+	return res
+}
+
+// containerInfraNodeGroupV1StateRefreshFunc returns a resource.StateRefreshFunc
+// that is used to watch a container infra node group.
+func containerInfraNodeGroupV1StateRefreshFunc(client *gophercloud.ServiceClient, clusterID, nodeGroupID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		ng, err := nodegroups.Get(client, clusterID, nodeGroupID).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return ng, "DELETE_COMPLETE", nil
+			}
+			return nil, "", err
+		}
+
+		errorStatuses := []string{
+			"CREATE_FAILED",
+			"UPDATE_FAILED",
+			"DELETE_FAILED",
+			"ROLLBACK_FAILED",
+		}
+		for _, errorStatus := range errorStatuses {
+			if ng.Status == errorStatus {
+				err = fmt.Errorf("openstack_containerinfra_nodegroup_v1 is in an error state: %s", ng.StatusReason)
+				return ng, ng.Status, err
+			}
+		}
+
+		return ng, ng.Status, nil
+	}
+}
@@ -0,0 +1,38 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainerInfraNodeGroupV1ParseID(t *testing.T) {
+	clusterID, nodeGroupID, err := containerInfraNodeGroupV1ParseID("cluster-1/nodegroup-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "cluster-1", clusterID)
+	assert.Equal(t, "nodegroup-1", nodeGroupID)
+
+	_, _, err = containerInfraNodeGroupV1ParseID("nodegroup-1")
+	assert.Error(t, err)
+}
+
+func TestContainerInfraNodeGroupV1Labels(t *testing.T) {
+	labels := map[string]string{
+		"kubescheduler_options": "log-flush-frequency=2m",
+		"kube_tag":              "v1.21.1",
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceContainerInfraNodeGroupV1().Schema, map[string]interface{}{
+		"labels": map[string]interface{}{
+			"kubescheduler_options": "log-flush-frequency=2m",
+		},
+	})
+	assert.Equal(t, labels, containerInfraNodeGroupV1Labels(d, labels))
+
+	d.Set("merge_labels", true)
+	expected := map[string]string{
+		"kubescheduler_options": "log-flush-frequency=2m",
+	}
+	assert.Equal(t, expected, containerInfraNodeGroupV1Labels(d, labels))
+}
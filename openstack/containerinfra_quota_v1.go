@@ -0,0 +1,66 @@
+package openstack
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/containerinfra/v1/quotas"
+)
+
+// containerInfraQuotaV1UpdateOpts represents the attributes used when
+// updating a quota.
+type containerInfraQuotaV1UpdateOpts struct {
+	ProjectID string `json:"project_id"`
+	Resource  string `json:"resource"`
+	HardLimit int    `json:"hard_limit"`
+}
+
+type containerInfraQuotaV1Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets a containerInfraQuotaV1Result as a quota.
+func (r containerInfraQuotaV1Result) Extract() (*quotas.Quotas, error) {
+	var s *quotas.Quotas
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+func containerInfraQuotaV1Get(client *gophercloud.ServiceClient, projectID, resource string) (r containerInfraQuotaV1Result) {
+	resp, err := client.Get(client.ServiceURL("quotas", projectID, resource), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func containerInfraQuotaV1Update(client *gophercloud.ServiceClient, opts containerInfraQuotaV1UpdateOpts) (r containerInfraQuotaV1Result) {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Patch(client.ServiceURL("quotas", opts.ProjectID, opts.Resource), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// containerInfraQuotaV1Delete resets the quota to the default value.
+func containerInfraQuotaV1Delete(client *gophercloud.ServiceClient, projectID, resource string) (r gophercloud.ErrResult) {
+	resp, err := client.Delete(client.ServiceURL("quotas", projectID, resource), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func containerInfraQuotaV1ParseID(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) != 2 {
+		return "", "", fmt.Errorf("Unable to determine openstack_containerinfra_quota_v1 ID from raw ID: %s", id)
+	}
+
+	projectID := idParts[0]
+	resource := idParts[1]
+
+	return projectID, resource, nil
+}
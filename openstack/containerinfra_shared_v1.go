@@ -8,9 +8,12 @@ import (
 	"encoding/base64"
 	"encoding/pem"
 	"fmt"
+	"log"
 	"os"
+	"sort"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"gopkg.in/yaml.v2"
@@ -19,6 +22,7 @@ import (
 	"github.com/gophercloud/gophercloud/openstack/containerinfra/v1/certificates"
 	"github.com/gophercloud/gophercloud/openstack/containerinfra/v1/clusters"
 	"github.com/gophercloud/gophercloud/openstack/containerinfra/v1/clustertemplates"
+	"github.com/gophercloud/gophercloud/openstack/containerinfra/v1/nodegroups"
 )
 
 const (
@@ -26,6 +30,12 @@ const (
 	certificateRequestBlockType = "CERTIFICATE REQUEST"
 )
 
+const (
+	containerInfraV1ClusterResizeMicroversion  = "1.7"
+	containerInfraV1ClusterUpgradeMicroversion = "1.8"
+	containerInfraV1NodeGroupMicroversion      = "1.9"
+)
+
 func expandContainerInfraV1LabelsMap(v map[string]interface{}) (map[string]string, error) {
 	m := make(map[string]string)
 	for key, val := range v {
@@ -71,6 +81,225 @@ func containerInfraClusterTemplateV1AppendUpdateOpts(updateOpts []clustertemplat
 	return updateOpts
 }
 
+// containerInfraClusterTemplateV1CreateOpts represents the attributes used
+// when creating a new cluster template.
+type containerInfraClusterTemplateV1CreateOpts struct {
+	clustertemplates.CreateOpts
+	Hidden *bool  `json:"hidden,omitempty"`
+	Tags   string `json:"tags,omitempty"`
+}
+
+// ToClusterCreateMap casts a CreateOpts struct to a map.
+// It overrides clustertemplates.ToClusterCreateMap to add the Hidden and Tags
+// fields.
+func (opts containerInfraClusterTemplateV1CreateOpts) ToClusterCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// containerInfraClusterTemplateV1Ext represents the cluster template fields,
+// which are missing in clustertemplates.ClusterTemplate.
+type containerInfraClusterTemplateV1Ext struct {
+	Hidden bool   `json:"hidden"`
+	Tags   string `json:"tags"`
+}
+
+// containerInfraClusterTemplateV1Get retrieves a cluster template along with
+// its hidden and tags fields.
+func containerInfraClusterTemplateV1Get(client *gophercloud.ServiceClient, id string) (*clustertemplates.ClusterTemplate, *containerInfraClusterTemplateV1Ext, error) {
+	r := clustertemplates.Get(client, id)
+	ct, err := r.Extract()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ext containerInfraClusterTemplateV1Ext
+	if err := r.ExtractInto(&ext); err != nil {
+		return nil, nil, err
+	}
+
+	return ct, &ext, nil
+}
+
+// flattenContainerInfraClusterTemplateV1Tags splits the comma separated tags
+// of a cluster template.
+func flattenContainerInfraClusterTemplateV1Tags(tags string) []string {
+	res := []string{}
+	for _, tag := range strings.Split(tags, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag != "" {
+			res = append(res, tag)
+		}
+	}
+
+	return res
+}
+
+// containerInfraClusterTemplateV1HasTags reports whether all the tags are in
+// the tags of a cluster template.
+func containerInfraClusterTemplateV1HasTags(ctTags []string, tags []string) bool {
+	for _, tag := range tags {
+		if !strSliceContains(ctTags, tag) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// containerInfraClusterTemplateV1KnownLabels contains the labels, which are
+// documented for the Magnum drivers of each COE.
+var containerInfraClusterTemplateV1KnownLabels = map[string][]string{
+	"kubernetes": {
+		"admission_control_list",
+		"auto_healing_controller",
+		"auto_healing_enabled",
+		"auto_scaling_enabled",
+		"autoscaler_tag",
+		"availability_zone",
+		"boot_volume_size",
+		"boot_volume_type",
+		"calico_ipv4pool",
+		"calico_ipv4pool_ipip",
+		"calico_tag",
+		"cert_manager_api",
+		"cgroup_driver",
+		"cinder_csi_enabled",
+		"cinder_csi_plugin_tag",
+		"cloud_provider_enabled",
+		"cloud_provider_tag",
+		"container_infra_prefix",
+		"container_runtime",
+		"containerd_tarball_sha256",
+		"containerd_tarball_url",
+		"containerd_version",
+		"coredns_tag",
+		"csi_attacher_tag",
+		"csi_node_driver_registrar_tag",
+		"csi_provisioner_tag",
+		"csi_resizer_tag",
+		"csi_snapshotter_tag",
+		"dns_cluster_domain",
+		"docker_volume_type",
+		"draino_tag",
+		"etcd_lb_disabled",
+		"etcd_tag",
+		"etcd_volume_size",
+		"etcd_volume_type",
+		"fixed_network_cidr",
+		"flannel_backend",
+		"flannel_cni_tag",
+		"flannel_network_cidr",
+		"flannel_network_subnetlen",
+		"flannel_tag",
+		"grafana_admin_passwd",
+		"grafana_tag",
+		"heapster_enabled",
+		"heat_container_agent_tag",
+		"helm_client_sha256",
+		"helm_client_tag",
+		"helm_client_url",
+		"hyperkube_prefix",
+		"influx_grafana_dashboard_enabled",
+		"ingress_controller",
+		"ingress_controller_role",
+		"k8s_keystone_auth_tag",
+		"keystone_auth_enabled",
+		"kube_dashboard_enabled",
+		"kube_dashboard_version",
+		"kube_tag",
+		"kubeapi_options",
+		"kubecontroller_options",
+		"kubelet_options",
+		"kubeproxy_options",
+		"kubescheduler_options",
+		"magnum_auto_healer_tag",
+		"master_lb_allowed_cidrs",
+		"master_lb_floating_ip_enabled",
+		"max_node_count",
+		"metrics_scraper_tag",
+		"metrics_server_chart_tag",
+		"metrics_server_enabled",
+		"min_node_count",
+		"monitoring_enabled",
+		"monitoring_ingress_enabled",
+		"monitoring_retention_days",
+		"monitoring_retention_size",
+		"monitoring_storage_class_name",
+		"nginx_ingress_controller_chart_tag",
+		"nginx_ingress_controller_tag",
+		"node_problem_detector_tag",
+		"npd_enabled",
+		"octavia_ingress_controller_tag",
+		"octavia_lb_algorithm",
+		"octavia_lb_healthcheck",
+		"octavia_provider",
+		"prometheus_adapter_chart_tag",
+		"prometheus_adapter_configmap",
+		"prometheus_adapter_enabled",
+		"prometheus_monitoring",
+		"prometheus_operator_chart_tag",
+		"prometheus_tag",
+		"selinux_mode",
+		"service_cluster_ip_range",
+		"tiller_enabled",
+		"tiller_namespace",
+		"tiller_tag",
+		"traefik_ingress_controller_tag",
+		"use_podman",
+	},
+	"swarm": {
+		"availability_zone",
+		"container_infra_prefix",
+		"docker_volume_type",
+		"flannel_backend",
+		"flannel_network_cidr",
+		"flannel_network_subnetlen",
+		"rexray_preempt",
+		"swarm_strategy",
+	},
+	"swarm-mode": {
+		"availability_zone",
+		"container_infra_prefix",
+		"docker_volume_type",
+	},
+	"mesos": {
+		"mesos_slave_executor_env_variables",
+		"mesos_slave_image_providers",
+		"mesos_slave_isolation",
+		"mesos_slave_work_dir",
+		"rexray_preempt",
+	},
+}
+
+// containerInfraClusterTemplateV1LabelsWarnings returns a warning for each
+// label, which isn't known for the COE. Drivers can add their own labels,
+// therefore unknown labels are not treated as errors.
+func containerInfraClusterTemplateV1LabelsWarnings(coe string, labels map[string]interface{}) diag.Diagnostics {
+	known, ok := containerInfraClusterTemplateV1KnownLabels[coe]
+	if !ok {
+		return nil
+	}
+
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var diags diag.Diagnostics
+	for _, k := range keys {
+		if !strSliceContains(known, k) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Unknown openstack_containerinfra_clustertemplate_v1 label %q", k),
+				Detail:   fmt.Sprintf("The %q label is not known for the %s COE.", k, coe),
+			})
+		}
+	}
+
+	return diags
+}
+
 // ContainerInfraClusterV1StateRefreshFunc returns a resource.StateRefreshFunc
 // that is used to watch a container infra Cluster.
 func containerInfraClusterV1StateRefreshFunc(client *gophercloud.ServiceClient, clusterID string) resource.StateRefreshFunc {
@@ -101,6 +330,71 @@ func containerInfraClusterV1StateRefreshFunc(client *gophercloud.ServiceClient,
 	}
 }
 
+// containerInfraClusterV1DefaultWorkerNodeGroup returns the default worker
+// node group of a cluster.
+func containerInfraClusterV1DefaultWorkerNodeGroup(client *gophercloud.ServiceClient, clusterID string) (*nodegroups.NodeGroup, error) {
+	allPages, err := nodegroups.List(client, clusterID, nodegroups.ListOpts{Role: "worker"}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	allNodeGroups, err := nodegroups.ExtractNodeGroups(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ng := range allNodeGroups {
+		if ng.IsDefault {
+			// The list doesn't contain the node count limits.
+			return nodegroups.Get(client, clusterID, ng.UUID).Extract()
+		}
+	}
+
+	return nil, fmt.Errorf("Unable to find the default worker node group of openstack_containerinfra_cluster_v1 %s", clusterID)
+}
+
+// containerInfraClusterV1NodeCountCustomizeDiff rejects a cluster resize to
+// zero nodes, unless the default worker node group allows it.
+func containerInfraClusterV1NodeCountCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("node_count") || diff.Get("node_count").(int) != 0 {
+		return nil
+	}
+
+	// The region is always set, when the cluster exists.
+	config := meta.(*Config)
+	containerInfraClient, err := config.ContainerInfraV1Client(diff.Get("region").(string))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack container infra client: %s", err)
+	}
+
+	containerInfraClient.Microversion = containerInfraV1NodeGroupMicroversion
+
+	ng, err := containerInfraClusterV1DefaultWorkerNodeGroup(containerInfraClient, diff.Id())
+	if err != nil {
+		return err
+	}
+
+	if ng.MinNodeCount > 0 {
+		return fmt.Errorf("node_count can't be 0, the min_node_count of the %s node group is %d", ng.Name, ng.MinNodeCount)
+	}
+
+	return nil
+}
+
+// containerInfraClusterV1TemplateCustomizeDiff recreates a cluster, when its
+// cluster template changes, unless the cluster is upgraded in place.
+func containerInfraClusterV1TemplateCustomizeDiff(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" || !diff.HasChange("cluster_template_id") {
+		return nil
+	}
+
+	if diff.Get("upgrade_on_template_change").(bool) {
+		return nil
+	}
+
+	return diff.ForceNew("cluster_template_id")
+}
+
 // containerInfraClusterV1Flavor will determine the flavor for a container infra
 // cluster based on either what was set in the configuration or environment
 // variable.
@@ -170,16 +464,23 @@ type kubernetesConfigUserData struct {
 }
 
 func flattenContainerInfraV1Kubeconfig(d *schema.ResourceData, containerInfraClient *gophercloud.ServiceClient) (map[string]interface{}, error) {
-	clientSert, ok := d.Get("kubeconfig.client_certificate").(string)
-	if ok && clientSert != "" {
-		return d.Get("kubeconfig").(map[string]interface{}), nil
-	}
-
 	certificateAuthority, err := certificates.Get(containerInfraClient, d.Id()).Extract()
 	if err != nil {
 		return nil, fmt.Errorf("Error getting certificate authority: %s", err)
 	}
 
+	// Keep the existing client certificate, unless the cluster CA was rotated
+	// or the API address of the cluster has changed.
+	name := d.Get("name").(string)
+	host := d.Get("api_address").(string)
+	kubeconfig := d.Get("kubeconfig").(map[string]interface{})
+	if clientCert, ok := kubeconfig["client_certificate"].(string); ok && clientCert != "" {
+		if kubeconfig["cluster_ca_certificate"] == certificateAuthority.PEM && kubeconfig["host"] == host {
+			return kubeconfig, nil
+		}
+		log.Printf("[DEBUG] Regenerating the kubeconfig of openstack_containerinfra_cluster_v1 %s", d.Id())
+	}
+
 	clientKey, err := rsa.GenerateKey(rand.Reader, 4096)
 	if err != nil {
 		return nil, fmt.Errorf("Error generating client key: %s", err)
@@ -224,8 +525,6 @@ func flattenContainerInfraV1Kubeconfig(d *schema.ResourceData, containerInfraCli
 		return nil, fmt.Errorf("Error requesting client certificate: %s", err)
 	}
 
-	name := d.Get("name").(string)
-	host := d.Get("api_address").(string)
 	rawKubeconfig, err := renderKubeconfig(name, host, []byte(certificateAuthority.PEM), []byte(clientCertificate.PEM), pemClientKey)
 	if err != nil {
 		return nil, fmt.Errorf("Error rendering kubeconfig: %s", err)
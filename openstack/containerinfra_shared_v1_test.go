@@ -1,11 +1,17 @@
 package openstack
 
 import (
+	"fmt"
+	"net/http"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/gophercloud/gophercloud/openstack/containerinfra/v1/clustertemplates"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
 )
 
 func TestExpandContainerInfraV1LabelsMap(t *testing.T) {
@@ -66,3 +72,106 @@ func TestContainerInfraClusterTemplateV1AppendUpdateOpts(t *testing.T) {
 
 	assert.Equal(t, expectedUpdateOpts, actualUpdateOpts)
 }
+
+func TestContainerInfraClusterV1DefaultWorkerNodeGroup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/clusters/cluster-1/nodegroups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestFormValues(t, r, map[string]string{"role": "worker"})
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"nodegroups": [
+			{"uuid": "nodegroup-1", "name": "workers", "role": "worker", "is_default": false},
+			{"uuid": "nodegroup-2", "name": "default-worker", "role": "worker", "is_default": true}
+		]}`)
+	})
+
+	th.Mux.HandleFunc("/clusters/cluster-1/nodegroups/nodegroup-2", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"uuid": "nodegroup-2", "name": "default-worker", "role": "worker", "is_default": true, "min_node_count": 1}`)
+	})
+
+	ng, err := containerInfraClusterV1DefaultWorkerNodeGroup(thclient.ServiceClient(), "cluster-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "default-worker", ng.Name)
+	assert.Equal(t, 1, ng.MinNodeCount)
+}
+
+func TestFlattenContainerInfraV1Kubeconfig(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	ca := "ca-1"
+	th.Mux.HandleFunc("/certificates/cluster-1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"cluster_uuid": "cluster-1", "pem": "%s"}`, ca)
+	})
+
+	th.Mux.HandleFunc("/certificates", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"cluster_uuid": "cluster-1", "pem": "client-2"}`)
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceContainerInfraClusterV1().Schema, map[string]interface{}{
+		"name":        "cluster_1",
+		"api_address": "https://192.168.0.10:6443",
+	})
+	d.SetId("cluster-1")
+	d.Set("kubeconfig", map[string]interface{}{
+		"raw_config":             "raw-1",
+		"host":                   "https://192.168.0.10:6443",
+		"cluster_ca_certificate": "ca-1",
+		"client_certificate":     "client-1",
+		"client_key":             "key-1",
+	})
+
+	// The existing client certificate is kept.
+	kubeconfig, err := flattenContainerInfraV1Kubeconfig(d, thclient.ServiceClient())
+	assert.NoError(t, err)
+	assert.Equal(t, "client-1", kubeconfig["client_certificate"])
+
+	// A new client certificate is requested, when the cluster CA was rotated.
+	ca = "ca-2"
+	kubeconfig, err = flattenContainerInfraV1Kubeconfig(d, thclient.ServiceClient())
+	assert.NoError(t, err)
+	assert.Equal(t, "ca-2", kubeconfig["cluster_ca_certificate"])
+	assert.Equal(t, "client-2", kubeconfig["client_certificate"])
+	assert.NotEqual(t, "key-1", kubeconfig["client_key"])
+}
+
+func TestFlattenContainerInfraClusterTemplateV1Tags(t *testing.T) {
+	assert.Equal(t, []string{"foo", "bar"}, flattenContainerInfraClusterTemplateV1Tags("foo, bar,"))
+	assert.Equal(t, []string{}, flattenContainerInfraClusterTemplateV1Tags(""))
+}
+
+func TestContainerInfraClusterTemplateV1HasTags(t *testing.T) {
+	ctTags := []string{"foo", "bar"}
+
+	assert.True(t, containerInfraClusterTemplateV1HasTags(ctTags, []string{"foo"}))
+	assert.True(t, containerInfraClusterTemplateV1HasTags(ctTags, []string{"foo", "bar"}))
+	assert.False(t, containerInfraClusterTemplateV1HasTags(ctTags, []string{"foo", "baz"}))
+}
+
+func TestContainerInfraClusterTemplateV1LabelsWarnings(t *testing.T) {
+	labels := map[string]interface{}{
+		"kube_tag":       "v1.21.1",
+		"kube_tga":       "v1.21.1",
+		"cinder_csi":     "true",
+		"flannel_tag":    "v0.14.0",
+		"swarm_strategy": "spread",
+	}
+
+	diags := containerInfraClusterTemplateV1LabelsWarnings("kubernetes", labels)
+	assert.Len(t, diags, 3)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Equal(t, `Unknown openstack_containerinfra_clustertemplate_v1 label "cinder_csi"`, diags[0].Summary)
+	assert.Equal(t, `Unknown openstack_containerinfra_clustertemplate_v1 label "kube_tga"`, diags[1].Summary)
+	assert.Equal(t, `Unknown openstack_containerinfra_clustertemplate_v1 label "swarm_strategy"`, diags[2].Summary)
+
+	assert.Empty(t, containerInfraClusterTemplateV1LabelsWarnings("unknown", labels))
+}
@@ -134,15 +134,10 @@ func dataSourceComputeFlavorV2Read(ctx context.Context, d *schema.ResourceData,
 
 		log.Printf("[DEBUG] openstack_compute_flavor_v2 ListOpts: %#v", listOpts)
 
-		allPages, err := flavors.ListDetail(computeClient, listOpts).AllPages()
+		allFlavors, err = computeFlavorsV2ListDetail(config, computeClient, listOpts)
 		if err != nil {
 			return diag.Errorf("Unable to query OpenStack flavors: %s", err)
 		}
-
-		allFlavors, err = flavors.ExtractFlavors(allPages)
-		if err != nil {
-			return diag.Errorf("Unable to retrieve OpenStack flavors: %s", err)
-		}
 	}
 
 	// Loop through all flavors to find a more specific one.
@@ -0,0 +1,226 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
+	"github.com/gophercloud/utils/terraform/hashcode"
+)
+
+func dataSourceComputeFlavorsV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceComputeFlavorsV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+
+			"min_ram": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"min_vcpus": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"min_disk": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"is_public": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"extra_specs": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"order_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "ram",
+				ValidateFunc: validation.StringInSlice([]string{
+					"ram", "vcpus", "disk", "name",
+				}, false),
+			},
+
+			"ascending": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"flavors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ram": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"vcpus": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"disk": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"swap": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"rx_tx_factor": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"is_public": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"extra_specs": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceComputeFlavorsV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	computeClient, err := config.ComputeV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	accessType := flavors.AllAccess
+	if v, ok := d.GetOkExists("is_public"); ok {
+		if v.(bool) {
+			accessType = flavors.PublicAccess
+		} else {
+			accessType = flavors.PrivateAccess
+		}
+	}
+
+	listOpts := flavors.ListOpts{
+		MinDisk:    d.Get("min_disk").(int),
+		MinRAM:     d.Get("min_ram").(int),
+		AccessType: accessType,
+	}
+
+	log.Printf("[DEBUG] openstack_compute_flavors_v2 ListOpts: %#v", listOpts)
+
+	allPages, err := flavors.ListDetail(computeClient, listOpts).AllPages()
+	if err != nil {
+		return diag.Errorf("Unable to query openstack_compute_flavors_v2: %s", err)
+	}
+
+	allFlavors, err := flavors.ExtractFlavors(allPages)
+	if err != nil {
+		return diag.Errorf("Unable to retrieve openstack_compute_flavors_v2: %s", err)
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+	minVCPUs := d.Get("min_vcpus").(int)
+	wantedSpecs := d.Get("extra_specs").(map[string]interface{})
+
+	var filteredFlavors []flavors.Flavor
+	extraSpecs := make(map[string]map[string]string)
+	for _, flavor := range allFlavors {
+		if nameRegex != nil && !nameRegex.MatchString(flavor.Name) {
+			continue
+		}
+
+		if flavor.VCPUs < minVCPUs {
+			continue
+		}
+
+		es, err := flavors.ListExtraSpecs(computeClient, flavor.ID).Extract()
+		if err != nil {
+			return diag.Errorf("Unable to retrieve extra specs of openstack_compute_flavors_v2 flavor %s: %s", flavor.ID, err)
+		}
+
+		if !computeFlavorV2MatchExtraSpecs(es, wantedSpecs) {
+			continue
+		}
+
+		extraSpecs[flavor.ID] = es
+		filteredFlavors = append(filteredFlavors, flavor)
+	}
+
+	computeFlavorsV2Sort(filteredFlavors, d.Get("order_by").(string), d.Get("ascending").(bool))
+
+	log.Printf("[DEBUG] Retrieved %d flavors in openstack_compute_flavors_v2", len(filteredFlavors))
+
+	flavorIDs := make([]string, len(filteredFlavors))
+	flattened := make([]map[string]interface{}, len(filteredFlavors))
+	for i, flavor := range filteredFlavors {
+		flavorIDs[i] = flavor.ID
+		flattened[i] = map[string]interface{}{
+			"id":           flavor.ID,
+			"name":         flavor.Name,
+			"ram":          flavor.RAM,
+			"vcpus":        flavor.VCPUs,
+			"disk":         flavor.Disk,
+			"swap":         flavor.Swap,
+			"rx_tx_factor": flavor.RxTxFactor,
+			"is_public":    flavor.IsPublic,
+			"extra_specs":  extraSpecs[flavor.ID],
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(strings.Join(flavorIDs, ","))))
+	d.Set("ids", flavorIDs)
+	if err := d.Set("flavors", flattened); err != nil {
+		return diag.Errorf("Unable to set flavors for openstack_compute_flavors_v2: %s", err)
+	}
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
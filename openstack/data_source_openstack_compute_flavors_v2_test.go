@@ -0,0 +1,84 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccComputeV2FlavorsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2FlavorsDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_flavors_v2.flavors_1", "flavors.0.name", "m1.acctest"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_flavors_v2.flavors_1", "flavors.0.ram", "512"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_compute_flavors_v2.flavors_1", "ids.0",
+						"data.openstack_compute_flavors_v2.flavors_1", "flavors.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2FlavorsDataSource_extraSpecs(t *testing.T) {
+	var flavorName = acctest.RandomWithPrefix("tf-acc-flavor")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2FlavorExtraSpecs1(flavorName),
+			},
+			{
+				Config: testAccComputeV2FlavorsDataSourceExtraSpecs(flavorName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_flavors_v2.flavors_1", "flavors.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_flavors_v2.flavors_1", "flavors.0.name", flavorName),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_flavors_v2.flavors_1", "flavors.0.extra_specs.%", "2"),
+				),
+			},
+		},
+	})
+}
+
+const testAccComputeV2FlavorsDataSourceBasic = `
+data "openstack_compute_flavors_v2" "flavors_1" {
+  name_regex = "^m1\\.acctest$"
+  min_ram    = 512
+  min_vcpus  = 1
+  order_by   = "ram"
+}
+`
+
+func testAccComputeV2FlavorsDataSourceExtraSpecs(flavorName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_compute_flavors_v2" "flavors_1" {
+  extra_specs = {
+    "hw:cpu_policy" = "CPU-POLICY"
+  }
+
+  depends_on = ["openstack_compute_flavor_v2.flavor_1"]
+}
+`, testAccComputeV2FlavorExtraSpecs1(flavorName))
+}
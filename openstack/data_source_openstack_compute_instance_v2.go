@@ -148,7 +148,7 @@ func dataSourceComputeInstanceV2Read(ctx context.Context, d *schema.ResourceData
 	d.Set("image_id", server.Image["ID"])
 
 	// Get the instance network and address information
-	networks, err := flattenInstanceNetworks(d, meta)
+	networks, err := flattenInstanceNetworks(d, meta, server)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -228,7 +228,7 @@ func dataSourceComputeInstanceV2Read(ctx context.Context, d *schema.ResourceData
 	}
 
 	// Populate tags.
-	computeClient.Microversion = computeV2TagsExtensionMicroversion
+	computeV2SetMicroversion(computeClient, computeV2TagsExtensionMicroversion, "tags")
 	instanceTags, err := tags.List(computeClient, server.ID).Extract()
 	if err != nil {
 		log.Printf("[DEBUG] Unable to get tags for openstack_compute_instance_v2: %s", err)
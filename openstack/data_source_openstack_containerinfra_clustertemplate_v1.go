@@ -2,12 +2,14 @@ package openstack
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/containerinfra/v1/clustertemplates"
 )
 
@@ -22,8 +24,20 @@ func dataSourceContainerInfraClusterTemplateV1() *schema.Resource {
 			},
 
 			"name": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"name", "tags"},
+			},
+
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"hidden": {
+				Type:     schema.TypeBool,
+				Computed: true,
 			},
 
 			"project_id": {
@@ -187,7 +201,18 @@ func dataSourceContainerInfraClusterTemplateV1Read(ctx context.Context, d *schem
 	}
 
 	name := d.Get("name").(string)
-	ct, err := clustertemplates.Get(containerInfraClient, name).Extract()
+	tags := expandToStringSlice(d.Get("tags").(*schema.Set).List())
+
+	// The cluster template can be retrieved by its name or ID, unless it is
+	// filtered by tags.
+	if len(tags) > 0 {
+		name, err = dataSourceContainerInfraClusterTemplateV1FilterByTags(containerInfraClient, name, tags)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	ct, ext, err := containerInfraClusterTemplateV1Get(containerInfraClient, name)
 	if err != nil {
 		return diag.Errorf("Error getting openstack_containerinfra_clustertemplate_v1 %s: %s", name, err)
 	}
@@ -222,6 +247,7 @@ func dataSourceContainerInfraClusterTemplateV1Read(ctx context.Context, d *schem
 	d.Set("server_type", ct.ServerType)
 	d.Set("tls_disabled", ct.TLSDisabled)
 	d.Set("volume_driver", ct.VolumeDriver)
+	d.Set("hidden", ext.Hidden)
 
 	if err := d.Set("created_at", ct.CreatedAt.Format(time.RFC3339)); err != nil {
 		log.Printf("[DEBUG] Unable to set openstack_containerinfra_clustertemplate_v1 created_at: %s", err)
@@ -234,3 +260,48 @@ func dataSourceContainerInfraClusterTemplateV1Read(ctx context.Context, d *schem
 
 	return nil
 }
+
+// dataSourceContainerInfraClusterTemplateV1FilterByTags returns the ID of the
+// only cluster template, which has all the tags and the name, if it is set.
+func dataSourceContainerInfraClusterTemplateV1FilterByTags(client *gophercloud.ServiceClient, name string, tags []string) (string, error) {
+	allPages, err := clustertemplates.List(client, nil).AllPages()
+	if err != nil {
+		return "", fmt.Errorf("Unable to query openstack_containerinfra_clustertemplate_v1: %s", err)
+	}
+
+	allClusterTemplates, err := clustertemplates.ExtractClusterTemplates(allPages)
+	if err != nil {
+		return "", fmt.Errorf("Unable to retrieve openstack_containerinfra_clustertemplate_v1: %s", err)
+	}
+
+	var ids []string
+	for _, ct := range allClusterTemplates {
+		if name != "" && ct.Name != name && ct.UUID != name {
+			continue
+		}
+
+		// The list doesn't contain the tags of the cluster templates.
+		_, ext, err := containerInfraClusterTemplateV1Get(client, ct.UUID)
+		if err != nil {
+			return "", fmt.Errorf("Error getting openstack_containerinfra_clustertemplate_v1 %s: %s", ct.UUID, err)
+		}
+
+		ctTags := flattenContainerInfraClusterTemplateV1Tags(ext.Tags)
+		if containerInfraClusterTemplateV1HasTags(ctTags, tags) {
+			ids = append(ids, ct.UUID)
+		}
+	}
+
+	if len(ids) < 1 {
+		return "", fmt.Errorf("Your openstack_containerinfra_clustertemplate_v1 query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(ids) > 1 {
+		log.Printf("[DEBUG] Multiple openstack_containerinfra_clustertemplate_v1 results found: %#v", ids)
+		return "", fmt.Errorf("Your query returned more than one result. " +
+			"Please try a more specific search criteria.")
+	}
+
+	return ids[0], nil
+}
@@ -0,0 +1,76 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud/openstack/db/v1/datastores"
+)
+
+func dataSourceDatabaseDatastoreV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDatabaseDatastoreV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// Computed values
+			"default_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDatabaseDatastoreV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	DatabaseV1Client, err := config.DatabaseV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack database client: %s", err)
+	}
+
+	datastore, err := datastores.Get(DatabaseV1Client, d.Get("name").(string)).Extract()
+	if err != nil {
+		return diag.Errorf("Error retrieving openstack_db_datastore_v1: %s", err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_db_datastore_v1 %s: %#v", datastore.ID, datastore)
+
+	d.SetId(datastore.ID)
+	d.Set("name", datastore.Name)
+	d.Set("default_version", datastore.DefaultVersion)
+	d.Set("versions", flattenDatabaseDatastoreV1Versions(datastore.Versions))
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatabaseV1DatastoreDataSource_basic(t *testing.T) {
+	resourceName := "data.openstack_db_datastore_v1.datastore_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDatabase(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseV1DatastoreDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", osDBDatastoreType),
+					resource.TestCheckResourceAttrSet(resourceName, "default_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "versions.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatabaseV1DatastoreDataSourceBasic() string {
+	return fmt.Sprintf(`
+data "openstack_db_datastore_v1" "datastore_1" {
+  name = "%s"
+}
+`, osDBDatastoreType)
+}
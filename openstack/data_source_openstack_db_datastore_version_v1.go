@@ -0,0 +1,111 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud/openstack/db/v1/datastores"
+)
+
+func dataSourceDatabaseDatastoreVersionV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDatabaseDatastoreVersionV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"datastore": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"most_recent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			// Computed values
+			"datastore_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"flavors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceDatabaseDatastoreVersionV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	DatabaseV1Client, err := config.DatabaseV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack database client: %s", err)
+	}
+
+	datastore, err := datastores.Get(DatabaseV1Client, d.Get("datastore").(string)).Extract()
+	if err != nil {
+		return diag.Errorf("Error retrieving openstack_db_datastore_version_v1 datastore: %s", err)
+	}
+
+	name := d.Get("name").(string)
+	var allVersions []datastores.Version
+	for _, version := range datastore.Versions {
+		if name != "" && version.Name != name {
+			continue
+		}
+		allVersions = append(allVersions, version)
+	}
+
+	if len(allVersions) < 1 {
+		return diag.Errorf("Your openstack_db_datastore_version_v1 query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	var version datastores.Version
+	if len(allVersions) > 1 {
+		recent := d.Get("most_recent").(bool)
+
+		if recent {
+			version = dataSourceDatabaseDatastoreV1MostRecentVersion(allVersions)
+		} else {
+			log.Printf("[DEBUG] Multiple openstack_db_datastore_version_v1 results found: %#v", allVersions)
+
+			return diag.Errorf("Your query returned more than one result. Please try a more " +
+				"specific search criteria, or set `most_recent` attribute to true.")
+		}
+	} else {
+		version = allVersions[0]
+	}
+
+	flavors, err := databaseDatastoreV1VersionFlavors(DatabaseV1Client, datastore.ID, version.ID).Extract()
+	if err != nil {
+		return diag.Errorf("Error retrieving openstack_db_datastore_version_v1 %s flavors: %s", version.ID, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_db_datastore_version_v1 %s: %#v", version.ID, version)
+
+	d.SetId(version.ID)
+	d.Set("name", version.Name)
+	d.Set("datastore_id", datastore.ID)
+	d.Set("flavors", flattenDatabaseDatastoreV1Flavors(flavors))
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatabaseV1DatastoreVersionDataSource_basic(t *testing.T) {
+	resourceName := "data.openstack_db_datastore_version_v1.version_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDatabase(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseV1DatastoreVersionDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", osDBDatastoreVersion),
+					resource.TestCheckResourceAttrPair(resourceName, "datastore_id",
+						"data.openstack_db_datastore_v1.datastore_1", "id"),
+				),
+			},
+			{
+				Config: testAccDatabaseV1DatastoreVersionDataSourceMostRecent(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_db_datastore_version_v1.most_recent", "id"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_db_datastore_version_v1.most_recent", "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatabaseV1DatastoreVersionDataSourceBasic() string {
+	return fmt.Sprintf(`
+data "openstack_db_datastore_v1" "datastore_1" {
+  name = "%[1]s"
+}
+
+data "openstack_db_datastore_version_v1" "version_1" {
+  datastore = "${data.openstack_db_datastore_v1.datastore_1.id}"
+  name      = "%[2]s"
+}
+`, osDBDatastoreType, osDBDatastoreVersion)
+}
+
+func testAccDatabaseV1DatastoreVersionDataSourceMostRecent() string {
+	return fmt.Sprintf(`
+data "openstack_db_datastore_version_v1" "most_recent" {
+  datastore   = "%s"
+  most_recent = true
+}
+`, osDBDatastoreType)
+}
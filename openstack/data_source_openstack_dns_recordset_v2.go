@@ -0,0 +1,155 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/recordsets"
+)
+
+func dataSourceDNSRecordSetV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDNSRecordSetV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"all_projects": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"name", "type"},
+			},
+
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"name", "type"},
+			},
+
+			"records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"ttl": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"zone_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDNSRecordSetV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	dnsClient, err := config.DNSV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	if err := dnsClientSetAuthHeader(d, dnsClient); err != nil {
+		return diag.Errorf("Error setting dns client auth headers: %s", err)
+	}
+
+	zoneID := d.Get("zone_id").(string)
+	listOpts := recordsets.ListOpts{
+		Name: d.Get("name").(string),
+		Type: d.Get("type").(string),
+	}
+
+	allPages, err := recordsets.ListByZone(dnsClient, zoneID, listOpts).AllPages()
+	if err != nil {
+		return diag.Errorf("Unable to retrieve openstack_dns_recordset_v2: %s", err)
+	}
+
+	allRecordSets, err := recordsets.ExtractRecordSets(allPages)
+	if err != nil {
+		return diag.Errorf("Unable to extract openstack_dns_recordset_v2: %s", err)
+	}
+
+	if len(allRecordSets) < 1 {
+		return diag.Errorf("Your query returned no openstack_dns_recordset_v2 results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(allRecordSets) > 1 {
+		return diag.Errorf("Your query returned more than one openstack_dns_recordset_v2 result. " +
+			"Please specify the type of the recordset.")
+	}
+
+	rs := allRecordSets[0]
+
+	log.Printf("[DEBUG] Retrieved openstack_dns_recordset_v2 %s: %#v", rs.ID, rs)
+
+	d.SetId(rs.ID)
+	d.Set("name", rs.Name)
+	d.Set("type", rs.Type)
+	d.Set("records", rs.Records)
+	d.Set("ttl", rs.TTL)
+	d.Set("status", rs.Status)
+	d.Set("description", rs.Description)
+	d.Set("zone_name", rs.ZoneName)
+	d.Set("version", rs.Version)
+	d.Set("project_id", rs.ProjectID)
+	d.Set("created_at", rs.CreatedAt.Format(time.RFC3339))
+	d.Set("updated_at", rs.UpdatedAt.Format(time.RFC3339))
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
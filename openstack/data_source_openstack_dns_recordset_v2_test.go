@@ -0,0 +1,75 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOpenStackDNSRecordSetV2DataSource_basic(t *testing.T) {
+	zoneName := randomZoneName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDNS(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpenStackDNSRecordSetV2DataSourceBasic(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_dns_recordset_v2.rs_1", "id",
+						"openstack_dns_recordset_v2.rs_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_dns_recordset_v2.rs_1", "type", "A"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_dns_recordset_v2.rs_1", "ttl", "3000"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_dns_recordset_v2.rs_1", "records.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_dns_recordset_v2.rs_1", "records.0", "10.1.0.0"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_dns_recordset_v2.rs_1", "status"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_dns_recordset_v2.ns_1", "type", "NS"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOpenStackDNSRecordSetV2DataSourceBasic(zoneName string) string {
+	return fmt.Sprintf(`
+resource "openstack_dns_zone_v2" "zone_1" {
+  name = "%s"
+  email = "email2@example.com"
+  description = "a zone"
+  ttl = 6000
+}
+
+resource "openstack_dns_recordset_v2" "rs_1" {
+  zone_id = "${openstack_dns_zone_v2.zone_1.id}"
+  name = "%s"
+  type = "A"
+  description = "a record set"
+  ttl = 3000
+  records = ["10.1.0.0"]
+}
+
+data "openstack_dns_recordset_v2" "rs_1" {
+  zone_id = "${openstack_dns_zone_v2.zone_1.id}"
+  name    = "${openstack_dns_recordset_v2.rs_1.name}"
+  type    = "A"
+}
+
+data "openstack_dns_recordset_v2" "ns_1" {
+  zone_id = "${openstack_dns_zone_v2.zone_1.id}"
+  name    = "${openstack_dns_zone_v2.zone_1.name}"
+  type    = "NS"
+}
+`, zoneName, zoneName)
+}
@@ -0,0 +1,96 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/utils/terraform/hashcode"
+)
+
+func dataSourceDNSZoneSharesV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDNSZoneSharesV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"target_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"shares": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"project_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_project_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDNSZoneSharesV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	dnsClient, err := config.DNSV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	if err := dnsClientSetAuthHeader(d, dnsClient); err != nil {
+		return diag.Errorf("Error setting dns client auth headers: %s", err)
+	}
+
+	zoneID := d.Get("zone_id").(string)
+	allShares, err := dnsZoneSharesV2List(dnsClient, zoneID).Extract()
+	if err != nil {
+		return diag.Errorf("Unable to retrieve openstack_dns_zone_shares_v2: %s", err)
+	}
+
+	shares := flattenDNSZoneSharesV2(allShares, d.Get("target_project_id").(string))
+
+	log.Printf("[DEBUG] Retrieved openstack_dns_zone_shares_v2 for zone %s: %#v", zoneID, shares)
+
+	ids := make([]string, 0, len(shares))
+	for _, share := range shares {
+		ids = append(ids, share["id"].(string))
+	}
+
+	d.SetId(hashcode.Strings(append([]string{zoneID}, ids...)))
+	d.Set("region", GetRegion(d, config))
+	if err := d.Set("shares", shares); err != nil {
+		log.Printf("[DEBUG] Unable to set openstack_dns_zone_shares_v2 shares: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDNSV2ZoneSharesDataSource_basic(t *testing.T) {
+	zoneName := randomZoneName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckDNS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDNSV2ZoneShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2ZoneShareBasic(zoneName),
+			},
+			{
+				Config: testAccDNSV2ZoneSharesDataSourceBasic(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.openstack_dns_zone_shares_v2.shares_1", "shares.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_dns_zone_shares_v2.shares_1", "shares.0.target_project_id",
+						"openstack_identity_project_v3.project_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDNSV2ZoneSharesDataSourceBasic(zoneName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_dns_zone_shares_v2" "shares_1" {
+  zone_id = "${openstack_dns_zone_share_v2.share_1.zone_id}"
+}
+`, testAccDNSV2ZoneShareBasic(zoneName))
+}
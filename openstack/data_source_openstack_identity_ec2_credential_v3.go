@@ -0,0 +1,123 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/ec2credentials"
+)
+
+func dataSourceIdentityEc2CredentialV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIdentityEc2CredentialV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"user_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"access": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"project_id"},
+			},
+
+			"project_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"access"},
+			},
+
+			"secret": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"trust_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// dataSourceIdentityEc2CredentialV3Read performs the EC2 credential lookup.
+func dataSourceIdentityEc2CredentialV3Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	identityClient, err := config.IdentityV3Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	tokenInfo, err := getTokenInfo(identityClient)
+	if err != nil {
+		return diag.Errorf("Error getting token info: %s", err)
+	}
+
+	userID := tokenInfo.userID
+	if v, ok := d.GetOk("user_id"); ok {
+		userID = v.(string)
+	}
+
+	var ec2Credential *ec2credentials.Credential
+	if access, ok := d.GetOk("access"); ok {
+		ec2Credential, err = ec2credentials.Get(identityClient, userID, access.(string)).Extract()
+		if err != nil {
+			return diag.Errorf("Unable to retrieve openstack_identity_ec2_credential_v3 %s: %s", access, err)
+		}
+	} else {
+		projectID := tokenInfo.projectID
+		if v, ok := d.GetOk("project_id"); ok {
+			projectID = v.(string)
+		}
+
+		allPages, err := ec2credentials.List(identityClient, userID).AllPages()
+		if err != nil {
+			return diag.Errorf("Unable to query openstack_identity_ec2_credential_v3: %s", err)
+		}
+
+		allCredentials, err := ec2credentials.ExtractCredentials(allPages)
+		if err != nil {
+			return diag.Errorf("Unable to retrieve openstack_identity_ec2_credential_v3: %s", err)
+		}
+
+		credentials := identityEc2CredentialV3FilterByProject(allCredentials, projectID)
+
+		if len(credentials) < 1 {
+			return diag.Errorf("Your openstack_identity_ec2_credential_v3 query returned no results")
+		}
+
+		if len(credentials) > 1 {
+			return diag.Errorf("Your openstack_identity_ec2_credential_v3 query returned %d results for project %s. "+
+				"Please specify the access key using the access argument", len(credentials), projectID)
+		}
+
+		ec2Credential = &credentials[0]
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_identity_ec2_credential_v3 %s", ec2Credential.Access)
+
+	d.SetId(ec2Credential.Access)
+	d.Set("region", GetRegion(d, config))
+	d.Set("access", ec2Credential.Access)
+	d.Set("secret", ec2Credential.Secret)
+	d.Set("user_id", ec2Credential.UserID)
+	d.Set("project_id", ec2Credential.TenantID)
+	d.Set("trust_id", ec2Credential.TrustID)
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOpenStackIdentityV3Ec2CredentialDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityV3Ec2CredentialBasic,
+			},
+			{
+				Config: testAccOpenStackIdentityV3Ec2CredentialDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_identity_ec2_credential_v3.ec2_cred_1", "secret",
+						"openstack_identity_ec2_credential_v3.ec2_cred_1", "secret"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_identity_ec2_credential_v3.ec2_cred_1", "project_id",
+						"openstack_identity_ec2_credential_v3.ec2_cred_1", "project_id"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_identity_ec2_credential_v3.ec2_cred_1", "user_id",
+						"openstack_identity_ec2_credential_v3.ec2_cred_1", "user_id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccOpenStackIdentityV3Ec2CredentialDataSourceBasic = `
+resource "openstack_identity_ec2_credential_v3" "ec2_cred_1" {}
+
+data "openstack_identity_ec2_credential_v3" "ec2_cred_1" {
+  access = "${openstack_identity_ec2_credential_v3.ec2_cred_1.access}"
+}
+`
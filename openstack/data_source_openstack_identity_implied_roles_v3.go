@@ -0,0 +1,96 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/utils/terraform/hashcode"
+)
+
+func dataSourceIdentityImpliedRolesV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIdentityImpliedRolesV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"prior_role_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"role_inferences": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"prior_role_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"prior_role_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"implied_role_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"implied_role_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// dataSourceIdentityImpliedRolesV3Read performs the role inferences lookup.
+func dataSourceIdentityImpliedRolesV3Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	identityClient, err := config.IdentityV3Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	inferences, err := identityRoleInferencesV3List(identityClient).Extract()
+	if err != nil {
+		return diag.Errorf("Unable to retrieve openstack_identity_implied_roles_v3: %s", err)
+	}
+
+	if priorRoleID := d.Get("prior_role_id").(string); priorRoleID != "" {
+		filtered := make([]identityRoleInferenceV3, 0, 1)
+		for _, inference := range inferences {
+			if inference.PriorRole.ID == priorRoleID {
+				filtered = append(filtered, inference)
+			}
+		}
+		inferences = filtered
+	}
+
+	roleInferences := flattenIdentityRoleInferencesV3(inferences)
+
+	log.Printf("[DEBUG] Retrieved openstack_identity_implied_roles_v3: %#v", roleInferences)
+
+	ids := make([]string, 0, len(roleInferences))
+	for _, r := range roleInferences {
+		ids = append(ids, identityImpliedRoleV3ID(r["prior_role_id"].(string), r["implied_role_id"].(string)))
+	}
+
+	d.SetId(hashcode.Strings(ids))
+	d.Set("region", GetRegion(d, config))
+	if err := d.Set("role_inferences", roleInferences); err != nil {
+		log.Printf("[DEBUG] Unable to set openstack_identity_implied_roles_v3 role_inferences: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOpenStackIdentityV3ImpliedRolesDataSource_basic(t *testing.T) {
+	priorRoleName := fmt.Sprintf("ACCPTTEST-%s", acctest.RandString(5))
+	impliedRoleName := fmt.Sprintf("ACCPTTEST-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityV3ImpliedRoleBasic(priorRoleName, impliedRoleName),
+			},
+			{
+				Config: testAccOpenStackIdentityV3ImpliedRolesDataSourceBasic(priorRoleName, impliedRoleName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.openstack_identity_implied_roles_v3.implied_roles_1", "role_inferences.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_identity_implied_roles_v3.implied_roles_1", "role_inferences.0.implied_role_id",
+						"openstack_identity_role_v3.implied", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_identity_implied_roles_v3.implied_roles_1", "role_inferences.0.implied_role_name", impliedRoleName),
+				),
+			},
+		},
+	})
+}
+
+func testAccOpenStackIdentityV3ImpliedRolesDataSourceBasic(priorRoleName, impliedRoleName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_identity_implied_roles_v3" "implied_roles_1" {
+  prior_role_id = "${openstack_identity_implied_role_v3.implied_role_1.prior_role_id}"
+}
+`, testAccIdentityV3ImpliedRoleBasic(priorRoleName, impliedRoleName))
+}
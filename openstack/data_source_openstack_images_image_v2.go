@@ -221,16 +221,11 @@ func dataSourceImagesImageV2Read(ctx context.Context, d *schema.ResourceData, me
 	log.Printf("[DEBUG] List Options: %#v", listOpts)
 
 	var image images.Image
-	allPages, err := images.List(imageClient, listOpts).AllPages()
+	allImages, err := imagesImagesV2List(config, imageClient, listOpts)
 	if err != nil {
 		return diag.Errorf("Unable to query images: %s", err)
 	}
 
-	allImages, err := images.ExtractImages(allPages)
-	if err != nil {
-		return diag.Errorf("Unable to retrieve images: %s", err)
-	}
-
 	properties := resourceImagesImageV2ExpandProperties(
 		d.Get("properties").(map[string]interface{}))
 
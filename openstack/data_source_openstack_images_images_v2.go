@@ -0,0 +1,285 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
+	"github.com/gophercloud/utils/terraform/hashcode"
+)
+
+func dataSourceImagesImagesV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceImagesImagesV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"name_regex"},
+			},
+
+			"name_regex": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsValidRegExp,
+				ConflictsWith: []string{"name"},
+			},
+
+			"visibility": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(images.ImageVisibilityPublic),
+					string(images.ImageVisibilityPrivate),
+					string(images.ImageVisibilityShared),
+					string(images.ImageVisibilityCommunity),
+				}, false),
+			},
+
+			"member_status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(images.ImageMemberStatusAccepted),
+					string(images.ImageMemberStatusPending),
+					string(images.ImageMemberStatusRejected),
+					string(images.ImageMemberStatusAll),
+				}, false),
+			},
+
+			"owner": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"size_min": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"size_max": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"hidden": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"tag": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"properties": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"created_at": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: dataSourceImagesImagesV2ValidateDateQuery,
+			},
+
+			"updated_at": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: dataSourceImagesImagesV2ValidateDateQuery,
+			},
+
+			"sort": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "name:asc",
+			},
+
+			// Computed values
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"images": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"visibility": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"container_format": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"disk_format": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"checksum": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size_bytes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"properties": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceImagesImagesV2ValidateDateQuery(v interface{}, k string) ([]string, []error) {
+	if _, err := imagesImageV2DateQuery(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%s: %s", k, err)}
+	}
+
+	return nil, nil
+}
+
+// dataSourceImagesImagesV2Read performs the images lookup.
+func dataSourceImagesImagesV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	imageClient, err := config.ImageV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	visibility := resourceImagesImageV2VisibilityFromString(
+		d.Get("visibility").(string))
+	memberStatus := resourceImagesImageV2MemberStatusFromString(
+		d.Get("member_status").(string))
+	properties := resourceImagesImageV2ExpandProperties(
+		d.Get("properties").(map[string]interface{}))
+
+	var tags []string
+	if tag := d.Get("tag").(string); tag != "" {
+		tags = append(tags, tag)
+	}
+
+	listOpts := images.ListOpts{
+		Name:         d.Get("name").(string),
+		Visibility:   visibility,
+		Hidden:       d.Get("hidden").(bool),
+		Owner:        d.Get("owner").(string),
+		Status:       images.ImageStatusActive,
+		SizeMin:      int64(d.Get("size_min").(int)),
+		SizeMax:      int64(d.Get("size_max").(int)),
+		Sort:         d.Get("sort").(string),
+		Tags:         tags,
+		MemberStatus: memberStatus,
+	}
+
+	if v := d.Get("created_at").(string); v != "" {
+		if listOpts.CreatedAtQuery, err = imagesImageV2DateQuery(v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if v := d.Get("updated_at").(string); v != "" {
+		if listOpts.UpdatedAtQuery, err = imagesImageV2DateQuery(v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	log.Printf("[DEBUG] List Options in openstack_images_images_v2: %#v", listOpts)
+
+	allPages, err := images.List(imageClient, listOpts).AllPages()
+	if err != nil {
+		return diag.Errorf("Unable to list images in openstack_images_images_v2: %s", err)
+	}
+
+	allImages, err := images.ExtractImages(allPages)
+	if err != nil {
+		return diag.Errorf("Unable to retrieve images in openstack_images_images_v2: %s", err)
+	}
+
+	log.Printf("[DEBUG] Retrieved %d images in openstack_images_images_v2", len(allImages))
+
+	allImages = imagesFilterByProperties(allImages, properties)
+
+	if nameRegex, ok := d.GetOk("name_regex"); ok {
+		allImages = imagesFilterByRegex(allImages, nameRegex.(string))
+	}
+
+	log.Printf("[DEBUG] Got %d images after filtering in openstack_images_images_v2", len(allImages))
+
+	imageIDs := make([]string, len(allImages))
+	flattened := make([]map[string]interface{}, len(allImages))
+	for i, image := range allImages {
+		imageIDs[i] = image.ID
+		flattened[i] = map[string]interface{}{
+			"id":               image.ID,
+			"name":             image.Name,
+			"owner":            image.Owner,
+			"visibility":       string(image.Visibility),
+			"container_format": image.ContainerFormat,
+			"disk_format":      image.DiskFormat,
+			"checksum":         image.Checksum,
+			"size_bytes":       int(image.SizeBytes),
+			"tags":             image.Tags,
+			"properties":       resourceImagesImageV2ExpandProperties(image.Properties),
+			"created_at":       image.CreatedAt.Format(time.RFC3339),
+			"updated_at":       image.UpdatedAt.Format(time.RFC3339),
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(strings.Join(imageIDs, ","))))
+	d.Set("ids", imageIDs)
+	if err := d.Set("images", flattened); err != nil {
+		return diag.Errorf("Unable to set images for openstack_images_images_v2: %s", err)
+	}
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
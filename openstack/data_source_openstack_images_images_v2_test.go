@@ -0,0 +1,69 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOpenStackImagesV2ImagesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpenStackImagesV2ImagesDataSourceProperties(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.openstack_images_images_v2.images_by_properties", "images.#", "2"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_images_images_v2.images_by_properties", "images.0.id",
+						"openstack_images_image_v2.image_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_images_images_v2.images_by_properties", "images.0.properties.bar", "foo"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_images_images_v2.images_by_properties", "images.0.checksum"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_images_images_v2.images_by_properties", "images.0.size_bytes"),
+				),
+			},
+			{
+				Config: testAccOpenStackImagesV2ImagesDataSourceCreatedAt(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.openstack_images_images_v2.images_by_created_at", "ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOpenStackImagesV2ImagesDataSourceProperties() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_images_images_v2" "images_by_properties" {
+	properties = {
+		foo = "bar"
+	}
+	name_regex = "^CirrOS-tf_"
+	visibility = "private"
+}
+`, testAccOpenStackImagesV2ImageIDsDataSourceCirros)
+}
+
+func testAccOpenStackImagesV2ImagesDataSourceCreatedAt() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_images_images_v2" "images_by_created_at" {
+	name_regex = "^CirrOS-tf_"
+	visibility = "private"
+	created_at = "lt:2000-01-01T00:00:00Z"
+}
+`, testAccOpenStackImagesV2ImageIDsDataSourceCirros)
+}
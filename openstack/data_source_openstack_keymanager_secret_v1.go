@@ -73,6 +73,16 @@ func dataSourceKeyManagerSecretV1() *schema.Resource {
 				Optional: true,
 			},
 
+			"most_recent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"skip_payload": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
 			"expiration_filter": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -204,14 +214,21 @@ func dataSourceKeyManagerSecretV1Read(ctx context.Context, d *schema.ResourceDat
 			"Please change your search criteria and try again")
 	}
 
+	var secret secrets.Secret
 	if len(allSecrets) > 1 {
-		log.Printf("[DEBUG] Multiple openstack_keymanager_secret_v1 results found: %#v", allSecrets)
-		return diag.Errorf("Your query returned more than one result. Please try a more " +
-			"specific search criteria")
+		recent := d.Get("most_recent").(bool)
+
+		if recent {
+			secret = dataSourceKeyManagerSecretV1MostRecentSecret(allSecrets)
+		} else {
+			log.Printf("[DEBUG] Multiple openstack_keymanager_secret_v1 results found: %#v", allSecrets)
+			return diag.Errorf("Your query returned more than one result. Please try a more " +
+				"specific search criteria, or set `most_recent` attribute to true.")
+		}
+	} else {
+		secret = allSecrets[0]
 	}
 
-	secret := allSecrets[0]
-
 	log.Printf("[DEBUG] Retrieved openstack_keymanager_secret_v1 %s: %#v", d.Id(), secret)
 
 	uuid := keyManagerSecretV1GetUUIDfromSecretRef(secret.SecretRef)
@@ -233,7 +250,13 @@ func dataSourceKeyManagerSecretV1Read(ctx context.Context, d *schema.ResourceDat
 	payloadContentType, _ := secret.ContentTypes["default"]
 	d.Set("payload_content_type", payloadContentType)
 
-	d.Set("payload", keyManagerSecretV1GetPayload(kmClient, d.Id()))
+	// Don't store the secret value in the state, when the payload is skipped.
+	if d.Get("skip_payload").(bool) {
+		d.Set("payload", "")
+	} else {
+		d.Set("payload", keyManagerSecretV1GetPayload(kmClient, d.Id()))
+	}
+
 	metadataMap, err := secrets.GetMetadata(kmClient, d.Id()).Extract()
 	if err != nil {
 		log.Printf("[DEBUG] Unable to get %s secret metadata: %s", uuid, err)
@@ -38,6 +38,31 @@ func TestAccKeyManagerSecretV1DataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccKeyManagerSecretV1DataSource_mostRecent(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckKeyManager(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSecretV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyManagerSecretV1DataSourceMostRecent,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_keymanager_secret_v1.secret_1", "id",
+						"openstack_keymanager_secret_v1.secret_2", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_keymanager_secret_v1.secret_1", "payload", ""),
+					resource.TestCheckResourceAttr(
+						"data.openstack_keymanager_secret_v1.secret_1", "secret_type", "certificate"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKeyManagerSecretV1DataSource_acls(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -111,6 +136,29 @@ data "openstack_keymanager_secret_v1" "secret_2" {
 }
 `
 
+const testAccKeyManagerSecretV1DataSourceMostRecent = `
+resource "openstack_keymanager_secret_v1" "secret_1" {
+  name        = "mycertificate"
+  payload     = "foo"
+  secret_type = "certificate"
+  payload_content_type = "text/plain"
+}
+
+resource "openstack_keymanager_secret_v1" "secret_2" {
+  name        = "${openstack_keymanager_secret_v1.secret_1.name}"
+  payload     = "bar"
+  secret_type = "certificate"
+  payload_content_type = "text/plain"
+}
+
+data "openstack_keymanager_secret_v1" "secret_1" {
+  name         = "${openstack_keymanager_secret_v1.secret_2.name}"
+  secret_type  = "certificate"
+  most_recent  = true
+  skip_payload = true
+}
+`
+
 const testAccKeyManagerSecretV1DataSourceAcls = `
 resource "openstack_keymanager_secret_v1" "secret_1" {
   algorithm   = "aes"
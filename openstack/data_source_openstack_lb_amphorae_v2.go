@@ -0,0 +1,157 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/amphorae"
+	"github.com/gophercloud/utils/terraform/hashcode"
+)
+
+func dataSourceLoadBalancerAmphoraeV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLoadBalancerAmphoraeV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"loadbalancer_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"role": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"image_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"amphorae": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"loadbalancer_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"compute_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"lb_network_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ha_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ha_port_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vrrp_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vrrp_port_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"image_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cached_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLoadBalancerAmphoraeV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	if lbClient.Type != octaviaLBClientType {
+		return diag.Errorf("Error retrieving openstack_lb_amphorae_v2: Only available when using octavia")
+	}
+
+	listOpts := amphorae.ListOpts{
+		LoadbalancerID: d.Get("loadbalancer_id").(string),
+		Role:           d.Get("role").(string),
+		Status:         d.Get("status").(string),
+		ImageID:        d.Get("image_id").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_lb_amphorae_v2 list options: %#v", listOpts)
+
+	allPages, err := amphorae.List(lbClient, listOpts).AllPages()
+	if err != nil {
+		return diag.Errorf("Unable to list openstack_lb_amphorae_v2: %s", err)
+	}
+
+	allAmphorae, err := amphorae.ExtractAmphorae(allPages)
+	if err != nil {
+		return diag.Errorf("Unable to retrieve openstack_lb_amphorae_v2: %s", err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_lb_amphorae_v2: %#v", allAmphorae)
+
+	ids := make([]string, 0, len(allAmphorae))
+	for _, a := range allAmphorae {
+		ids = append(ids, a.ID)
+	}
+
+	d.SetId(hashcode.Strings(ids))
+	d.Set("region", GetRegion(d, config))
+	if err := d.Set("amphorae", flattenLBAmphoraeV2(allAmphorae)); err != nil {
+		log.Printf("[DEBUG] Unable to set openstack_lb_amphorae_v2 amphorae: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccLBV2AmphoraeDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckLB(t)
+			testAccPreCheckUseOctavia(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBV2LoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLbV2LoadBalancerConfigBasic("octavia"),
+			},
+			{
+				Config: testAccLBV2AmphoraeDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_lb_amphorae_v2.amphorae_1", "amphorae.0.loadbalancer_id",
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_amphorae_v2.amphorae_1", "amphorae.0.status", "ALLOCATED"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_lb_amphorae_v2.amphorae_1", "amphorae.0.compute_id"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_lb_amphorae_v2.amphorae_1", "amphorae.0.lb_network_ip"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLBV2AmphoraeDataSourceBasic() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_lb_amphorae_v2" "amphorae_1" {
+  loadbalancer_id = "${openstack_lb_loadbalancer_v2.loadbalancer_1.id}"
+}
+`, testAccLbV2LoadBalancerConfigBasic("octavia"))
+}
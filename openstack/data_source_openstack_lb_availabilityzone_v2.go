@@ -0,0 +1,72 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceLoadBalancerAvailabilityZoneV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLoadBalancerAvailabilityZoneV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"availability_zone_profile_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceLoadBalancerAvailabilityZoneV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	if lbClient.Type != octaviaLBClientType {
+		return diag.Errorf("Error retrieving openstack_lb_availabilityzone_v2: Only available when using octavia")
+	}
+
+	name := d.Get("name").(string)
+	availabilityZone, err := lbAvailabilityZoneV2Get(lbClient, name).Extract()
+	if err != nil {
+		return diag.Errorf("Unable to retrieve openstack_lb_availabilityzone_v2 %s: %s", name, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_lb_availabilityzone_v2 %s: %#v", name, availabilityZone)
+
+	d.SetId(availabilityZone.Name)
+	d.Set("region", GetRegion(d, config))
+	d.Set("name", availabilityZone.Name)
+	d.Set("description", availabilityZone.Description)
+	d.Set("availability_zone_profile_id", availabilityZone.AvailabilityZoneProfileID)
+	d.Set("enabled", availabilityZone.Enabled)
+
+	return nil
+}
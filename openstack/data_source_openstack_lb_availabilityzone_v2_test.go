@@ -0,0 +1,51 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccLBV2AvailabilityZoneDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckLB(t)
+			testAccPreCheckUseOctavia(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBV2AvailabilityZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV2AvailabilityZoneBasic,
+			},
+			{
+				Config: testAccLBV2AvailabilityZoneDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_lb_availabilityzone_v2.availabilityzone_1", "id",
+						"openstack_lb_availabilityzone_v2.availabilityzone_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_lb_availabilityzone_v2.availabilityzone_1", "availability_zone_profile_id",
+						"openstack_lb_availabilityzoneprofile_v2.availabilityzoneprofile_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_availabilityzone_v2.availabilityzone_1", "description", "availabilityzone_1 description"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_availabilityzone_v2.availabilityzone_1", "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLBV2AvailabilityZoneDataSourceBasic() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_lb_availabilityzone_v2" "availabilityzone_1" {
+  name = "${openstack_lb_availabilityzone_v2.availabilityzone_1.name}"
+}
+`, testAccLBV2AvailabilityZoneBasic)
+}
@@ -0,0 +1,102 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceLoadBalancerFlavorV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLoadBalancerFlavorV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"flavor_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name"},
+			},
+
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"flavor_id"},
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"flavor_profile_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceLoadBalancerFlavorV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	if lbClient.Type != octaviaLBClientType {
+		return diag.Errorf("Error retrieving openstack_lb_flavor_v2: Only available when using octavia")
+	}
+
+	listOpts := lbFlavorV2ListOpts{
+		ID:              d.Get("flavor_id").(string),
+		Name:            d.Get("name").(string),
+		FlavorProfileID: d.Get("flavor_profile_id").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_lb_flavor_v2 list options: %#v", listOpts)
+
+	allFlavors, err := lbFlavorV2List(lbClient, listOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Unable to retrieve openstack_lb_flavor_v2: %s", err)
+	}
+
+	if len(allFlavors) < 1 {
+		return diag.Errorf("Your openstack_lb_flavor_v2 query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(allFlavors) > 1 {
+		return diag.Errorf("Your openstack_lb_flavor_v2 query returned more than one result. " +
+			"Please try a more specific search criteria.")
+	}
+
+	flavor := allFlavors[0]
+
+	log.Printf("[DEBUG] Retrieved openstack_lb_flavor_v2 %s: %#v", flavor.ID, flavor)
+
+	d.SetId(flavor.ID)
+	d.Set("region", GetRegion(d, config))
+	d.Set("flavor_id", flavor.ID)
+	d.Set("name", flavor.Name)
+	d.Set("description", flavor.Description)
+	d.Set("flavor_profile_id", flavor.FlavorProfileID)
+	d.Set("enabled", flavor.Enabled)
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccLBV2FlavorDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckLB(t)
+			testAccPreCheckUseOctavia(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBV2FlavorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV2FlavorBasic,
+			},
+			{
+				Config: testAccLBV2FlavorDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_lb_flavor_v2.flavor_1", "id",
+						"openstack_lb_flavor_v2.flavor_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_lb_flavor_v2.flavor_1", "flavor_profile_id",
+						"openstack_lb_flavorprofile_v2.flavorprofile_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_flavor_v2.flavor_1", "description", "flavor_1 description"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_flavor_v2.flavor_1", "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLBV2FlavorDataSourceBasic() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_lb_flavor_v2" "flavor_1" {
+  name = "${openstack_lb_flavor_v2.flavor_1.name}"
+}
+`, testAccLBV2FlavorBasic)
+}
@@ -0,0 +1,103 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+)
+
+func dataSourceLoadBalancerFlavorProfileV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLoadBalancerFlavorProfileV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"flavorprofile_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name"},
+			},
+
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"flavorprofile_id"},
+			},
+
+			"provider_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"flavor_data": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceLoadBalancerFlavorProfileV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	if lbClient.Type != octaviaLBClientType {
+		return diag.Errorf("Error retrieving openstack_lb_flavorprofile_v2: Only available when using octavia")
+	}
+
+	listOpts := lbFlavorProfileV2ListOpts{
+		ID:           d.Get("flavorprofile_id").(string),
+		Name:         d.Get("name").(string),
+		ProviderName: d.Get("provider_name").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_lb_flavorprofile_v2 list options: %#v", listOpts)
+
+	allFlavorProfiles, err := lbFlavorProfileV2List(lbClient, listOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Unable to retrieve openstack_lb_flavorprofile_v2: %s", err)
+	}
+
+	if len(allFlavorProfiles) < 1 {
+		return diag.Errorf("Your openstack_lb_flavorprofile_v2 query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(allFlavorProfiles) > 1 {
+		return diag.Errorf("Your openstack_lb_flavorprofile_v2 query returned more than one result. " +
+			"Please try a more specific search criteria.")
+	}
+
+	flavorProfile := allFlavorProfiles[0]
+
+	log.Printf("[DEBUG] Retrieved openstack_lb_flavorprofile_v2 %s: %#v", flavorProfile.ID, flavorProfile)
+
+	flavorData, err := structure.NormalizeJsonString(flavorProfile.FlavorData)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to normalize openstack_lb_flavorprofile_v2 %s flavor_data: %s", flavorProfile.ID, err)
+		flavorData = flavorProfile.FlavorData
+	}
+
+	d.SetId(flavorProfile.ID)
+	d.Set("region", GetRegion(d, config))
+	d.Set("flavorprofile_id", flavorProfile.ID)
+	d.Set("name", flavorProfile.Name)
+	d.Set("provider_name", flavorProfile.ProviderName)
+	d.Set("flavor_data", flavorData)
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccLBV2FlavorProfileDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckLB(t)
+			testAccPreCheckUseOctavia(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBV2FlavorProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV2FlavorProfileBasic,
+			},
+			{
+				Config: testAccLBV2FlavorProfileDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_lb_flavorprofile_v2.flavorprofile_1", "id",
+						"openstack_lb_flavorprofile_v2.flavorprofile_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_flavorprofile_v2.flavorprofile_1", "provider_name", "amphora"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_flavorprofile_v2.flavorprofile_1", "flavor_data", `{"loadbalancer_topology":"SINGLE"}`),
+				),
+			},
+		},
+	})
+}
+
+func testAccLBV2FlavorProfileDataSourceBasic() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_lb_flavorprofile_v2" "flavorprofile_1" {
+  name = "${openstack_lb_flavorprofile_v2.flavorprofile_1.name}"
+}
+`, testAccLBV2FlavorProfileBasic)
+}
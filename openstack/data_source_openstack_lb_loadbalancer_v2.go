@@ -0,0 +1,234 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	octavialoadbalancers "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/loadbalancers"
+)
+
+func dataSourceLoadBalancerV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLoadBalancerV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"loadbalancer_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name"},
+			},
+
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"loadbalancer_id"},
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"vip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"vip_port_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"vip_subnet_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"vip_network_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"loadbalancer_provider": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"flavor_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"all_tags": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"operating_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"provisioning_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"include_stats": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"stats": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"active_connections": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"bytes_in": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"bytes_out": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"request_errors": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"total_connections": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLoadBalancerV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	if lbClient.Type != octaviaLBClientType {
+		return diag.Errorf("Error retrieving openstack_lb_loadbalancer_v2: Only available when using octavia")
+	}
+
+	listOpts := octavialoadbalancers.ListOpts{
+		ID:        d.Get("loadbalancer_id").(string),
+		Name:      d.Get("name").(string),
+		ProjectID: d.Get("project_id").(string),
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		tags := expandToStringSlice(v.(*schema.Set).List())
+		listOpts.Tags = []string{strings.Join(tags, ",")}
+	}
+
+	log.Printf("[DEBUG] openstack_lb_loadbalancer_v2 list options: %#v", listOpts)
+
+	allPages, err := octavialoadbalancers.List(lbClient, listOpts).AllPages()
+	if err != nil {
+		return diag.Errorf("Unable to list openstack_lb_loadbalancer_v2: %s", err)
+	}
+
+	allLoadBalancers, err := octavialoadbalancers.ExtractLoadBalancers(allPages)
+	if err != nil {
+		return diag.Errorf("Unable to retrieve openstack_lb_loadbalancer_v2: %s", err)
+	}
+
+	if len(allLoadBalancers) < 1 {
+		return diag.Errorf("Your openstack_lb_loadbalancer_v2 query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(allLoadBalancers) > 1 {
+		return diag.Errorf("Your openstack_lb_loadbalancer_v2 query returned more than one result. " +
+			"Please try a more specific search criteria.")
+	}
+
+	lb := allLoadBalancers[0]
+
+	log.Printf("[DEBUG] Retrieved openstack_lb_loadbalancer_v2 %s: %#v", lb.ID, lb)
+
+	stats := make([]map[string]interface{}, 0, 1)
+	if d.Get("include_stats").(bool) {
+		s, err := octavialoadbalancers.GetStats(lbClient, lb.ID).Extract()
+		if err != nil {
+			return diag.Errorf("Unable to retrieve openstack_lb_loadbalancer_v2 %s stats: %s", lb.ID, err)
+		}
+
+		log.Printf("[DEBUG] Retrieved openstack_lb_loadbalancer_v2 %s stats: %#v", lb.ID, s)
+
+		stats = append(stats, flattenLBLoadBalancerV2Stats(s))
+	}
+
+	d.SetId(lb.ID)
+	d.Set("region", GetRegion(d, config))
+	d.Set("loadbalancer_id", lb.ID)
+	d.Set("name", lb.Name)
+	d.Set("description", lb.Description)
+	d.Set("project_id", lb.ProjectID)
+	d.Set("vip_address", lb.VipAddress)
+	d.Set("vip_port_id", lb.VipPortID)
+	d.Set("vip_subnet_id", lb.VipSubnetID)
+	d.Set("vip_network_id", lb.VipNetworkID)
+	d.Set("loadbalancer_provider", lb.Provider)
+	d.Set("flavor_id", lb.FlavorID)
+	d.Set("availability_zone", lb.AvailabilityZone)
+	d.Set("admin_state_up", lb.AdminStateUp)
+	d.Set("all_tags", lb.Tags)
+	d.Set("operating_status", lb.OperatingStatus)
+	d.Set("provisioning_status", lb.ProvisioningStatus)
+	if err := d.Set("stats", stats); err != nil {
+		log.Printf("[DEBUG] Unable to set openstack_lb_loadbalancer_v2 stats: %s", err)
+	}
+
+	return nil
+}
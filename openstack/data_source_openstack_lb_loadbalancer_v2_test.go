@@ -0,0 +1,62 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccLBV2LoadBalancerDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLB(t)
+			testAccPreCheckUseOctavia(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBV2LoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLbV2LoadBalancerConfigBasic("octavia"),
+			},
+			{
+				Config: testAccLBV2LoadBalancerDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_lb_loadbalancer_v2.loadbalancer_1", "id",
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_lb_loadbalancer_v2.loadbalancer_1", "vip_address",
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "vip_address"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_lb_loadbalancer_v2.loadbalancer_1", "vip_port_id",
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "vip_port_id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_loadbalancer_v2.loadbalancer_1", "loadbalancer_provider", "octavia"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_loadbalancer_v2.loadbalancer_1", "all_tags.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_loadbalancer_v2.loadbalancer_1", "provisioning_status", "ACTIVE"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_loadbalancer_v2.loadbalancer_1", "stats.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_loadbalancer_v2.loadbalancer_1", "stats.0.active_connections", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLBV2LoadBalancerDataSourceBasic() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name          = "${openstack_lb_loadbalancer_v2.loadbalancer_1.name}"
+  tags          = ["tag1"]
+  include_stats = true
+}
+`, testAccLbV2LoadBalancerConfigBasic("octavia"))
+}
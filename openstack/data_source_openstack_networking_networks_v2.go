@@ -0,0 +1,258 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/external"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
+	"github.com/gophercloud/utils/terraform/hashcode"
+)
+
+// dataSourceNetworkingNetworksV2PageSize is the number of networks, which
+// are requested per page.
+const dataSourceNetworkingNetworksV2PageSize = 500
+
+func dataSourceNetworkingNetworksV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetworkingNetworksV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"name_regex"},
+			},
+
+			"name_regex": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsValidRegExp,
+				ConflictsWith: []string{"name"},
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tenant_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["tenant_id"],
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"shared": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"external": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"sort_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"sort_direction": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"asc", "desc",
+				}, true),
+			},
+
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"networks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"shared": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"external": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"mtu": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"subnets": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"all_tags": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkingNetworksV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	opts := networks.ListOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Status:      d.Get("status").(string),
+		TenantID:    d.Get("tenant_id").(string),
+		ProjectID:   d.Get("project_id").(string),
+		SortKey:     d.Get("sort_key").(string),
+		SortDir:     d.Get("sort_direction").(string),
+		Limit:       dataSourceNetworkingNetworksV2PageSize,
+	}
+
+	if v, ok := d.GetOkExists("shared"); ok {
+		shared := v.(bool)
+		opts.Shared = &shared
+	}
+
+	tags := networkingV2AttributesTags(d)
+	if len(tags) > 0 {
+		opts.Tags = strings.Join(tags, ",")
+	}
+
+	var listOpts networks.ListOptsBuilder = opts
+	if v, ok := d.GetOkExists("external"); ok {
+		isExternal := v.(bool)
+		listOpts = external.ListOptsExt{
+			ListOptsBuilder: listOpts,
+			External:        &isExternal,
+		}
+	}
+
+	pages, err := networks.List(networkingClient, listOpts).AllPages()
+	if err != nil {
+		return diag.Errorf("Unable to retrieve openstack_networking_networks_v2: %s", err)
+	}
+
+	var allNetworks []networkExtended
+	if err := networks.ExtractNetworksInto(pages, &allNetworks); err != nil {
+		return diag.Errorf("Unable to extract openstack_networking_networks_v2: %s", err)
+	}
+
+	log.Printf("[DEBUG] Retrieved %d networks in openstack_networking_networks_v2", len(allNetworks))
+
+	if nameRegex, ok := d.GetOk("name_regex"); ok {
+		allNetworks = dataSourceNetworkingNetworksV2FilterName(allNetworks, regexp.MustCompile(nameRegex.(string)))
+
+		log.Printf("[DEBUG] Retrieved %d networks after filtering by %s in openstack_networking_networks_v2",
+			len(allNetworks), nameRegex)
+	}
+
+	networkIDs := make([]string, len(allNetworks))
+	flattened := make([]map[string]interface{}, len(allNetworks))
+	for i, network := range allNetworks {
+		networkIDs[i] = network.ID
+		flattened[i] = map[string]interface{}{
+			"id":          network.ID,
+			"name":        network.Name,
+			"description": network.Description,
+			"status":      network.Status,
+			"tenant_id":   network.TenantID,
+			"shared":      network.Shared,
+			"external":    network.External,
+			"mtu":         network.MTU,
+			"subnets":     network.Subnets,
+			"all_tags":    network.Tags,
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(strings.Join(networkIDs, ","))))
+	d.Set("ids", networkIDs)
+	if err := d.Set("networks", flattened); err != nil {
+		return diag.Errorf("Unable to set networks for openstack_networking_networks_v2: %s", err)
+	}
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+// dataSourceNetworkingNetworksV2FilterName returns the networks, which names
+// match the regular expression.
+func dataSourceNetworkingNetworksV2FilterName(allNetworks []networkExtended, r *regexp.Regexp) []networkExtended {
+	var filtered []networkExtended
+	for _, network := range allNetworks {
+		if network.Name == "" {
+			log.Printf("[WARN] Unable to find network name to match against "+
+				"for %q network ID, nothing to do.", network.ID)
+			continue
+		}
+		if r.MatchString(network.Name) {
+			filtered = append(filtered, network)
+		}
+	}
+
+	return filtered
+}
@@ -0,0 +1,94 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetworkingV2NetworksDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2NetworksDataSourceTag(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_networks_v2.networks_by_tag", "ids.#", "2"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_networks_v2.networks_by_tag", "networks.#", "2"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2NetworksDataSourceRegex(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_networks_v2.networks_by_name_regex", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_networking_networks_v2.networks_by_name_regex", "ids.0",
+						"openstack_networking_network_v2.network_2", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_networks_v2.networks_by_name_regex", "networks.0.name", "tf_test_networks_two"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_networks_v2.networks_by_name_regex", "networks.0.external", "false"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_networks_v2.networks_by_name_regex", "networks.0.subnets.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccNetworkingV2NetworksDataSource = `
+resource "openstack_networking_network_v2" "network_1" {
+  name           = "tf_test_networks_one"
+  admin_state_up = "true"
+  tags           = ["tf_test_networks"]
+}
+
+resource "openstack_networking_network_v2" "network_2" {
+  name           = "tf_test_networks_two"
+  admin_state_up = "true"
+  tags           = ["tf_test_networks"]
+}
+
+resource "openstack_networking_subnet_v2" "subnet_2" {
+  name       = "tf_test_networks_two"
+  cidr       = "192.168.199.0/24"
+  network_id = "${openstack_networking_network_v2.network_2.id}"
+}
+`
+
+func testAccNetworkingV2NetworksDataSourceTag() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_networking_networks_v2" "networks_by_tag" {
+  tags = ["tf_test_networks"]
+
+  depends_on = [
+    "openstack_networking_network_v2.network_1",
+    "openstack_networking_network_v2.network_2",
+  ]
+}
+`, testAccNetworkingV2NetworksDataSource)
+}
+
+func testAccNetworkingV2NetworksDataSourceRegex() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_networking_networks_v2" "networks_by_name_regex" {
+  name_regex = "^tf_test_networks_t.*"
+
+  depends_on = [
+    "openstack_networking_subnet_v2.subnet_2",
+  ]
+}
+`, testAccNetworkingV2NetworksDataSource)
+}
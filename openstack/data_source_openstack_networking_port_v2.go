@@ -79,6 +79,11 @@ func dataSourceNetworkingPortV2() *schema.Resource {
 				ValidateFunc: validation.IsIPAddress,
 			},
 
+			"fixed_ip_subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
 			"status": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -286,17 +291,22 @@ func dataSourceNetworkingPortV2Read(ctx context.Context, d *schema.ResourceData,
 
 	var portsList []portExtended
 
-	// Filter returned Fixed IPs by a "fixed_ip".
-	if v, ok := d.GetOk("fixed_ip"); ok {
+	// Filter returned Fixed IPs by a "fixed_ip" and a "fixed_ip_subnet_id".
+	// Both have to match the same Fixed IP.
+	fixedIP := d.Get("fixed_ip").(string)
+	fixedIPSubnetID := d.Get("fixed_ip_subnet_id").(string)
+	if fixedIP != "" || fixedIPSubnetID != "" {
 		for _, p := range allPorts {
 			for _, ipObject := range p.FixedIPs {
-				if v.(string) == ipObject.IPAddress {
+				if (fixedIP == "" || fixedIP == ipObject.IPAddress) &&
+					(fixedIPSubnetID == "" || fixedIPSubnetID == ipObject.SubnetID) {
 					portsList = append(portsList, p)
+					break
 				}
 			}
 		}
 		if len(portsList) == 0 {
-			log.Printf("No openstack_networking_port_v2 found after the 'fixed_ip' filter")
+			log.Printf("No openstack_networking_port_v2 found after the 'fixed_ip' and 'fixed_ip_subnet_id' filters")
 			return diag.Errorf("No openstack_networking_port_v2 found")
 		}
 	} else {
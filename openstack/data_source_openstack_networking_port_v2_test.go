@@ -31,6 +31,9 @@ func TestAccNetworkingV2PortDataSource_basic(t *testing.T) {
 						"openstack_networking_port_v2.port_1", "id"),
 					resource.TestCheckResourceAttr(
 						"data.openstack_networking_port_v2.port_3", "all_fixed_ips.#", "2"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_networking_port_v2.port_4", "id",
+						"openstack_networking_port_v2.port_1", "id"),
 				),
 			},
 		},
@@ -118,4 +121,9 @@ data "openstack_networking_port_v2" "port_2" {
 data "openstack_networking_port_v2" "port_3" {
   fixed_ip = "${openstack_networking_port_v2.port_1.all_fixed_ips.1}"
 }
+
+data "openstack_networking_port_v2" "port_4" {
+  fixed_ip           = "${openstack_networking_port_v2.port_1.all_fixed_ips.0}"
+  fixed_ip_subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+}
 `
@@ -16,16 +16,19 @@ func dataSourceNetworkingQuotaV2() *schema.Resource {
 		ReadContext: dataSourceNetworkingQuotaV2Read,
 		Schema: map[string]*schema.Schema{
 			"region": {
-				Type: schema.TypeString,
-
+				Type:     schema.TypeString,
+				Optional: true,
 				Computed: true,
-				ForceNew: true,
 			},
 
 			"project_id": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
+			},
+
+			"details": {
+				Type:     schema.TypeBool,
+				Optional: true,
 			},
 
 			"floatingip": {
@@ -72,6 +75,23 @@ func dataSourceNetworkingQuotaV2() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+
+			"trunk": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"used": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+
+			"reserved": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
 		},
 	}
 }
@@ -86,17 +106,38 @@ func dataSourceNetworkingQuotaV2Read(ctx context.Context, d *schema.ResourceData
 
 	projectID := d.Get("project_id").(string)
 
+	id := fmt.Sprintf("%s/%s", projectID, region)
+	d.SetId(id)
+	d.Set("project_id", projectID)
+	d.Set("region", region)
+
+	if d.Get("details").(bool) {
+		q, err := quotas.GetDetail(networkingClient, projectID).Extract()
+		if err != nil {
+			return diag.Errorf("Error retrieving openstack_networking_quota_v2 details %s: %s", id, err)
+		}
+
+		log.Printf("[DEBUG] Retrieved openstack_networking_quota_v2 details %s: %#v", id, q)
+
+		details := networkingQuotaV2Details(q)
+		for name, detail := range details {
+			d.Set(name, detail.Limit)
+		}
+
+		used, reserved := networkingQuotaV2Usage(details)
+		d.Set("used", used)
+		d.Set("reserved", reserved)
+
+		return nil
+	}
+
 	q, err := quotas.Get(networkingClient, projectID).Extract()
 	if err != nil {
-		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_networking_quota_v2"))
+		return diag.Errorf("Error retrieving openstack_networking_quota_v2 %s: %s", id, err)
 	}
 
-	log.Printf("[DEBUG] Retrieved openstack_networking_quota_v2 %s: %#v", d.Id(), q)
+	log.Printf("[DEBUG] Retrieved openstack_networking_quota_v2 %s: %#v", id, q)
 
-	id := fmt.Sprintf("%s/%s", projectID, region)
-	d.SetId(id)
-	d.Set("project_id", projectID)
-	d.Set("region", region)
 	d.Set("floatingip", q.FloatingIP)
 	d.Set("network", q.Network)
 	d.Set("port", q.Port)
@@ -106,6 +147,7 @@ func dataSourceNetworkingQuotaV2Read(ctx context.Context, d *schema.ResourceData
 	d.Set("security_group_rule", q.SecurityGroupRule)
 	d.Set("subnet", q.Subnet)
 	d.Set("subnetpool", q.SubnetPool)
+	d.Set("trunk", q.Trunk)
 
 	return nil
 }
@@ -34,6 +34,15 @@ func TestAccNetworkingV2QuotaDataSource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.openstack_networking_quota_v2.source", "subnetpool"),
 				),
 			},
+			{
+				Config: testAccNetworkingV2QuotaDataSourceDetails(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingQuotaV2DataSourceID("data.openstack_networking_quota_v2.source"),
+					resource.TestCheckResourceAttrSet("data.openstack_networking_quota_v2.source", "port"),
+					resource.TestCheckResourceAttr("data.openstack_networking_quota_v2.source", "used.port", "0"),
+					resource.TestCheckResourceAttr("data.openstack_networking_quota_v2.source", "reserved.port", "0"),
+				),
+			},
 		},
 	})
 }
@@ -68,3 +77,14 @@ data "openstack_networking_quota_v2" "source" {
 }
 `, testAccNetworkingV2QuotaDataSourceBasic)
 }
+
+func testAccNetworkingV2QuotaDataSourceDetails() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_networking_quota_v2" "source" {
+  project_id = "${openstack_identity_project_v3.project.id}"
+  details    = true
+}
+`, testAccNetworkingV2QuotaDataSourceBasic)
+}
@@ -190,7 +190,7 @@ func dataSourceNetworkingSubnetPoolV2Read(ctx context.Context, d *schema.Resourc
 		listOpts.IPVersion = v.(int)
 	}
 
-	if v, ok := d.GetOk("shared"); ok {
+	if v, ok := d.GetOkExists("shared"); ok {
 		shared := v.(bool)
 		listOpts.Shared = &shared
 	}
@@ -199,7 +199,7 @@ func dataSourceNetworkingSubnetPoolV2Read(ctx context.Context, d *schema.Resourc
 		listOpts.Description = v.(string)
 	}
 
-	if v, ok := d.GetOk("is_default"); ok {
+	if v, ok := d.GetOkExists("is_default"); ok {
 		isDefault := v.(bool)
 		listOpts.IsDefault = &isDefault
 	}
@@ -76,6 +76,28 @@ func TestAccNetworkingV2SubnetPoolDataSourceTestQueries(t *testing.T) {
 	})
 }
 
+func TestAccNetworkingV2SubnetPoolDataSourceAddressScope(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpenStackNetworkingSubnetPoolV2DataSourceAddressScope,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_networking_subnetpool_v2.subnetpool_1", "id",
+						"openstack_networking_subnetpool_v2.subnetpool_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_subnetpool_v2.subnetpool_1", "is_default", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckNetworkingSubnetPoolV2DataSourceID(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -158,3 +180,29 @@ data "openstack_networking_subnetpool_v2" "subnetpool_1" {
 }
 `, testAccOpenStackNetworkingSubnetPoolV2DataSourceSubnetPool)
 }
+
+const testAccOpenStackNetworkingSubnetPoolV2DataSourceAddressScope = `
+resource "openstack_networking_addressscope_v2" "addressscope_1" {
+  name       = "addressscope_1"
+  ip_version = 4
+}
+
+resource "openstack_networking_subnetpool_v2" "subnetpool_1" {
+  name             = "subnetpool_1"
+  prefixes         = ["10.20.0.0/16"]
+  address_scope_id = openstack_networking_addressscope_v2.addressscope_1.id
+
+  tags = [
+    "foo",
+    "bar",
+  ]
+}
+
+data "openstack_networking_subnetpool_v2" "subnetpool_1" {
+  address_scope_id = openstack_networking_subnetpool_v2.subnetpool_1.address_scope_id
+  is_default       = false
+  tags = [
+    "foo",
+  ]
+}
+`
@@ -0,0 +1,153 @@
+package openstack
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"time"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
+)
+
+// dataSourceObjectStorageObjectV1DefaultMaxContentLength is the maximum size
+// of the object content which is read, when max_content_length is not set.
+const dataSourceObjectStorageObjectV1DefaultMaxContentLength = 1024 * 1024
+
+func dataSourceObjectStorageObjectV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceObjectStorageObjectV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"container_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"max_content_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      dataSourceObjectStorageObjectV1DefaultMaxContentLength,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"content": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"content_base64": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"content_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"content_length": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"content_encoding": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"content_disposition": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"last_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"metadata": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceObjectStorageObjectV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	objectStorageClient, err := config.ObjectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	cn := d.Get("container_name").(string)
+	name := d.Get("name").(string)
+	maxContentLength := int64(d.Get("max_content_length").(int))
+
+	res := objects.Download(objectStorageClient, cn, name, nil)
+	if res.Body != nil {
+		defer res.Body.Close()
+	}
+
+	header, err := res.Extract()
+	if err != nil {
+		return diag.Errorf("Unable to retrieve openstack_objectstorage_object_v1 %s/%s: %s", cn, name, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_objectstorage_object_v1 %s/%s: %#v", cn, name, header)
+
+	// Fail early instead of downloading a too large object.
+	if header.ContentLength > maxContentLength {
+		return diag.Errorf("The openstack_objectstorage_object_v1 %s/%s content length of %d bytes exceeds max_content_length of %d bytes",
+			cn, name, header.ContentLength, maxContentLength)
+	}
+
+	content, err := objectStorageObjectV1ReadContent(res.Body, maxContentLength)
+	if err != nil {
+		return diag.Errorf("Error reading openstack_objectstorage_object_v1 %s/%s content: %s", cn, name, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", cn, name))
+
+	// Binary content is only available as base64.
+	if utf8.Valid(content) {
+		d.Set("content", string(content))
+	} else {
+		d.Set("content", "")
+	}
+	d.Set("content_base64", base64.StdEncoding.EncodeToString(content))
+	d.Set("content_type", header.ContentType)
+	d.Set("content_length", header.ContentLength)
+	d.Set("content_encoding", header.ContentEncoding)
+	d.Set("content_disposition", header.ContentDisposition)
+	d.Set("etag", header.ETag)
+	if header.LastModified.Unix() > 0 {
+		d.Set("last_modified", header.LastModified.Format(time.RFC3339))
+	}
+	d.Set("metadata", objectStorageObjectV1Metadata(res.Header))
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package openstack
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOpenStackObjectStorageV1ObjectDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSwift(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpenStackObjectStorageV1ObjectDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.openstack_objectstorage_object_v1.settings", "content", "{\"foo\": \"bar\"}"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_objectstorage_object_v1.settings", "content_base64", "eyJmb28iOiAiYmFyIn0="),
+					resource.TestCheckResourceAttr(
+						"data.openstack_objectstorage_object_v1.settings", "content_type", "application/json"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_objectstorage_object_v1.settings", "content_length", "14"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_objectstorage_object_v1.settings", "metadata.Owner", "terraform"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_objectstorage_object_v1.settings", "etag"),
+				),
+			},
+			{
+				Config:      testAccOpenStackObjectStorageV1ObjectDataSourceMaxContentLength,
+				ExpectError: regexp.MustCompile("exceeds max_content_length"),
+			},
+		},
+	})
+}
+
+const testAccOpenStackObjectStorageV1ObjectDataSourceBasic = `
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name          = "tf_test_container_1"
+  force_destroy = true
+}
+
+resource "openstack_objectstorage_object_v1" "settings" {
+  name           = "settings.json"
+  container_name = "${openstack_objectstorage_container_v1.container_1.name}"
+  content        = "{\"foo\": \"bar\"}"
+  content_type   = "application/json"
+
+  metadata = {
+    owner = "terraform"
+  }
+}
+
+data "openstack_objectstorage_object_v1" "settings" {
+  container_name = "${openstack_objectstorage_object_v1.settings.container_name}"
+  name           = "${openstack_objectstorage_object_v1.settings.name}"
+}
+`
+
+const testAccOpenStackObjectStorageV1ObjectDataSourceMaxContentLength = `
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name          = "tf_test_container_1"
+  force_destroy = true
+}
+
+resource "openstack_objectstorage_object_v1" "settings" {
+  name           = "settings.json"
+  container_name = "${openstack_objectstorage_container_v1.container_1.name}"
+  content        = "{\"foo\": \"bar\"}"
+  content_type   = "application/json"
+
+  metadata = {
+    owner = "terraform"
+  }
+}
+
+data "openstack_objectstorage_object_v1" "settings" {
+  container_name     = "${openstack_objectstorage_object_v1.settings.container_name}"
+  name               = "${openstack_objectstorage_object_v1.settings.name}"
+  max_content_length = 10
+}
+`
@@ -0,0 +1,106 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceObjectStorageTempurlV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceObjectStorageTempurlV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"container": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"object": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "get",
+				ValidateFunc: validation.StringInSlice([]string{"get", "post"}, false),
+			},
+
+			"ttl": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"split": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"digest": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "sha1",
+				ValidateFunc: validation.StringInSlice([]string{"sha1", "sha256", "sha512"}, false),
+			},
+
+			"url": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"expires_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// dataSourceObjectStorageTempurlV1Read signs a new temporary URL on every
+// read, so the URL in the state never expires before the TTL.
+func dataSourceObjectStorageTempurlV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	objectStorageClient, err := config.ObjectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	containerName := d.Get("container").(string)
+	objectName := d.Get("object").(string)
+	opts := objectStorageTempurlV1Opts{
+		Method: d.Get("method").(string),
+		TTL:    d.Get("ttl").(int),
+		Split:  d.Get("split").(string),
+		Digest: d.Get("digest").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_objectstorage_tempurl_v1 options: %#v", opts)
+
+	url, expiresAt, err := objectStorageTempurlV1Create(objectStorageClient, containerName, objectName, opts)
+	if err != nil {
+		return diag.Errorf("Unable to generate a temporary url for the object %s in container %s: %s",
+			objectName, containerName, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", containerName, objectName))
+	d.Set("url", url)
+	d.Set("expires_at", expiresAt.Format(time.RFC3339))
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
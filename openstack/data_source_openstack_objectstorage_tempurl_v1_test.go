@@ -0,0 +1,54 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOpenStackObjectStorageTempurlV1DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSwift(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpenStackObjectStorageTempurlV1DataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectstorageTempurlV1Get("data.openstack_objectstorage_tempurl_v1.tempurl_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_objectstorage_tempurl_v1.tempurl_1", "digest", "sha256"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_objectstorage_tempurl_v1.tempurl_1", "expires_at"),
+				),
+			},
+		},
+	})
+}
+
+const testAccOpenStackObjectStorageTempurlV1DataSourceBasic = `
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name          = "tf_test_container_1"
+  force_destroy = true
+
+  metadata = {
+    Temp-URL-Key = "testkey"
+  }
+}
+
+resource "openstack_objectstorage_object_v1" "object_1" {
+  container_name = "${openstack_objectstorage_container_v1.container_1.name}"
+  name           = "object/with/slashes"
+  content        = "Hello, world!"
+}
+
+data "openstack_objectstorage_tempurl_v1" "tempurl_1" {
+  container = "${openstack_objectstorage_container_v1.container_1.name}"
+  object    = "${openstack_objectstorage_object_v1.object_1.name}"
+  digest    = "sha256"
+  ttl       = 60
+}
+`
@@ -0,0 +1,94 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud/openstack/sharedfilesystems/v2/shares"
+)
+
+func dataSourceSharedFilesystemExportLocationsV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSharedFilesystemExportLocationsV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"share_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"export_locations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"preferred": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_admin_only": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"share_instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"preferred_export_location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceSharedFilesystemExportLocationsV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	// The preferred flag of the export locations appeared in 2.14.
+	sfsClient.Microversion = minManilaShareMicroversion
+
+	shareID := d.Get("share_id").(string)
+
+	exportLocationsRaw, err := shares.ListExportLocations(sfsClient, shareID).Extract()
+	if err != nil {
+		return diag.Errorf("Failed to retrieve share's export_locations %s: %s", shareID, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved share's export_locations %s: %#v", shareID, exportLocationsRaw)
+
+	d.SetId(shareID)
+	d.Set("region", GetRegion(d, config))
+	d.Set("preferred_export_location", sharedFilesystemShareV2PreferredExportLocation(exportLocationsRaw))
+
+	if err := d.Set("export_locations", flattenSharedFilesystemShareV2ExportLocations(exportLocationsRaw)); err != nil {
+		log.Printf("[DEBUG] Unable to set export_locations for share %s: %s", shareID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSFSV2ExportLocationsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckSFS(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSFSV2ShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSFSV2ExportLocationsDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_sharedfilesystem_export_locations_v2.export_locations_1", "id",
+						"openstack_sharedfilesystem_share_v2.share_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_sharedfilesystem_export_locations_v2.export_locations_1", "preferred_export_location",
+						"openstack_sharedfilesystem_share_v2.share_1", "preferred_export_location"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_sharedfilesystem_export_locations_v2.export_locations_1", "export_locations.0.path"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_sharedfilesystem_export_locations_v2.export_locations_1", "export_locations.0.share_instance_id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccSFSV2ExportLocationsDataSourceBasic = `
+resource "openstack_sharedfilesystem_share_v2" "share_1" {
+  name        = "nfs_share"
+  share_proto = "NFS"
+  share_type  = "dhss_false"
+  size        = 1
+}
+
+data "openstack_sharedfilesystem_export_locations_v2" "export_locations_1" {
+  share_id = "${openstack_sharedfilesystem_share_v2.share_1.id}"
+}
+`
@@ -2,7 +2,6 @@ package openstack
 
 import (
 	"context"
-	"fmt"
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -94,6 +93,10 @@ func dataSourceSharedFilesystemShareV2() *schema.Resource {
 				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"path": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -102,10 +105,23 @@ func dataSourceSharedFilesystemShareV2() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"is_admin_only": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"share_instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
 
+			"preferred_export_location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"availability_zone": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -176,13 +192,7 @@ func dataSourceSharedFilesystemShareV2Read(ctx context.Context, d *schema.Resour
 
 	log.Printf("[DEBUG] Retrieved share's export_locations %s: %#v", share.ID, exportLocationsRaw)
 
-	exportLocations := make([]map[string]string, 0, len(exportLocationsRaw))
-	for _, v := range exportLocationsRaw {
-		exportLocations = append(exportLocations, map[string]string{
-			"path":      v.Path,
-			"preferred": fmt.Sprint(v.Preferred),
-		})
-	}
+	exportLocations := flattenSharedFilesystemShareV2ExportLocations(exportLocationsRaw)
 
 	d.SetId(share.ID)
 	d.Set("name", share.Name)
@@ -196,6 +206,7 @@ func dataSourceSharedFilesystemShareV2Read(ctx context.Context, d *schema.Resour
 	d.Set("status", share.Status)
 	d.Set("is_public", share.IsPublic)
 	d.Set("share_proto", share.ShareProto)
+	d.Set("preferred_export_location", sharedFilesystemShareV2PreferredExportLocation(exportLocationsRaw))
 
 	if err := d.Set("metadata", share.Metadata); err != nil {
 		log.Printf("[DEBUG] Unable to set metadata for share %s: %s", share.ID, err)
@@ -32,6 +32,9 @@ func TestAccSFSV2ShareDataSource_basic(t *testing.T) {
 						"data.openstack_sharedfilesystem_share_v2.share_1", "is_public", "false"),
 					resource.TestCheckResourceAttr(
 						"data.openstack_sharedfilesystem_share_v2.share_1", "size", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_sharedfilesystem_share_v2.share_1", "preferred_export_location",
+						"openstack_sharedfilesystem_share_v2.share_1", "preferred_export_location"),
 				),
 			},
 		},
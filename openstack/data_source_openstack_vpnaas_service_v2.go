@@ -0,0 +1,151 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/vpnaas/services"
+)
+
+func dataSourceServiceV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceServiceV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"service_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"router_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"external_v4_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"external_v6_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceServiceV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	listOpts := services.ListOpts{}
+
+	if v, ok := d.GetOk("name"); ok {
+		listOpts.Name = v.(string)
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		listOpts.Description = v.(string)
+	}
+
+	if v, ok := d.GetOk("router_id"); ok {
+		listOpts.RouterID = v.(string)
+	}
+
+	if v, ok := d.GetOk("subnet_id"); ok {
+		listOpts.SubnetID = v.(string)
+	}
+
+	if v, ok := d.GetOkExists("admin_state_up"); ok {
+		asu := v.(bool)
+		listOpts.AdminStateUp = &asu
+	}
+
+	if v, ok := d.GetOk("status"); ok {
+		listOpts.Status = v.(string)
+	}
+
+	if v, ok := d.GetOk("tenant_id"); ok {
+		listOpts.TenantID = v.(string)
+	}
+
+	pages, err := services.List(networkingClient, listOpts).AllPages()
+	if err != nil {
+		return diag.Errorf("Unable to list VPN services: %s", err)
+	}
+
+	allServices, err := services.ExtractServices(pages)
+	if err != nil {
+		return diag.Errorf("Unable to retrieve VPN services: %s", err)
+	}
+
+	// services.ListOpts has no ID filter.
+	if v, ok := d.GetOk("service_id"); ok {
+		var filtered []services.Service
+		for _, service := range allServices {
+			if service.ID == v.(string) {
+				filtered = append(filtered, service)
+			}
+		}
+		allServices = filtered
+	}
+
+	if len(allServices) < 1 {
+		return diag.Errorf("No VPN service found")
+	}
+
+	if len(allServices) > 1 {
+		return diag.Errorf("More than one VPN service found")
+	}
+
+	service := allServices[0]
+
+	log.Printf("[DEBUG] Retrieved VPN service %s: %+v", service.ID, service)
+	d.SetId(service.ID)
+
+	d.Set("service_id", service.ID)
+	d.Set("name", service.Name)
+	d.Set("description", service.Description)
+	d.Set("router_id", service.RouterID)
+	d.Set("subnet_id", service.SubnetID)
+	d.Set("admin_state_up", service.AdminStateUp)
+	d.Set("status", service.Status)
+	d.Set("tenant_id", service.TenantID)
+	d.Set("external_v4_ip", service.ExternalV4IP)
+	d.Set("external_v6_ip", service.ExternalV6IP)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
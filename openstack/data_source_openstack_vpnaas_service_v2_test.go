@@ -0,0 +1,57 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccVPNaaSServiceV2DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckVPN(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPNaaSServiceV2DataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_vpnaas_service_v2.service_1", "id",
+						"openstack_vpnaas_service_v2.service_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_vpnaas_service_v2.service_1", "router_id",
+						"openstack_networking_router_v2.router_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_vpnaas_service_v2.service_1", "name", "service_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_vpnaas_service_v2.service_1", "admin_state_up", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPNaaSServiceV2DataSourceBasic() string {
+	return fmt.Sprintf(`
+resource "openstack_networking_router_v2" "router_1" {
+  name                = "router_1"
+  admin_state_up      = "true"
+  external_network_id = "%s"
+}
+
+resource "openstack_vpnaas_service_v2" "service_1" {
+  name           = "service_1"
+  router_id      = openstack_networking_router_v2.router_1.id
+  admin_state_up = "false"
+}
+
+data "openstack_vpnaas_service_v2" "service_1" {
+  name      = openstack_vpnaas_service_v2.service_1.name
+  router_id = openstack_vpnaas_service_v2.service_1.router_id
+}
+`, osExtGwID)
+}
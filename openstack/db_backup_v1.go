@@ -0,0 +1,91 @@
+package openstack
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// databaseBackupV1 represents a database instance backup.
+type databaseBackupV1 struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	InstanceID  string  `json:"instance_id"`
+	ParentID    string  `json:"parent_id"`
+	LocationRef string  `json:"locationRef"`
+	Size        float64 `json:"size"`
+	Status      string  `json:"status"`
+	Created     string  `json:"created"`
+	Updated     string  `json:"updated"`
+}
+
+// databaseBackupV1CreateOpts represents the attributes used when creating a
+// new database instance backup.
+type databaseBackupV1CreateOpts struct {
+	Name        string `json:"name"`
+	Instance    string `json:"instance"`
+	Description string `json:"description,omitempty"`
+	ParentID    string `json:"parent_id,omitempty"`
+	Incremental int    `json:"incremental,omitempty"`
+}
+
+type databaseBackupV1Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets a databaseBackupV1Result as a databaseBackupV1.
+func (r databaseBackupV1Result) Extract() (*databaseBackupV1, error) {
+	var s struct {
+		Backup *databaseBackupV1 `json:"backup"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Backup, err
+}
+
+func databaseBackupV1Create(client *gophercloud.ServiceClient, opts databaseBackupV1CreateOpts) (r databaseBackupV1Result) {
+	b, err := gophercloud.BuildRequestBody(opts, "backup")
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(client.ServiceURL("backups"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func databaseBackupV1Get(client *gophercloud.ServiceClient, id string) (r databaseBackupV1Result) {
+	resp, err := client.Get(client.ServiceURL("backups", id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func databaseBackupV1Delete(client *gophercloud.ServiceClient, id string) (r gophercloud.ErrResult) {
+	resp, err := client.Delete(client.ServiceURL("backups", id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// databaseBackupV1StateRefreshFunc returns a resource.StateRefreshFunc
+// that is used to watch a database instance backup.
+func databaseBackupV1StateRefreshFunc(client *gophercloud.ServiceClient, backupID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		b, err := databaseBackupV1Get(client, backupID).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return b, "DELETED", nil
+			}
+			return nil, "", err
+		}
+
+		if b.Status == "FAILED" || b.Status == "DELETE_FAILED" {
+			return b, b.Status, fmt.Errorf("openstack_db_backup_v1 %s is in an error state: %s", backupID, b.Status)
+		}
+
+		return b, b.Status, nil
+	}
+}
@@ -38,6 +38,18 @@ func expandDatabaseConfigurationV1Values(rawValues []interface{}) map[string]int
 	return values
 }
 
+// databaseConfigurationV1ValuesRemoved returns true, when a value of the old
+// values is not present in the new values.
+func databaseConfigurationV1ValuesRemoved(o, n map[string]interface{}) bool {
+	for k := range o {
+		if _, ok := n[k]; !ok {
+			return true
+		}
+	}
+
+	return false
+}
+
 // databaseConfigurationV1StateRefreshFunc returns a resource.StateRefreshFunc that is used to watch
 // an cloud database instance.
 func databaseConfigurationV1StateRefreshFunc(client *gophercloud.ServiceClient, cgroupID string) resource.StateRefreshFunc {
@@ -50,3 +50,19 @@ func TestExpandDatabaseConfigurationV1Values(t *testing.T) {
 	actual := expandDatabaseConfigurationV1Values(values)
 	assert.Equal(t, expected, actual)
 }
+
+func TestDatabaseConfigurationV1ValuesRemoved(t *testing.T) {
+	o := map[string]interface{}{
+		"collation_server": "latin1_swedish_ci",
+		"max_connections":  200,
+	}
+
+	assert.False(t, databaseConfigurationV1ValuesRemoved(o, map[string]interface{}{
+		"collation_server": "utf8_general_ci",
+		"max_connections":  200,
+		"wait_timeout":     60,
+	}))
+	assert.True(t, databaseConfigurationV1ValuesRemoved(o, map[string]interface{}{
+		"max_connections": 200,
+	}))
+}
@@ -0,0 +1,118 @@
+package openstack
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/db/v1/datastores"
+	"github.com/gophercloud/gophercloud/openstack/db/v1/flavors"
+)
+
+// databaseDatastoreV1VersionSort represents a sortable slice of datastore
+// versions.
+type databaseDatastoreV1VersionSort []datastores.Version
+
+func (versions databaseDatastoreV1VersionSort) Len() int {
+	return len(versions)
+}
+
+func (versions databaseDatastoreV1VersionSort) Swap(i, j int) {
+	versions[i], versions[j] = versions[j], versions[i]
+}
+
+func (versions databaseDatastoreV1VersionSort) Less(i, j int) bool {
+	return databaseDatastoreV1CompareVersions(versions[i].Name, versions[j].Name) < 0
+}
+
+func dataSourceDatabaseDatastoreV1MostRecentVersion(versions []datastores.Version) datastores.Version {
+	sortedVersions := versions
+	sort.Sort(databaseDatastoreV1VersionSort(sortedVersions))
+	return sortedVersions[len(sortedVersions)-1]
+}
+
+// databaseDatastoreV1CompareVersions compares two datastore version names,
+// e.g. "5.7.29" and "5.7.4". Numeric parts are compared as numbers, other
+// parts are compared as strings.
+func databaseDatastoreV1CompareVersions(a, b string) int {
+	isSeparator := func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}
+	aParts := strings.FieldsFunc(a, isSeparator)
+	bParts := strings.FieldsFunc(b, isSeparator)
+
+	for i := 0; i < len(aParts) && i < len(bParts); i++ {
+		aInt, aErr := strconv.Atoi(aParts[i])
+		bInt, bErr := strconv.Atoi(bParts[i])
+
+		switch {
+		case aErr == nil && bErr == nil:
+			if aInt != bInt {
+				if aInt < bInt {
+					return -1
+				}
+				return 1
+			}
+		case aParts[i] != bParts[i]:
+			return strings.Compare(aParts[i], bParts[i])
+		}
+	}
+
+	switch {
+	case len(aParts) < len(bParts):
+		return -1
+	case len(aParts) > len(bParts):
+		return 1
+	}
+
+	return 0
+}
+
+type databaseDatastoreV1VersionFlavorsResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a databaseDatastoreV1VersionFlavorsResult as a slice of
+// flavors.
+func (r databaseDatastoreV1VersionFlavorsResult) Extract() ([]flavors.Flavor, error) {
+	var s struct {
+		Flavors []flavors.Flavor `json:"flavors"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Flavors, err
+}
+
+// databaseDatastoreV1VersionFlavors lists the flavors, which are compatible
+// with a datastore version.
+func databaseDatastoreV1VersionFlavors(client *gophercloud.ServiceClient, datastoreID, versionID string) (r databaseDatastoreV1VersionFlavorsResult) {
+	resp, err := client.Get(client.ServiceURL("datastores", datastoreID, "versions", versionID, "flavors"), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func flattenDatabaseDatastoreV1Versions(versions []datastores.Version) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(versions))
+	for _, version := range versions {
+		res = append(res, map[string]interface{}{
+			"id":   version.ID,
+			"name": version.Name,
+		})
+	}
+
+	return res
+}
+
+func flattenDatabaseDatastoreV1Flavors(flavorList []flavors.Flavor) []string {
+	res := make([]string, 0, len(flavorList))
+	for _, flavor := range flavorList {
+		if flavor.StrID != "" {
+			res = append(res, flavor.StrID)
+		} else {
+			res = append(res, strconv.Itoa(flavor.ID))
+		}
+	}
+
+	return res
+}
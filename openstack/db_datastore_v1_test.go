@@ -0,0 +1,41 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gophercloud/gophercloud/openstack/db/v1/datastores"
+	"github.com/gophercloud/gophercloud/openstack/db/v1/flavors"
+)
+
+func TestDatabaseDatastoreV1CompareVersions(t *testing.T) {
+	assert.Equal(t, 0, databaseDatastoreV1CompareVersions("5.7.29", "5.7.29"))
+	assert.Equal(t, -1, databaseDatastoreV1CompareVersions("5.7.4", "5.7.29"))
+	assert.Equal(t, 1, databaseDatastoreV1CompareVersions("8.0", "5.7.29"))
+	assert.Equal(t, -1, databaseDatastoreV1CompareVersions("5.7", "5.7.1"))
+	assert.Equal(t, 1, databaseDatastoreV1CompareVersions("10.4-focal", "10.4"))
+	assert.Equal(t, -1, databaseDatastoreV1CompareVersions("12-alpha", "12-beta"))
+}
+
+func TestDataSourceDatabaseDatastoreV1MostRecentVersion(t *testing.T) {
+	versions := []datastores.Version{
+		{ID: "1", Name: "5.7.29"},
+		{ID: "2", Name: "8.0.21"},
+		{ID: "3", Name: "5.7.4"},
+	}
+
+	actual := dataSourceDatabaseDatastoreV1MostRecentVersion(versions)
+	assert.Equal(t, "2", actual.ID)
+}
+
+func TestFlattenDatabaseDatastoreV1Flavors(t *testing.T) {
+	flavorList := []flavors.Flavor{
+		{ID: 1, StrID: ""},
+		{ID: 0, StrID: "d2a4b5a0-1d6f-4f2e-9c1b-5c8d2d3c4e5f"},
+	}
+
+	expected := []string{"1", "d2a4b5a0-1d6f-4f2e-9c1b-5c8d2d3c4e5f"}
+
+	assert.Equal(t, expected, flattenDatabaseDatastoreV1Flavors(flavorList))
+}
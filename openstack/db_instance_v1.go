@@ -12,6 +12,109 @@ import (
 	"github.com/gophercloud/gophercloud/openstack/db/v1/users"
 )
 
+// databaseInstanceV1CreateOpts represents the attributes used when creating a
+// new database instance.
+type databaseInstanceV1CreateOpts struct {
+	instances.CreateOpts
+	ReplicaOf    string
+	ReplicaCount int
+	RestorePoint string
+	Access       *databaseInstanceV1AccessOpts
+}
+
+// databaseInstanceV1AccessOpts represents the public access settings of a
+// database instance.
+type databaseInstanceV1AccessOpts struct {
+	IsPublic     bool     `json:"is_public"`
+	AllowedCIDRs []string `json:"allowed_cidrs"`
+}
+
+// ToInstanceCreateMap casts a CreateOpts struct to a map.
+// It overrides instances.ToInstanceCreateMap to add the replication, restore
+// point and access fields.
+func (opts databaseInstanceV1CreateOpts) ToInstanceCreateMap() (map[string]interface{}, error) {
+	b, err := opts.CreateOpts.ToInstanceCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	instance := b["instance"].(map[string]interface{})
+	if opts.ReplicaOf != "" {
+		instance["replica_of"] = opts.ReplicaOf
+	}
+	if opts.ReplicaCount > 0 {
+		instance["replica_count"] = opts.ReplicaCount
+	}
+	if opts.RestorePoint != "" {
+		instance["restorePoint"] = map[string]string{"backupRef": opts.RestorePoint}
+	}
+	if opts.Access != nil {
+		instance["access"] = opts.Access
+	}
+
+	return b, nil
+}
+
+type databaseInstanceV1ReplicaRef struct {
+	ID string `json:"id"`
+}
+
+// databaseInstanceV1Replication represents the replication status of a
+// database instance.
+type databaseInstanceV1Replication struct {
+	ReplicaOf *databaseInstanceV1ReplicaRef  `json:"replica_of"`
+	Replicas  []databaseInstanceV1ReplicaRef `json:"replicas"`
+}
+
+// databaseInstanceV1ExtractReplication extracts the replication status of a
+// database instance, which is not exposed by instances.Instance.
+func databaseInstanceV1ExtractReplication(r instances.GetResult) (*databaseInstanceV1Replication, error) {
+	var s struct {
+		Instance *databaseInstanceV1Replication `json:"instance"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Instance, err
+}
+
+// databaseInstanceV1ExtractAccess extracts the public access settings of a
+// database instance, which are not exposed by instances.Instance.
+func databaseInstanceV1ExtractAccess(r instances.GetResult) (*databaseInstanceV1AccessOpts, error) {
+	var s struct {
+		Instance struct {
+			Access *databaseInstanceV1AccessOpts `json:"access"`
+		} `json:"instance"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Instance.Access, err
+}
+
+// databaseInstanceV1UpdateAccess updates the public access settings of a
+// database instance.
+func databaseInstanceV1UpdateAccess(client *gophercloud.ServiceClient, id string, opts databaseInstanceV1AccessOpts) (r instances.ActionResult) {
+	b := map[string]interface{}{"instance": map[string]interface{}{"access": opts}}
+	resp, err := client.Put(client.ServiceURL("instances", id), &b, nil, &gophercloud.RequestOpts{OkCodes: []int{202}})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// databaseInstanceV1PromoteToReplicaSource promotes a replica to be the new
+// replication source.
+func databaseInstanceV1PromoteToReplicaSource(client *gophercloud.ServiceClient, id string) (r instances.ActionResult) {
+	b := map[string]interface{}{"promote_to_replica_source": struct{}{}}
+	resp, err := client.Post(client.ServiceURL("instances", id, "action"), &b, nil, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func flattenDatabaseInstanceV1Replicas(replicas []databaseInstanceV1ReplicaRef) []string {
+	res := make([]string, 0, len(replicas))
+	for _, replica := range replicas {
+		res = append(res, replica.ID)
+	}
+
+	return res
+}
+
 func expandDatabaseInstanceV1Datastore(rawDatastore []interface{}) instances.DatastoreOpts {
 	v := rawDatastore[0].(map[string]interface{})
 	datastore := instances.DatastoreOpts{
@@ -22,6 +125,65 @@ func expandDatabaseInstanceV1Datastore(rawDatastore []interface{}) instances.Dat
 	return datastore
 }
 
+func expandDatabaseInstanceV1RestorePoint(rawRestorePoint []interface{}) string {
+	if len(rawRestorePoint) == 0 || rawRestorePoint[0] == nil {
+		return ""
+	}
+
+	v := rawRestorePoint[0].(map[string]interface{})
+
+	return v["backup_ref"].(string)
+}
+
+func expandDatabaseInstanceV1Access(rawAccess []interface{}) *databaseInstanceV1AccessOpts {
+	if len(rawAccess) == 0 || rawAccess[0] == nil {
+		return nil
+	}
+
+	v := rawAccess[0].(map[string]interface{})
+
+	return &databaseInstanceV1AccessOpts{
+		IsPublic:     v["is_public"].(bool),
+		AllowedCIDRs: expandToStringSlice(v["allowed_cidrs"].([]interface{})),
+	}
+}
+
+func flattenDatabaseInstanceV1Access(access *databaseInstanceV1AccessOpts) []map[string]interface{} {
+	if access == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"is_public":     access.IsPublic,
+			"allowed_cidrs": access.AllowedCIDRs,
+		},
+	}
+}
+
+// flattenDatabaseInstanceV1Addresses returns the addresses of a database
+// instance. Older Trove releases only report a flat list of IP addresses.
+func flattenDatabaseInstanceV1Addresses(instance *instances.Instance) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(instance.Addresses))
+	for _, address := range instance.Addresses {
+		res = append(res, map[string]interface{}{
+			"address": address.Address,
+			"type":    address.Type,
+		})
+	}
+
+	if len(res) == 0 {
+		for _, ip := range instance.IP {
+			res = append(res, map[string]interface{}{
+				"address": ip,
+				"type":    "",
+			})
+		}
+	}
+
+	return res
+}
+
 func expandDatabaseInstanceV1Networks(rawNetworks []interface{}) []instances.NetworkOpts {
 	networks := make([]instances.NetworkOpts, 0, len(rawNetworks))
 	for _, v := range rawNetworks {
@@ -66,6 +228,21 @@ func expandDatabaseInstanceV1Users(rawUsers []interface{}) users.BatchCreateOpts
 	return userList
 }
 
+// resourceDatabaseInstanceV1SizeCustomizeDiff rejects decreasing the volume
+// size, which is not supported by Trove.
+func resourceDatabaseInstanceV1SizeCustomizeDiff(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" || !diff.HasChange("size") {
+		return nil
+	}
+
+	o, n := diff.GetChange("size")
+	if n.(int) < o.(int) {
+		return fmt.Errorf("openstack_db_instance_v1 volume size can't be decreased from %d to %d", o.(int), n.(int))
+	}
+
+	return nil
+}
+
 // databaseInstanceV1StateRefreshFunc returns a resource.StateRefreshFunc
 // that is used to watch a database instance.
 func databaseInstanceV1StateRefreshFunc(client *gophercloud.ServiceClient, instanceID string) resource.StateRefreshFunc {
@@ -1,6 +1,7 @@
 package openstack
 
 import (
+	"context"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -105,3 +106,113 @@ func TestExpandDatabaseInstanceV1Users(t *testing.T) {
 	actual := expandDatabaseInstanceV1Users(userList)
 	assert.Equal(t, expected, actual)
 }
+
+func TestDatabaseInstanceV1CreateOpts(t *testing.T) {
+	opts := databaseInstanceV1CreateOpts{
+		CreateOpts: instances.CreateOpts{
+			FlavorRef: "1",
+			Name:      "replica",
+			Size:      10,
+		},
+		ReplicaOf:    "foobar",
+		ReplicaCount: 2,
+		RestorePoint: "bazqux",
+	}
+
+	expected := map[string]interface{}{
+		"instance": map[string]interface{}{
+			"flavorRef":     "1",
+			"name":          "replica",
+			"volume":        map[string]int{"size": 10},
+			"replica_of":    "foobar",
+			"replica_count": 2,
+			"restorePoint":  map[string]string{"backupRef": "bazqux"},
+		},
+	}
+
+	actual, err := opts.ToInstanceCreateMap()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestFlattenDatabaseInstanceV1Replicas(t *testing.T) {
+	replicas := []databaseInstanceV1ReplicaRef{
+		{ID: "foo"},
+		{ID: "bar"},
+	}
+
+	expected := []string{"foo", "bar"}
+
+	actual := flattenDatabaseInstanceV1Replicas(replicas)
+	assert.Equal(t, expected, actual)
+}
+
+func TestExpandDatabaseInstanceV1RestorePoint(t *testing.T) {
+	restorePoint := []interface{}{
+		map[string]interface{}{
+			"backup_ref": "foobar",
+		},
+	}
+
+	assert.Equal(t, "foobar", expandDatabaseInstanceV1RestorePoint(restorePoint))
+	assert.Equal(t, "", expandDatabaseInstanceV1RestorePoint([]interface{}{}))
+}
+
+func TestExpandDatabaseInstanceV1Access(t *testing.T) {
+	access := []interface{}{
+		map[string]interface{}{
+			"is_public":     true,
+			"allowed_cidrs": []interface{}{"10.0.0.0/8"},
+		},
+	}
+
+	expected := &databaseInstanceV1AccessOpts{
+		IsPublic:     true,
+		AllowedCIDRs: []string{"10.0.0.0/8"},
+	}
+
+	assert.Equal(t, expected, expandDatabaseInstanceV1Access(access))
+	assert.Nil(t, expandDatabaseInstanceV1Access([]interface{}{}))
+}
+
+func TestFlattenDatabaseInstanceV1Addresses(t *testing.T) {
+	instance := &instances.Instance{
+		IP: []string{"192.168.0.10"},
+		Addresses: []instances.Address{
+			{Type: "private", Address: "192.168.0.10"},
+			{Type: "public", Address: "172.24.4.10"},
+		},
+	}
+
+	expected := []map[string]interface{}{
+		{"address": "192.168.0.10", "type": "private"},
+		{"address": "172.24.4.10", "type": "public"},
+	}
+
+	assert.Equal(t, expected, flattenDatabaseInstanceV1Addresses(instance))
+
+	instance.Addresses = nil
+	expected = []map[string]interface{}{
+		{"address": "192.168.0.10", "type": ""},
+	}
+
+	assert.Equal(t, expected, flattenDatabaseInstanceV1Addresses(instance))
+}
+
+func TestResourceDatabaseInstanceV1StateUpgradeV0(t *testing.T) {
+	rawState := map[string]interface{}{
+		"name":      "instance_1",
+		"addresses": []interface{}{"10.0.0.5", "2001:db8::5"},
+	}
+
+	actual, err := resourceDatabaseInstanceV1StateUpgradeV0(context.Background(), rawState, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"address": "10.0.0.5", "type": ""},
+		map[string]interface{}{"address": "2001:db8::5", "type": ""},
+	}, actual["addresses"])
+
+	actual, err = resourceDatabaseInstanceV1StateUpgradeV0(context.Background(), map[string]interface{}{"name": "instance_1"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{}, actual["addresses"])
+}
@@ -0,0 +1,104 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// dnsPTRRecordV2 represents the reverse DNS record of a floating IP.
+type dnsPTRRecordV2 struct {
+	ID          string `json:"id"`
+	PTRDName    string `json:"ptrdname"`
+	Description string `json:"description"`
+	TTL         int    `json:"ttl"`
+	Address     string `json:"address"`
+	Status      string `json:"status"`
+	Action      string `json:"action"`
+}
+
+type dnsPTRRecordV2Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets a dnsPTRRecordV2Result as a PTR record.
+func (r dnsPTRRecordV2Result) Extract() (*dnsPTRRecordV2, error) {
+	var s dnsPTRRecordV2
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+func dnsPTRRecordV2URL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("reverse", "floatingips", id)
+}
+
+func dnsPTRRecordV2Get(client *gophercloud.ServiceClient, id string) (r dnsPTRRecordV2Result) {
+	resp, err := client.Get(dnsPTRRecordV2URL(client, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// dnsPTRRecordV2Set sets or unsets the PTR record of a floating IP. A nil
+// ptrdname unsets the record.
+func dnsPTRRecordV2Set(client *gophercloud.ServiceClient, id string, b map[string]interface{}) (r dnsPTRRecordV2Result) {
+	resp, err := client.Patch(dnsPTRRecordV2URL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func dnsPTRRecordV2RefreshFunc(dnsClient *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		ptr, err := dnsPTRRecordV2Get(dnsClient, id).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return ptr, "DELETED", nil
+			}
+
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] openstack_dns_ptr_record_v2 %s current status: %s", ptr.ID, ptr.Status)
+		return ptr, ptr.Status, nil
+	}
+}
+
+func waitForDNSPTRRecordV2(ctx context.Context, dnsClient *gophercloud.ServiceClient, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{"ACTIVE"},
+		Pending:    []string{"PENDING"},
+		Refresh:    dnsPTRRecordV2RefreshFunc(dnsClient, id),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("Error waiting for openstack_dns_ptr_record_v2 %s to become active: %s", id, err)
+	}
+
+	return nil
+}
+
+// Designate identifies the PTR record of a floating IP by
+// <region>:<floatingip_id>.
+func dnsPTRRecordV2ID(region, floatingIPID string) string {
+	return fmt.Sprintf("%s:%s", region, floatingIPID)
+}
+
+func dnsPTRRecordV2ParseID(id string) (string, string, error) {
+	idParts := strings.SplitN(id, ":", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("Unable to determine openstack_dns_ptr_record_v2 ID from raw ID: %s, expected <region>:<floatingip_id>", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
@@ -0,0 +1,23 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDNSPTRRecordV2ParseID(t *testing.T) {
+	id := dnsPTRRecordV2ID("RegionOne", "fip")
+	assert.Equal(t, "RegionOne:fip", id)
+
+	region, floatingIPID, err := dnsPTRRecordV2ParseID(id)
+	assert.NoError(t, err)
+	assert.Equal(t, "RegionOne", region)
+	assert.Equal(t, "fip", floatingIPID)
+
+	_, _, err = dnsPTRRecordV2ParseID("fip")
+	assert.Error(t, err)
+
+	_, _, err = dnsPTRRecordV2ParseID(":fip")
+	assert.Error(t, err)
+}
@@ -0,0 +1,62 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// dnsQuotaV2 represents the Designate quota of a project.
+type dnsQuotaV2 struct {
+	APIExportSize    int `json:"api_export_size"`
+	RecordsetRecords int `json:"recordset_records"`
+	ZoneRecords      int `json:"zone_records"`
+	ZoneRecordsets   int `json:"zone_recordsets"`
+	Zones            int `json:"zones"`
+}
+
+// dnsQuotaV2UpdateOpts represents the attributes used when updating a quota.
+type dnsQuotaV2UpdateOpts struct {
+	APIExportSize    *int `json:"api_export_size,omitempty"`
+	RecordsetRecords *int `json:"recordset_records,omitempty"`
+	ZoneRecords      *int `json:"zone_records,omitempty"`
+	ZoneRecordsets   *int `json:"zone_recordsets,omitempty"`
+	Zones            *int `json:"zones,omitempty"`
+}
+
+type dnsQuotaV2Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets a dnsQuotaV2Result as a quota.
+func (r dnsQuotaV2Result) Extract() (*dnsQuotaV2, error) {
+	var s dnsQuotaV2
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+func dnsQuotaV2Get(client *gophercloud.ServiceClient, projectID string) (r dnsQuotaV2Result) {
+	resp, err := client.Get(client.ServiceURL("quotas", projectID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func dnsQuotaV2Update(client *gophercloud.ServiceClient, projectID string, opts dnsQuotaV2UpdateOpts) (r dnsQuotaV2Result) {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Patch(client.ServiceURL("quotas", projectID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// dnsQuotaV2Delete resets the quota of a project to the default values.
+func dnsQuotaV2Delete(client *gophercloud.ServiceClient, projectID string) (r gophercloud.ErrResult) {
+	resp, err := client.Delete(client.ServiceURL("quotas", projectID), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -3,13 +3,16 @@ package openstack
 import (
 	"fmt"
 	"log"
+	"net"
 	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/dns/v2/recordsets"
+	"github.com/gophercloud/utils/terraform/hashcode"
 )
 
 // RecordSetCreateOpts represents the attributes used when creating a new DNS record set.
@@ -87,3 +90,113 @@ func dnsRecordSetV2RecordsStateFunc(v interface{}) string {
 
 	return ""
 }
+
+// dnsRecordSetV2CanonicalRecord returns the record in a form, which doesn't
+// depend on its formatting, but keeps its case. IP addresses are returned in
+// their canonical form and quoted TXT and SPF records without quotes and with
+// their chunks joined.
+func dnsRecordSetV2CanonicalRecord(record string) string {
+	record = strings.TrimSpace(dnsRecordSetV2RecordsStateFunc(record))
+
+	if ip := net.ParseIP(record); ip != nil {
+		return ip.String()
+	}
+
+	if strings.HasPrefix(record, `"`) {
+		return dnsRecordSetV2UnquoteTXT(record)
+	}
+
+	return record
+}
+
+// dnsRecordSetV2NormalizeRecord returns the record in the form used to
+// compare it with the record returned by Designate. The records of the
+// CNAME, MX, NS, PTR and SRV types point to DNS names, which are compared in
+// lowercase and without their trailing dot. The records of the other types,
+// e.g. TXT, are case sensitive and only compared in their canonical form.
+func dnsRecordSetV2NormalizeRecord(recordType, record string) string {
+	record = dnsRecordSetV2CanonicalRecord(record)
+
+	switch strings.ToUpper(recordType) {
+	case "CNAME", "MX", "NS", "PTR", "SRV":
+		return strings.ToLower(strings.TrimSuffix(record, "."))
+	}
+
+	return record
+}
+
+// dnsRecordSetV2RecordsHash hashes the canonical record, so that records
+// which only differ in their formatting are considered equal. The hash
+// doesn't know the type of the record, so it keeps the case of the record.
+func dnsRecordSetV2RecordsHash(v interface{}) int {
+	return hashcode.String(dnsRecordSetV2CanonicalRecord(v.(string)))
+}
+
+// dnsRecordSetV2UnquoteTXT joins the quoted chunks of a TXT record,
+// e.g. "foo" "bar" becomes foobar. Unquoted records are returned as is.
+func dnsRecordSetV2UnquoteTXT(record string) string {
+	if len(record) < 2 || !strings.HasPrefix(record, `"`) || !strings.HasSuffix(record, `"`) {
+		return record
+	}
+
+	var b strings.Builder
+	var quoted, escaped bool
+	for _, c := range record {
+		switch {
+		case escaped:
+			b.WriteRune(c)
+			escaped = false
+		case quoted && c == '\\':
+			escaped = true
+		case c == '"':
+			quoted = !quoted
+		case quoted:
+			b.WriteRune(c)
+		}
+	}
+
+	return b.String()
+}
+
+// dnsRecordSetV2RecordDiffSuppressFunc suppresses the diff of a record, which
+// only differs in its formatting for the type of the record set.
+func dnsRecordSetV2RecordDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	recordType := d.Get("type").(string)
+	return dnsRecordSetV2NormalizeRecord(recordType, old) == dnsRecordSetV2NormalizeRecord(recordType, new)
+}
+
+// dnsRecordSetV2RecordsDiffSuppressFunc suppresses the diff of the records,
+// when the old and the new records are the same for the type of the record
+// set.
+func dnsRecordSetV2RecordsDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	if d.HasChange("type") {
+		return false
+	}
+
+	recordType := d.Get("type").(string)
+	o, n := d.GetChange("records")
+	oldRecords := dnsRecordSetV2NormalizeRecords(recordType, o.(*schema.Set))
+	newRecords := dnsRecordSetV2NormalizeRecords(recordType, n.(*schema.Set))
+
+	if len(oldRecords) != len(newRecords) {
+		return false
+	}
+
+	for record := range newRecords {
+		if !oldRecords[record] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// dnsRecordSetV2NormalizeRecords returns the normalized records of the set.
+func dnsRecordSetV2NormalizeRecords(recordType string, records *schema.Set) map[string]bool {
+	normalized := make(map[string]bool, records.Len())
+	for _, record := range records.List() {
+		normalized[dnsRecordSetV2NormalizeRecord(recordType, record.(string))] = true
+	}
+
+	return normalized
+}
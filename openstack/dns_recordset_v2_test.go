@@ -1,8 +1,11 @@
 package openstack
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -26,3 +29,150 @@ func TestDNSRecordSetV2RecordsStateFunc(t *testing.T) {
 		assert.Equal(t, expected[i], actual)
 	}
 }
+
+func TestDNSRecordSetV2NormalizeRecord(t *testing.T) {
+	testCases := []struct {
+		recordType string
+		record     string
+		expected   string
+	}{
+		{"A", "192.0.2.1", "192.0.2.1"},
+		{"AAAA", "2001:db8::1", "2001:db8::1"},
+		{"AAAA", "2001:DB8::1", "2001:db8::1"},
+		{"AAAA", "[2001:db8::1]", "2001:db8::1"},
+		{"AAAA", "0:0:0:0:0:0:0:1", "::1"},
+		{"AAAA", "2001:0db8:0000:0000:0000:0000:0000:0001", "2001:db8::1"},
+		{"CNAME", "foo.example.com.", "foo.example.com"},
+		{"CNAME", "Foo.Example.com", "foo.example.com"},
+		{"MX", "10 mail.example.com.", "10 mail.example.com"},
+		{"NS", "ns1.example.com.", "ns1.example.com"},
+		{"SRV", "10 5 5060 sip.example.com.", "10 5 5060 sip.example.com"},
+		{"PTR", "Host.Example.com.", "host.example.com"},
+		{"SPF", `"v=spf1 -all"`, "v=spf1 -all"},
+		{"SPF", "v=spf1 -all", "v=spf1 -all"},
+		{"TXT", `"foo" "bar"`, "foobar"},
+		{"TXT", `"say \"Hi\""`, `say "Hi"`},
+		{"TXT", "Hello World.", "Hello World."},
+		{"TXT", `"Hello World."`, "Hello World."},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, dnsRecordSetV2NormalizeRecord(tc.recordType, tc.record), tc.record)
+	}
+}
+
+func TestDNSRecordSetV2RecordsHash(t *testing.T) {
+	assert.Equal(t, dnsRecordSetV2RecordsHash("::1"), dnsRecordSetV2RecordsHash("0:0:0:0:0:0:0:1"))
+	assert.Equal(t, dnsRecordSetV2RecordsHash("2001:db8::1"), dnsRecordSetV2RecordsHash("[2001:DB8::1]"))
+	assert.Equal(t, dnsRecordSetV2RecordsHash("Hello"), dnsRecordSetV2RecordsHash(`"Hello"`))
+	assert.NotEqual(t, dnsRecordSetV2RecordsHash("Hello"), dnsRecordSetV2RecordsHash("hello"))
+	assert.NotEqual(t, dnsRecordSetV2RecordsHash("192.0.2.1"), dnsRecordSetV2RecordsHash("192.0.2.2"))
+	assert.NotEqual(t, dnsRecordSetV2RecordsHash("10 mail.example.com."), dnsRecordSetV2RecordsHash("20 mail.example.com."))
+}
+
+func TestResourceDNSRecordSetV2RecordsDiff(t *testing.T) {
+	r := resourceDNSRecordSetV2()
+
+	state := &terraform.InstanceState{
+		ID: "zone-1/recordset-1",
+		Attributes: map[string]string{
+			"zone_id":              "zone-1",
+			"name":                 "www.example.com.",
+			"type":                 "AAAA",
+			"ttl":                  "3000",
+			"disable_status_check": "false",
+			"zone_share":           "false",
+			"records.#":            "2",
+			fmt.Sprintf("records.%d", dnsRecordSetV2RecordsHash("2001:db8::1")): "2001:db8::1",
+			fmt.Sprintf("records.%d", dnsRecordSetV2RecordsHash("::1")):         "::1",
+		},
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"zone_id": "zone-1",
+		"name":    "www.example.com.",
+		"type":    "AAAA",
+		"records": []interface{}{"0:0:0:0:0:0:0:1", "[2001:DB8::1]"},
+	})
+
+	diff, err := r.Diff(context.Background(), state, config, nil)
+	assert.NoError(t, err)
+	assert.True(t, diff == nil || diff.Empty(), "%#v", diff)
+
+	config = terraform.NewResourceConfigRaw(map[string]interface{}{
+		"zone_id": "zone-1",
+		"name":    "www.example.com.",
+		"type":    "AAAA",
+		"records": []interface{}{"0:0:0:0:0:0:0:1", "2001:db8::1", "2001:db8::2"},
+	})
+
+	diff, err = r.Diff(context.Background(), state, config, nil)
+	assert.NoError(t, err)
+	if assert.NotNil(t, diff) {
+		assert.Equal(t, "3", diff.Attributes["records.#"].New)
+		unchanged := diff.Attributes[fmt.Sprintf("records.%d", dnsRecordSetV2RecordsHash("::1"))]
+		if assert.NotNil(t, unchanged) {
+			assert.Equal(t, unchanged.Old, unchanged.New)
+		}
+		assert.False(t, diff.RequiresNew())
+	}
+}
+
+func TestResourceDNSRecordSetV2RecordsDiffType(t *testing.T) {
+	r := resourceDNSRecordSetV2()
+
+	newState := func(recordType, record string) *terraform.InstanceState {
+		return &terraform.InstanceState{
+			ID: "zone-1/recordset-1",
+			Attributes: map[string]string{
+				"zone_id":              "zone-1",
+				"name":                 "www.example.com.",
+				"type":                 recordType,
+				"ttl":                  "3000",
+				"disable_status_check": "false",
+				"zone_share":           "false",
+				"records.#":            "1",
+				fmt.Sprintf("records.%d", dnsRecordSetV2RecordsHash(record)): record,
+			},
+		}
+	}
+
+	newConfig := func(recordType, record string) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"zone_id": "zone-1",
+			"name":    "www.example.com.",
+			"type":    recordType,
+			"records": []interface{}{record},
+		})
+	}
+
+	testCases := []struct {
+		recordType string
+		old        string
+		new        string
+		changed    bool
+	}{
+		{"CNAME", "Foo.Example.com.", "foo.example.com", false},
+		{"MX", "10 mail.example.com.", "10 Mail.example.com", false},
+		{"TXT", `"Hello World"`, "Hello World", false},
+		{"TXT", `"Hello World"`, "hello world", true},
+		{"TXT", "Hello World", "hello world", true},
+		{"TXT", "Hello World.", "Hello World", true},
+	}
+
+	for _, tc := range testCases {
+		diff, err := r.Diff(context.Background(), newState(tc.recordType, tc.old), newConfig(tc.recordType, tc.new), nil)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.changed, diff != nil && !diff.Empty(), "%s %s -> %s", tc.recordType, tc.old, tc.new)
+	}
+}
+
+func TestResourceDNSRecordSetV2StateUpgradeV0(t *testing.T) {
+	rawState := map[string]interface{}{
+		"records": []interface{}{"::1", "2001:db8::1", "0:0:0:0:0:0:0:1"},
+	}
+
+	actual, err := resourceDNSRecordSetV2StateUpgradeV0(context.Background(), rawState, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"::1", "2001:db8::1"}, actual["records"])
+}
@@ -0,0 +1,97 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// dnsTSIGKeyV2Algorithms contains the TSIG key algorithms supported by
+// Designate.
+var dnsTSIGKeyV2Algorithms = []string{
+	"hmac-md5",
+	"hmac-sha1",
+	"hmac-sha224",
+	"hmac-sha256",
+	"hmac-sha384",
+	"hmac-sha512",
+}
+
+// dnsTSIGKeyV2 represents a Designate TSIG key.
+type dnsTSIGKeyV2 struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Algorithm  string `json:"algorithm"`
+	Secret     string `json:"secret"`
+	Scope      string `json:"scope"`
+	ResourceID string `json:"resource_id"`
+}
+
+// dnsTSIGKeyV2CreateOpts represents the attributes used when creating a TSIG
+// key.
+type dnsTSIGKeyV2CreateOpts struct {
+	Name       string `json:"name" required:"true"`
+	Algorithm  string `json:"algorithm" required:"true"`
+	Secret     string `json:"secret" required:"true"`
+	Scope      string `json:"scope" required:"true"`
+	ResourceID string `json:"resource_id" required:"true"`
+}
+
+// dnsTSIGKeyV2UpdateOpts represents the attributes used when updating a TSIG
+// key.
+type dnsTSIGKeyV2UpdateOpts struct {
+	Name      *string `json:"name,omitempty"`
+	Algorithm *string `json:"algorithm,omitempty"`
+	Secret    *string `json:"secret,omitempty"`
+}
+
+type dnsTSIGKeyV2Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets a dnsTSIGKeyV2Result as a TSIG key.
+func (r dnsTSIGKeyV2Result) Extract() (*dnsTSIGKeyV2, error) {
+	var s dnsTSIGKeyV2
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+func dnsTSIGKeyV2URL(client *gophercloud.ServiceClient, parts ...string) string {
+	return client.ServiceURL(append([]string{"tsigkeys"}, parts...)...)
+}
+
+func dnsTSIGKeyV2Create(client *gophercloud.ServiceClient, opts dnsTSIGKeyV2CreateOpts) (r dnsTSIGKeyV2Result) {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(dnsTSIGKeyV2URL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func dnsTSIGKeyV2Get(client *gophercloud.ServiceClient, id string) (r dnsTSIGKeyV2Result) {
+	resp, err := client.Get(dnsTSIGKeyV2URL(client, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func dnsTSIGKeyV2Update(client *gophercloud.ServiceClient, id string, opts dnsTSIGKeyV2UpdateOpts) (r dnsTSIGKeyV2Result) {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Patch(dnsTSIGKeyV2URL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func dnsTSIGKeyV2Delete(client *gophercloud.ServiceClient, id string) (r gophercloud.ErrResult) {
+	resp, err := client.Delete(dnsTSIGKeyV2URL(client, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
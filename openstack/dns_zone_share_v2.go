@@ -0,0 +1,103 @@
+package openstack
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// dnsZoneShareV2 represents a Designate zone share.
+type dnsZoneShareV2 struct {
+	ID              string `json:"id"`
+	ZoneID          string `json:"zone_id"`
+	ProjectID       string `json:"project_id"`
+	TargetProjectID string `json:"target_project_id"`
+}
+
+// dnsZoneShareV2CreateOpts represents the attributes used when sharing a zone.
+type dnsZoneShareV2CreateOpts struct {
+	TargetProjectID string `json:"target_project_id" required:"true"`
+}
+
+type dnsZoneShareV2Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets a dnsZoneShareV2Result as a zone share.
+func (r dnsZoneShareV2Result) Extract() (*dnsZoneShareV2, error) {
+	var s dnsZoneShareV2
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+type dnsZoneSharesV2Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets a dnsZoneSharesV2Result as a list of zone shares.
+func (r dnsZoneSharesV2Result) Extract() ([]dnsZoneShareV2, error) {
+	var s struct {
+		SharedZones []dnsZoneShareV2 `json:"shared_zones"`
+	}
+	err := r.ExtractInto(&s)
+	return s.SharedZones, err
+}
+
+func dnsZoneShareV2Create(client *gophercloud.ServiceClient, zoneID string, opts dnsZoneShareV2CreateOpts) (r dnsZoneShareV2Result) {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(client.ServiceURL("zones", zoneID, "shares"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func dnsZoneShareV2Get(client *gophercloud.ServiceClient, zoneID, shareID string) (r dnsZoneShareV2Result) {
+	resp, err := client.Get(client.ServiceURL("zones", zoneID, "shares", shareID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func dnsZoneShareV2Delete(client *gophercloud.ServiceClient, zoneID, shareID string) (r gophercloud.ErrResult) {
+	resp, err := client.Delete(client.ServiceURL("zones", zoneID, "shares", shareID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func dnsZoneSharesV2List(client *gophercloud.ServiceClient, zoneID string) (r dnsZoneSharesV2Result) {
+	resp, err := client.Get(client.ServiceURL("zones", zoneID, "shares"), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func dnsZoneShareV2ParseID(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("Unable to determine openstack_dns_zone_share_v2 ID from raw ID: %s", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
+
+func flattenDNSZoneSharesV2(shares []dnsZoneShareV2, targetProjectID string) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(shares))
+
+	for _, share := range shares {
+		if targetProjectID != "" && share.TargetProjectID != targetProjectID {
+			continue
+		}
+
+		res = append(res, map[string]interface{}{
+			"id":                share.ID,
+			"project_id":        share.ProjectID,
+			"target_project_id": share.TargetProjectID,
+		})
+	}
+
+	return res
+}
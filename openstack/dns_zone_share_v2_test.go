@@ -0,0 +1,38 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDNSZoneShareV2ParseID(t *testing.T) {
+	zoneID, shareID, err := dnsZoneShareV2ParseID("foo/bar")
+	assert.NoError(t, err)
+	assert.Equal(t, "foo", zoneID)
+	assert.Equal(t, "bar", shareID)
+
+	_, _, err = dnsZoneShareV2ParseID("foo")
+	assert.Error(t, err)
+
+	_, _, err = dnsZoneShareV2ParseID("foo/")
+	assert.Error(t, err)
+}
+
+func TestFlattenDNSZoneSharesV2(t *testing.T) {
+	shares := []dnsZoneShareV2{
+		{ID: "share_1", ZoneID: "zone", ProjectID: "owner", TargetProjectID: "project_1"},
+		{ID: "share_2", ZoneID: "zone", ProjectID: "owner", TargetProjectID: "project_2"},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"id":                "share_2",
+			"project_id":        "owner",
+			"target_project_id": "project_2",
+		},
+	}
+
+	assert.Equal(t, expected, flattenDNSZoneSharesV2(shares, "project_2"))
+	assert.Len(t, flattenDNSZoneSharesV2(shares, ""), 2)
+}
@@ -57,8 +57,15 @@ func dnsClientSetAuthHeader(resourceData *schema.ResourceData, dnsClient *gopher
 		}
 	}
 
+	// Recordsets of a shared zone inherit the project_id of the zone owner,
+	// the sudo header must not be set when managing them from the target project.
+	zoneShare := false
+	if v, ok := resourceData.GetOk("zone_share"); ok {
+		zoneShare = v.(bool)
+	}
+
 	// If project_id is different from auth one, set AuthSudo header
-	if v, ok := resourceData.GetOk("project_id"); ok {
+	if v, ok := resourceData.GetOk("project_id"); ok && !zoneShare {
 		if projectID, ok := v.(string); ok {
 			if project != nil && project.ID != projectID {
 				headers[headerAuthSudoTenantID] = projectID
@@ -118,3 +125,13 @@ func getProjectFromToken(dnsClient *gophercloud.ServiceClient) (*tokens.Project,
 	}
 	return project, nil
 }
+
+// dnsZoneV2TriggerXFR forces a secondary zone to transfer the zone from its
+// masters.
+func dnsZoneV2TriggerXFR(client *gophercloud.ServiceClient, zoneID string) (r gophercloud.ErrResult) {
+	resp, err := client.Post(client.ServiceURL("zones", zoneID, "tasks", "xfr"), nil, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
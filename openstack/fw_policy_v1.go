@@ -1,6 +1,9 @@
 package openstack
 
 import (
+	"fmt"
+	"log"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	"github.com/gophercloud/gophercloud"
@@ -36,3 +39,90 @@ func fwPolicyV1DeleteFunc(networkingClient *gophercloud.ServiceClient, id string
 		return nil, "ACTIVE", err
 	}
 }
+
+// fwPolicyV1RuleMoves returns the rules to remove from a policy and the
+// rules to insert, in order, to turn the oldRules into the newRules. The
+// longest common subsequence of both lists is kept in place, so that only
+// the added, removed and moved rules are touched.
+func fwPolicyV1RuleMoves(oldRules, newRules []string) ([]string, []policies.InsertRuleOpts) {
+	// lcs[i][j] is the length of the longest common subsequence of
+	// oldRules[i:] and newRules[j:].
+	lcs := make([][]int, len(oldRules)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(newRules)+1)
+	}
+	for i := len(oldRules) - 1; i >= 0; i-- {
+		for j := len(newRules) - 1; j >= 0; j-- {
+			if oldRules[i] == newRules[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	keepOld := make(map[int]bool)
+	keepNew := make(map[int]bool)
+	for i, j := 0, 0; i < len(oldRules) && j < len(newRules); {
+		switch {
+		case oldRules[i] == newRules[j]:
+			keepOld[i] = true
+			keepNew[j] = true
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+
+	var remove []string
+	for i, rule := range oldRules {
+		if !keepOld[i] {
+			remove = append(remove, rule)
+		}
+	}
+
+	// Each rule is inserted after its predecessor, which is either kept or
+	// inserted before. The first rule is inserted at the top of the policy.
+	var insert []policies.InsertRuleOpts
+	for j, rule := range newRules {
+		if keepNew[j] {
+			continue
+		}
+
+		opts := policies.InsertRuleOpts{ID: rule}
+		if j > 0 {
+			opts.AfterRuleID = newRules[j-1]
+		}
+		insert = append(insert, opts)
+	}
+
+	return remove, insert
+}
+
+// fwPolicyV1UpdateRules reorders the rules of a policy with the insert_rule
+// and remove_rule actions, so that the unchanged rules stay in the policy
+// instead of replacing the whole list at once.
+func fwPolicyV1UpdateRules(networkingClient *gophercloud.ServiceClient, id string, oldRules, newRules []string) error {
+	remove, insert := fwPolicyV1RuleMoves(oldRules, newRules)
+
+	for _, rule := range remove {
+		log.Printf("[DEBUG] Removing openstack_fw_rule_v1 %s from openstack_fw_policy_v1 %s", rule, id)
+		if err := policies.RemoveRule(networkingClient, id, rule).Err; err != nil {
+			return fmt.Errorf("Error removing openstack_fw_rule_v1 %s: %s", rule, err)
+		}
+	}
+
+	for _, opts := range insert {
+		log.Printf("[DEBUG] Inserting openstack_fw_rule_v1 into openstack_fw_policy_v1 %s: %#v", id, opts)
+		if err := policies.AddRule(networkingClient, id, opts).Err; err != nil {
+			return fmt.Errorf("Error inserting openstack_fw_rule_v1 %s: %s", opts.ID, err)
+		}
+	}
+
+	return nil
+}
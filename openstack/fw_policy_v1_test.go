@@ -0,0 +1,111 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/fwaas/policies"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestFWPolicyV1RuleMoves(t *testing.T) {
+	testCases := []struct {
+		oldRules []string
+		newRules []string
+		remove   []string
+		insert   []policies.InsertRuleOpts
+	}{
+		{
+			[]string{"a", "b", "c"},
+			[]string{"a", "b", "c"},
+			nil,
+			nil,
+		},
+		{
+			[]string{"a", "b", "c"},
+			[]string{"c", "a", "b"},
+			[]string{"c"},
+			[]policies.InsertRuleOpts{{ID: "c"}},
+		},
+		{
+			[]string{"a", "b", "c", "d"},
+			[]string{"a", "c", "e", "d"},
+			[]string{"b"},
+			[]policies.InsertRuleOpts{{ID: "e", AfterRuleID: "c"}},
+		},
+		{
+			[]string{},
+			[]string{"a", "b"},
+			nil,
+			[]policies.InsertRuleOpts{{ID: "a"}, {ID: "b", AfterRuleID: "a"}},
+		},
+		{
+			[]string{"a", "b"},
+			[]string{},
+			[]string{"a", "b"},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		remove, insert := fwPolicyV1RuleMoves(tc.oldRules, tc.newRules)
+		assert.Equal(t, tc.remove, remove)
+		assert.Equal(t, tc.insert, insert)
+	}
+}
+
+func TestFWPolicyV1UpdateRules(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var requests []string
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+
+		if r.Method == "PUT" {
+			body, err := ioutil.ReadAll(r.Body)
+			assert.NoError(t, err)
+			requests = append(requests, fmt.Sprintf("%s %s", r.URL.Path, body))
+			fmt.Fprint(w, `{"firewall_policy": {"id": "policy-1"}}`)
+			return
+		}
+
+		fmt.Fprint(w, `{"firewall_policy": {"id": "policy-1", "audited": true, "firewall_rules": ["rule-2", "rule-1"]}}`)
+	}
+	th.Mux.HandleFunc("/fw/firewall_policies/policy-1", handler)
+	th.Mux.HandleFunc("/fw/firewall_policies/policy-1/insert_rule", handler)
+	th.Mux.HandleFunc("/fw/firewall_policies/policy-1/remove_rule", handler)
+
+	config := testAccUnitConfig("network")
+
+	state := &terraform.InstanceState{
+		ID: "policy-1",
+		Attributes: map[string]string{
+			"audited": "true",
+			"rules.#": "2",
+			"rules.0": "rule-1",
+			"rules.1": "rule-2",
+		},
+	}
+	diff := &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"rules.0": {Old: "rule-1", New: "rule-2"},
+			"rules.1": {Old: "rule-2", New: "rule-1"},
+		},
+	}
+
+	_, diags := resourceFWPolicyV1().Apply(context.Background(), state, diff, config)
+
+	assert.False(t, diags.HasError())
+	if assert.Len(t, requests, 3) {
+		assert.Equal(t, `/fw/firewall_policies/policy-1/remove_rule {"firewall_rule_id":"rule-1"}`, requests[0])
+		assert.Equal(t, `/fw/firewall_policies/policy-1/insert_rule {"firewall_rule_id":"rule-1","insert_after":"rule-2"}`, requests[1])
+		assert.Equal(t, `/fw/firewall_policies/policy-1 {"firewall_policy":{"audited":true}}`, requests[2])
+	}
+}
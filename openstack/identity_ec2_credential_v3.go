@@ -0,0 +1,19 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/ec2credentials"
+)
+
+// identityEc2CredentialV3FilterByProject returns the EC2 credentials
+// which are scoped to the given project.
+func identityEc2CredentialV3FilterByProject(credentials []ec2credentials.Credential, projectID string) []ec2credentials.Credential {
+	var res []ec2credentials.Credential
+
+	for _, c := range credentials {
+		if c.TenantID == projectID {
+			res = append(res, c)
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,28 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/ec2credentials"
+)
+
+func TestIdentityEc2CredentialV3FilterByProject(t *testing.T) {
+	credentials := []ec2credentials.Credential{
+		{Access: "a1", TenantID: "p1"},
+		{Access: "a2", TenantID: "p2"},
+		{Access: "a3", TenantID: "p1"},
+	}
+
+	expected := []ec2credentials.Credential{
+		{Access: "a1", TenantID: "p1"},
+		{Access: "a3", TenantID: "p1"},
+	}
+
+	actual := identityEc2CredentialV3FilterByProject(credentials, "p1")
+	assert.Equal(t, expected, actual)
+
+	actual = identityEc2CredentialV3FilterByProject(credentials, "p3")
+	assert.Empty(t, actual)
+}
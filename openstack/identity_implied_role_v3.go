@@ -0,0 +1,118 @@
+package openstack
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// identityImpliedRoleV3Role represents a role referenced by an inference rule.
+type identityImpliedRoleV3Role struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// identityImpliedRoleV3 represents a single role inference rule.
+type identityImpliedRoleV3 struct {
+	PriorRole identityImpliedRoleV3Role `json:"prior_role"`
+	Implies   identityImpliedRoleV3Role `json:"implies"`
+}
+
+// identityRoleInferenceV3 represents all roles implied by a prior role,
+// as returned by the role_inferences API.
+type identityRoleInferenceV3 struct {
+	PriorRole identityImpliedRoleV3Role   `json:"prior_role"`
+	Implies   []identityImpliedRoleV3Role `json:"implies"`
+}
+
+type identityImpliedRoleV3Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets an identityImpliedRoleV3Result as an inference rule.
+func (r identityImpliedRoleV3Result) Extract() (*identityImpliedRoleV3, error) {
+	var s struct {
+		RoleInference *identityImpliedRoleV3 `json:"role_inference"`
+	}
+	err := r.ExtractInto(&s)
+	return s.RoleInference, err
+}
+
+type identityRoleInferencesV3Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets an identityRoleInferencesV3Result as a list of inference rules.
+func (r identityRoleInferencesV3Result) Extract() ([]identityRoleInferenceV3, error) {
+	var s struct {
+		RoleInferences []identityRoleInferenceV3 `json:"role_inferences"`
+	}
+	err := r.ExtractInto(&s)
+	return s.RoleInferences, err
+}
+
+func identityImpliedRoleV3URL(client *gophercloud.ServiceClient, priorRoleID, impliedRoleID string) string {
+	return client.ServiceURL("roles", priorRoleID, "implies", impliedRoleID)
+}
+
+func identityImpliedRoleV3Create(client *gophercloud.ServiceClient, priorRoleID, impliedRoleID string) (r identityImpliedRoleV3Result) {
+	resp, err := client.Put(identityImpliedRoleV3URL(client, priorRoleID, impliedRoleID), nil, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func identityImpliedRoleV3Get(client *gophercloud.ServiceClient, priorRoleID, impliedRoleID string) (r identityImpliedRoleV3Result) {
+	resp, err := client.Get(identityImpliedRoleV3URL(client, priorRoleID, impliedRoleID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func identityImpliedRoleV3Delete(client *gophercloud.ServiceClient, priorRoleID, impliedRoleID string) (r gophercloud.ErrResult) {
+	resp, err := client.Delete(identityImpliedRoleV3URL(client, priorRoleID, impliedRoleID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func identityRoleInferencesV3List(client *gophercloud.ServiceClient) (r identityRoleInferencesV3Result) {
+	resp, err := client.Get(client.ServiceURL("role_inferences"), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Implied roles have no ID in OpenStack.
+// Build an ID out of the prior and implied role IDs.
+func identityImpliedRoleV3ID(priorRoleID, impliedRoleID string) string {
+	return fmt.Sprintf("%s/%s", priorRoleID, impliedRoleID)
+}
+
+func identityImpliedRoleV3ParseID(id string) (string, string, error) {
+	split := strings.Split(id, "/")
+
+	if len(split) != 2 || split[0] == "" || split[1] == "" {
+		return "", "", fmt.Errorf("Malformed ID: %s, expected <prior_role_id>/<implied_role_id>", id)
+	}
+
+	return split[0], split[1], nil
+}
+
+// flattenIdentityRoleInferencesV3 converts the nested role_inferences
+// response into a flat list with one entry per inference rule.
+func flattenIdentityRoleInferencesV3(inferences []identityRoleInferenceV3) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(inferences))
+
+	for _, inference := range inferences {
+		for _, implied := range inference.Implies {
+			res = append(res, map[string]interface{}{
+				"prior_role_id":     inference.PriorRole.ID,
+				"prior_role_name":   inference.PriorRole.Name,
+				"implied_role_id":   implied.ID,
+				"implied_role_name": implied.Name,
+			})
+		}
+	}
+
+	return res
+}
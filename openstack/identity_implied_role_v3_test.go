@@ -0,0 +1,68 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdentityImpliedRoleV3ID(t *testing.T) {
+	expected := "prior/implied"
+	actual := identityImpliedRoleV3ID("prior", "implied")
+	assert.Equal(t, expected, actual)
+}
+
+func TestIdentityImpliedRoleV3ParseID(t *testing.T) {
+	priorRoleID, impliedRoleID, err := identityImpliedRoleV3ParseID("prior/implied")
+	assert.Equal(t, err, nil)
+	assert.Equal(t, "prior", priorRoleID)
+	assert.Equal(t, "implied", impliedRoleID)
+
+	_, _, err = identityImpliedRoleV3ParseID("prior")
+	assert.Error(t, err)
+
+	_, _, err = identityImpliedRoleV3ParseID("prior/")
+	assert.Error(t, err)
+}
+
+func TestFlattenIdentityRoleInferencesV3(t *testing.T) {
+	inferences := []identityRoleInferenceV3{
+		{
+			PriorRole: identityImpliedRoleV3Role{ID: "1", Name: "admin"},
+			Implies: []identityImpliedRoleV3Role{
+				{ID: "2", Name: "member"},
+			},
+		},
+		{
+			PriorRole: identityImpliedRoleV3Role{ID: "2", Name: "member"},
+			Implies: []identityImpliedRoleV3Role{
+				{ID: "3", Name: "reader"},
+				{ID: "4", Name: "observer"},
+			},
+		},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"prior_role_id":     "1",
+			"prior_role_name":   "admin",
+			"implied_role_id":   "2",
+			"implied_role_name": "member",
+		},
+		{
+			"prior_role_id":     "2",
+			"prior_role_name":   "member",
+			"implied_role_id":   "3",
+			"implied_role_name": "reader",
+		},
+		{
+			"prior_role_id":     "2",
+			"prior_role_name":   "member",
+			"implied_role_id":   "4",
+			"implied_role_name": "observer",
+		},
+	}
+
+	actual := flattenIdentityRoleInferencesV3(inferences)
+	assert.Equal(t, expected, actual)
+}
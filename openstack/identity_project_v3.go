@@ -0,0 +1,17 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/projects"
+)
+
+// identityProjectV3IsImmutable reports whether the immutable resource option
+// is enabled in the given project options.
+func identityProjectV3IsImmutable(options map[projects.Option]interface{}) bool {
+	if v, ok := options[projects.Immutable]; ok {
+		if immutable, ok := v.(bool); ok {
+			return immutable
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,23 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/projects"
+)
+
+func TestIdentityProjectV3IsImmutable(t *testing.T) {
+	assert.False(t, identityProjectV3IsImmutable(nil))
+	assert.False(t, identityProjectV3IsImmutable(map[projects.Option]interface{}{}))
+	assert.False(t, identityProjectV3IsImmutable(map[projects.Option]interface{}{
+		projects.Immutable: nil,
+	}))
+	assert.False(t, identityProjectV3IsImmutable(map[projects.Option]interface{}{
+		projects.Immutable: false,
+	}))
+	assert.True(t, identityProjectV3IsImmutable(map[projects.Option]interface{}{
+		projects.Immutable: true,
+	}))
+}
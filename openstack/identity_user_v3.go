@@ -5,16 +5,33 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
 	"github.com/gophercloud/gophercloud/openstack/identity/v3/users"
 )
 
-func getUserOptions() [4]users.Option {
-	return [4]users.Option{
+// identityUserV3LockPassword isn't defined by gophercloud.
+const identityUserV3LockPassword users.Option = "lock_password"
+
+func getUserOptions() [5]users.Option {
+	return [5]users.Option{
 		users.IgnoreChangePasswordUponFirstUse,
 		users.IgnorePasswordExpiry,
 		users.IgnoreLockoutFailureAttempts,
 		users.MultiFactorAuthEnabled,
+		identityUserV3LockPassword,
+	}
+}
+
+// identityUserV3OptionConfigured reports whether a user option is set in the
+// configuration, so that an option configured as false is sent as well.
+func identityUserV3OptionConfigured(d *schema.ResourceData, option users.Option) bool {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return false
 	}
+
+	return !rawConfig.GetAttr(string(option)).IsNull()
 }
 
 func expandIdentityUserV3MFARules(rules []interface{}) []interface{} {
@@ -1,9 +1,17 @@
 package openstack
 
 import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
 )
 
 func TestExpandIdentityUserV3MFARules(t *testing.T) {
@@ -43,3 +51,53 @@ func TestFlattenIdentityUserV3MFARules(t *testing.T) {
 	actual := flattenIdentityUserV3MFARules(mfaRules)
 	assert.Equal(t, expected, actual)
 }
+
+func TestIdentityUserV3CreateOptions(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		body, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"user": {"name": "user_1", "enabled": true, "options": {"ignore_password_expiry": false, "lock_password": true}}}`, string(body))
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"user": {"id": "user-1", "name": "user_1", "enabled": true}}`)
+	})
+
+	th.Mux.HandleFunc("/users/user-1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"user": {"id": "user-1", "name": "user_1", "enabled": true, "options": {"lock_password": true}}}`)
+	})
+
+	config := testAccUnitConfig("identity")
+
+	diff := &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"name":                   {New: "user_1"},
+			"enabled":                {New: "true"},
+			"ignore_password_expiry": {New: "false"},
+			"lock_password":          {New: "true"},
+		},
+		RawConfig: cty.ObjectVal(map[string]cty.Value{
+			"name":                                  cty.StringVal("user_1"),
+			"ignore_change_password_upon_first_use": cty.NullVal(cty.Bool),
+			"ignore_password_expiry":                cty.False,
+			"ignore_lockout_failure_attempts":       cty.NullVal(cty.Bool),
+			"multi_factor_auth_enabled":             cty.NullVal(cty.Bool),
+			"lock_password":                         cty.True,
+		}),
+	}
+
+	state, diags := resourceIdentityUserV3().Apply(context.Background(), nil, diff, config)
+	assert.False(t, diags.HasError(), "%v", diags)
+	if assert.NotNil(t, state) {
+		assert.Equal(t, "user-1", state.ID)
+		assert.Equal(t, "false", state.Attributes["ignore_password_expiry"])
+		assert.Equal(t, "false", state.Attributes["multi_factor_auth_enabled"])
+		assert.Equal(t, "true", state.Attributes["lock_password"])
+	}
+}
@@ -12,13 +12,16 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/imageimport"
 	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
 	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/members"
+	imagesutils "github.com/gophercloud/utils/openstack/imageservice/v2/images"
 )
 
 func resourceImagesImageV2MemberStatusFromString(v string) images.ImageMemberStatus {
@@ -308,3 +311,194 @@ func imagesFilterByProperties(v []images.Image, p map[string]string) []images.Im
 
 	return result
 }
+
+// imagesImageV2DateQuery parses a date filter in the format of the Glance
+// created_at and updated_at filters, e.g. "gte:2021-01-01T00:00:00Z". A date
+// without an operator matches the exact date.
+func imagesImageV2DateQuery(v string) (*images.ImageDateQuery, error) {
+	filter := images.FilterEQ
+	date := v
+	if parts := strings.SplitN(v, ":", 2); len(parts) == 2 {
+		switch op := images.ImageDateFilter(parts[0]); op {
+		case images.FilterGT, images.FilterGTE, images.FilterLT, images.FilterLTE, images.FilterNEQ, images.FilterEQ:
+			filter = op
+			date = parts[1]
+		}
+	}
+
+	t, err := time.Parse(time.RFC3339, date)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid date filter %q, expected [gt|gte|lt|lte|neq|eq:]<RFC3339 date>: %s", v, err)
+	}
+
+	return &images.ImageDateQuery{
+		Date:   t,
+		Filter: filter,
+	}, nil
+}
+
+// imagesImageV2IDFromName resolves the name of an image to its ID. The result
+// is cached for the configured provider.
+func imagesImageV2IDFromName(config *Config, imageClient *gophercloud.ServiceClient, name string) (string, error) {
+	v, err := config.lookupCache.get("image-id|"+imageClient.Endpoint+"|"+name, func() (interface{}, error) {
+		return imagesutils.IDFromName(imageClient, name)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return v.(string), nil
+}
+
+// imagesImagesV2List lists the images matching the list options. The result
+// is cached for the configured provider, so a copy is returned.
+func imagesImagesV2List(config *Config, imageClient *gophercloud.ServiceClient, listOpts images.ListOpts) ([]images.Image, error) {
+	query, err := listOpts.ToImageListQuery()
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := config.lookupCache.get("images|"+imageClient.Endpoint+"|"+query, func() (interface{}, error) {
+		allPages, err := images.List(imageClient, listOpts).AllPages()
+		if err != nil {
+			return nil, err
+		}
+
+		return images.ExtractImages(allPages)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]images.Image(nil), v.([]images.Image)...), nil
+}
+
+const (
+	// imagesImageV2DefaultUploadChunkSize is used, if upload_chunk_size isn't set.
+	imagesImageV2DefaultUploadChunkSize = 64 * 1024 * 1024
+
+	// imagesImageV2UploadRetries is the number of times an interrupted upload
+	// is retried.
+	imagesImageV2UploadRetries = 3
+)
+
+// imagesImageV2ChunkReader reads an image file in chunks of a fixed size and
+// logs the upload progress after every chunk. Only a single chunk is held in
+// memory, regardless of the image size.
+type imagesImageV2ChunkReader struct {
+	file   io.ReadSeeker
+	id     string
+	size   int64
+	offset int64
+	buf    []byte
+	chunk  []byte
+}
+
+func newImagesImageV2ChunkReader(file io.ReadSeeker, id string, size int64, chunkSize int) *imagesImageV2ChunkReader {
+	if chunkSize <= 0 {
+		chunkSize = imagesImageV2DefaultUploadChunkSize
+	}
+
+	// A chunk never needs to be larger than the file.
+	if size > 0 && size < int64(chunkSize) {
+		chunkSize = int(size)
+	}
+
+	return &imagesImageV2ChunkReader{
+		file: file,
+		id:   id,
+		size: size,
+		buf:  make([]byte, chunkSize),
+	}
+}
+
+func (r *imagesImageV2ChunkReader) Read(p []byte) (int, error) {
+	if len(r.chunk) == 0 {
+		n, err := io.ReadFull(r.file, r.buf)
+		if n == 0 {
+			if err == io.ErrUnexpectedEOF {
+				err = io.EOF
+			}
+			return 0, err
+		}
+		if err != nil && err != io.ErrUnexpectedEOF {
+			return 0, err
+		}
+		r.chunk = r.buf[:n]
+	}
+
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+	r.offset += int64(n)
+
+	if len(r.chunk) == 0 {
+		var percent int64 = 100
+		if r.size > 0 {
+			percent = r.offset * 100 / r.size
+		}
+		log.Printf("[INFO] Uploaded %d of %d bytes (%d%%) of openstack_images_image_v2 %s", r.offset, r.size, percent, r.id)
+	}
+
+	return n, nil
+}
+
+// Seek allows the upload to be restarted, e.g. after a reauthentication.
+func (r *imagesImageV2ChunkReader) Seek(offset int64, whence int) (int64, error) {
+	pos, err := r.file.Seek(offset, whence)
+	if err != nil {
+		return pos, err
+	}
+
+	r.offset = pos
+	r.chunk = nil
+
+	return pos, nil
+}
+
+// imagesImageV2GlanceDirectSupported reports whether the glance-direct
+// import method is available, so that an image can be staged and imported.
+func imagesImageV2GlanceDirectSupported(imageClient *gophercloud.ServiceClient) bool {
+	info, err := imageimport.Get(imageClient).Extract()
+	if err != nil {
+		log.Printf("[DEBUG] Unable to retrieve the Glance import methods: %s", err)
+		return false
+	}
+
+	for _, method := range info.ImportMethods.Value {
+		if method == string(imageimport.GlanceDirectMethod) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// imagesImageV2UploadWithRetry runs the upload and retries it, when the
+// connection drops. Glance discards partially uploaded data and offers no way
+// to resume an upload, so it's restarted from the beginning, as long as the
+// image is queued again.
+func imagesImageV2UploadWithRetry(imageClient *gophercloud.ServiceClient, id string, r *imagesImageV2ChunkReader, upload func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := upload()
+		if err == nil {
+			return nil
+		}
+
+		// Glance rejected the data, so retrying won't help.
+		if _, ok := err.(gophercloud.StatusCodeError); ok || attempt > imagesImageV2UploadRetries {
+			return err
+		}
+
+		img, getErr := images.Get(imageClient, id).Extract()
+		if getErr != nil || img.Status != images.ImageStatusQueued {
+			return err
+		}
+
+		log.Printf("[INFO] Upload of openstack_images_image_v2 %s was interrupted, retrying from the beginning (attempt %d of %d): %s",
+			id, attempt, imagesImageV2UploadRetries, err)
+
+		if _, err := r.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+	}
+}
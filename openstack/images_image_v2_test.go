@@ -0,0 +1,134 @@
+package openstack
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/imagedata"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestImagesImageV2DateQuery(t *testing.T) {
+	date := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	q, err := imagesImageV2DateQuery("gte:2021-01-01T00:00:00Z")
+	assert.NoError(t, err)
+	assert.Equal(t, &images.ImageDateQuery{Date: date, Filter: images.FilterGTE}, q)
+
+	q, err = imagesImageV2DateQuery("lt:2021-01-01T00:00:00Z")
+	assert.NoError(t, err)
+	assert.Equal(t, images.FilterLT, q.Filter)
+
+	q, err = imagesImageV2DateQuery("2021-01-01T00:00:00Z")
+	assert.NoError(t, err)
+	assert.Equal(t, &images.ImageDateQuery{Date: date, Filter: images.FilterEQ}, q)
+
+	_, err = imagesImageV2DateQuery("gte:2021-01-01")
+	assert.Error(t, err)
+
+	_, err = imagesImageV2DateQuery("after:2021-01-01T00:00:00Z")
+	assert.Error(t, err)
+}
+
+func TestImagesImageV2ChunkReader(t *testing.T) {
+	data := bytes.Repeat([]byte("0123456789"), 10)
+	r := newImagesImageV2ChunkReader(bytes.NewReader(data), "image-1", int64(len(data)), 16)
+
+	read, err := ioutil.ReadAll(r)
+	assert.NoError(t, err)
+	assert.Equal(t, data, read)
+	assert.Len(t, r.buf, 16)
+
+	pos, err := r.Seek(0, io.SeekStart)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), pos)
+
+	read, err = ioutil.ReadAll(r)
+	assert.NoError(t, err)
+	assert.Equal(t, data, read)
+
+	// The chunk is limited to the size of the file.
+	r = newImagesImageV2ChunkReader(bytes.NewReader(data), "image-1", int64(len(data)), 0)
+	assert.Len(t, r.buf, len(data))
+
+	r = newImagesImageV2ChunkReader(bytes.NewReader(data), "image-1", int64(len(data)), 1024)
+	assert.Len(t, r.buf, len(data))
+
+	read, err = ioutil.ReadAll(r)
+	assert.NoError(t, err)
+	assert.Equal(t, data, read)
+}
+
+func TestImagesImageV2UploadWithRetry(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	data := bytes.Repeat([]byte("0123456789"), 1000)
+
+	var attempts int
+	th.Mux.HandleFunc("/images/image-1/stage", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		attempts++
+
+		if attempts == 1 {
+			// Drop the connection in the middle of the upload.
+			_, err := io.CopyN(ioutil.Discard, r.Body, 100)
+			assert.NoError(t, err)
+			conn, _, err := w.(http.Hijacker).Hijack()
+			assert.NoError(t, err)
+			conn.Close()
+			return
+		}
+
+		body, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, data, body)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	th.Mux.HandleFunc("/images/image-1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id": "image-1", "status": "queued"}`)
+	})
+
+	client := thclient.ServiceClient()
+	r := newImagesImageV2ChunkReader(bytes.NewReader(data), "image-1", int64(len(data)), 1024)
+
+	err := imagesImageV2UploadWithRetry(client, "image-1", r, func() error {
+		return imagedata.Stage(client, "image-1", r).Err
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestImagesImageV2UploadWithRetryRejected(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var attempts int
+	th.Mux.HandleFunc("/images/image-1/stage", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+	})
+
+	client := thclient.ServiceClient()
+	r := newImagesImageV2ChunkReader(bytes.NewReader([]byte("data")), "image-1", 4, 1024)
+
+	err := imagesImageV2UploadWithRetry(client, "image-1", r, func() error {
+		return imagedata.Stage(client, "image-1", r).Err
+	})
+
+	assert.Error(t, err)
+	assert.Equal(t, 1, attempts)
+}
@@ -30,7 +30,7 @@ func TestAccContainerInfraV1ClusterImport_basic(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"keypair", "cluster_template_id"},
+				ImportStateVerifyIgnore: []string{"keypair", "cluster_template_id", "upgrade_on_template_change"},
 			},
 		},
 	})
@@ -0,0 +1,32 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDNSV2TSIGKey_importBasic(t *testing.T) {
+	zoneName := randomZoneName()
+	resourceName := "openstack_dns_tsigkey_v2.tsigkey_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckDNS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDNSV2TSIGKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2TSIGKeyBasic(zoneName, "hmac-sha256", "c2VjcmV0LTE="),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,36 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIdentityV3ImpliedRole_importBasic(t *testing.T) {
+	resourceName := "openstack_identity_implied_role_v3.implied_role_1"
+
+	priorRoleName := fmt.Sprintf("ACCPTTEST-%s", acctest.RandString(5))
+	impliedRoleName := fmt.Sprintf("ACCPTTEST-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckIdentityV3ImpliedRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityV3ImpliedRoleBasic(priorRoleName, impliedRoleName),
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
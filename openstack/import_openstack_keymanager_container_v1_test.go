@@ -25,6 +25,9 @@ func TestAccKeyManagerContainerV1_importBasic(t *testing.T) {
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"prevent_destroy_if_consumed",
+				},
 			},
 		},
 	})
@@ -49,6 +52,9 @@ func TestAccKeyManagerContainerV1_importACLs(t *testing.T) {
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"prevent_destroy_if_consumed",
+				},
 			},
 		},
 	})
@@ -1,9 +1,11 @@
 package openstack
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccNetworkingV2FloatingIP_importBasic(t *testing.T) {
@@ -26,6 +28,24 @@ func TestAccNetworkingV2FloatingIP_importBasic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccNetworkingV2FloatingIPImportAddress(resourceName),
+			},
 		},
 	})
 }
+
+func testAccNetworkingV2FloatingIPImportAddress(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["address"], nil
+	}
+}
@@ -0,0 +1,34 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObjectStorageV1Symlink_importBasic(t *testing.T) {
+	resourceName := "openstack_objectstorage_symlink_v1.latest"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSwift(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckObjectStorageV1SymlinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectStorageV1SymlinkBasic("v1.txt"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"metadata",
+				},
+			},
+		},
+	})
+}
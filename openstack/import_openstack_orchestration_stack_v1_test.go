@@ -27,6 +27,7 @@ func TestAccOrchestrationStackV1_importBasic(t *testing.T) {
 				ImportStateVerifyIgnore: []string{
 					"environment_opts",
 					"template_opts",
+					"resolved_files",
 				},
 			},
 		},
@@ -0,0 +1,33 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSFSV2ShareGroupSnapshot_importBasic(t *testing.T) {
+	resourceName := "openstack_sharedfilesystem_share_group_snapshot_v2.share_group_snapshot_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSFS(t)
+			testAccPreCheckSFSShareGroup(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSFSV2ShareGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSFSV2ShareGroupConfigUpdate(),
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
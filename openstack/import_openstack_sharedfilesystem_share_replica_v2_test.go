@@ -0,0 +1,36 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSFSV2ShareReplica_importBasic(t *testing.T) {
+	resourceName := "openstack_sharedfilesystem_share_replica_v2.replica_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSFS(t)
+			testAccPreCheckSFSReplication(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSFSV2ShareReplicaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSFSV2ShareReplicaConfigBasic(),
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"promote",
+				},
+			},
+		},
+	})
+}
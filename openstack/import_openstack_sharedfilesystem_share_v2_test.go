@@ -26,6 +26,9 @@ func TestAccSFSV2Share_importBasic(t *testing.T) {
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"allow_shrink",
+				},
 			},
 		},
 	})
@@ -0,0 +1,37 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSFSV2ShareTypeAccess_importBasic(t *testing.T) {
+	resourceName := "openstack_sharedfilesystem_sharetype_access_v2.sharetype_access_1"
+
+	var projectName = fmt.Sprintf("ACCPTTEST-%s", acctest.RandString(5))
+	var shareTypeName = fmt.Sprintf("ACCPTTEST-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckSFS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSFSV2ShareTypeAccessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSFSV2ShareTypeAccessConfigBasic(projectName, shareTypeName),
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -25,6 +25,9 @@ func TestAccSiteConnectionV2_importBasic(t *testing.T) {
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"wait_for_active",
+				},
 			},
 		},
 	})
@@ -114,3 +114,13 @@ func flattenKeyManagerContainerV1Consumers(cr []containers.ConsumerRef) []map[st
 
 	return m
 }
+
+func keyManagerContainerV1ConsumersString(cr []containers.ConsumerRef) string {
+	consumers := make([]string, 0, len(cr))
+
+	for _, v := range cr {
+		consumers = append(consumers, fmt.Sprintf("%s (%s)", v.Name, v.URL))
+	}
+
+	return strings.Join(consumers, ", ")
+}
@@ -2,15 +2,106 @@ package openstack
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/keymanager/v1/orders"
 )
 
+// keyManagerOrderV1CreateOpts represents the attributes used when creating a
+// new order.
+type keyManagerOrderV1CreateOpts struct {
+	orders.CreateOpts
+	PassPhrase string `json:"-"`
+}
+
+// ToOrderCreateMap casts a CreateOpts struct to a map.
+// It overrides orders.ToOrderCreateMap to add the pass_phrase meta field.
+func (opts keyManagerOrderV1CreateOpts) ToOrderCreateMap() (map[string]interface{}, error) {
+	b, err := opts.CreateOpts.ToOrderCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.PassPhrase != "" {
+		meta := b["meta"].(map[string]interface{})
+		meta["pass_phrase"] = opts.PassPhrase
+		b["meta"] = meta
+	}
+
+	return b, nil
+}
+
+// keyManagerOrderV1Algorithms contains the supported algorithms and their bit
+// lengths per order type. A nil bit lengths list allows any bit length.
+var keyManagerOrderV1Algorithms = map[string]map[string][]int{
+	"key": {
+		"aes":        {128, 192, 256},
+		"hmacsha1":   nil,
+		"hmacsha256": nil,
+		"hmacsha384": nil,
+		"hmacsha512": nil,
+	},
+	"asymmetric": {
+		"rsa": {1024, 2048, 4096},
+		"dsa": {1024, 2048, 3072},
+	},
+}
+
+func keyManagerOrderV1ValidateMeta(orderType, algorithm string, bitLength int, passPhrase string) error {
+	algorithms, ok := keyManagerOrderV1Algorithms[orderType]
+	if !ok {
+		return nil
+	}
+
+	bitLengths, ok := algorithms[strings.ToLower(algorithm)]
+	if !ok {
+		supported := make([]string, 0, len(algorithms))
+		for k := range algorithms {
+			supported = append(supported, k)
+		}
+		sort.Strings(supported)
+		return fmt.Errorf("Unsupported %q algorithm for the %q order type, must be one of: %s", algorithm, orderType, strings.Join(supported, ", "))
+	}
+
+	if bitLengths != nil {
+		var found bool
+		for _, v := range bitLengths {
+			if v == bitLength {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("Unsupported %d bit_length for the %q algorithm, must be one of: %v", bitLength, algorithm, bitLengths)
+		}
+	}
+
+	if passPhrase != "" && orderType != "asymmetric" {
+		return fmt.Errorf("The pass_phrase is only supported for the \"asymmetric\" order type")
+	}
+
+	return nil
+}
+
+func resourceKeyManagerOrderV1MetaCustomizeDiff(diff *schema.ResourceDiff) error {
+	if !diff.NewValueKnown("type") || !diff.NewValueKnown("meta") {
+		return nil
+	}
+
+	return keyManagerOrderV1ValidateMeta(
+		diff.Get("type").(string),
+		diff.Get("meta.0.algorithm").(string),
+		diff.Get("meta.0.bit_length").(int),
+		diff.Get("meta.0.pass_phrase").(string),
+	)
+}
+
 func keyManagerOrderV1WaitForOrderDeletion(kmClient *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		err := orders.Delete(kmClient, id).Err
@@ -50,7 +141,7 @@ func keyManagerOrderV1WaitForOrderCreation(kmClient *gophercloud.ServiceClient,
 		}
 
 		if order.Status == "ERROR" {
-			return "", order.Status, fmt.Errorf("Error creating order")
+			return "", order.Status, fmt.Errorf("Error creating order: %s (%s)", order.ErrorReason, order.ErrorStatusCode)
 		}
 
 		return order, order.Status, nil
@@ -1,18 +1,71 @@
 package openstack
 
 import (
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"log"
+	"os"
+	"sort"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mitchellh/go-homedir"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/keymanager/v1/secrets"
 )
 
+// keyManagerSecretV1Sort represents a sortable slice of key manager v1
+// secrets.
+type keyManagerSecretV1Sort []secrets.Secret
+
+func (secret keyManagerSecretV1Sort) Len() int {
+	return len(secret)
+}
+
+func (secret keyManagerSecretV1Sort) Swap(i, j int) {
+	secret[i], secret[j] = secret[j], secret[i]
+}
+
+func (secret keyManagerSecretV1Sort) Less(i, j int) bool {
+	itime := secret[i].Created
+	jtime := secret[j].Created
+	return itime.Unix() < jtime.Unix()
+}
+
+func dataSourceKeyManagerSecretV1MostRecentSecret(secrets []secrets.Secret) secrets.Secret {
+	sortedSecrets := secrets
+	sort.Sort(keyManagerSecretV1Sort(sortedSecrets))
+	return sortedSecrets[len(sortedSecrets)-1]
+}
+
+// keyManagerSecretV1Expired returns true, when the RFC3339 expiration has
+// passed.
+func keyManagerSecretV1Expired(expiration string) bool {
+	v, err := time.Parse(time.RFC3339, expiration)
+	if err != nil {
+		return false
+	}
+
+	return v.Before(time.Now())
+}
+
+func keyManagerSecretV1ExpiredWarning(id, expiration string) diag.Diagnostics {
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("openstack_keymanager_secret_v1 %s has expired", id),
+			Detail:   fmt.Sprintf("The secret has expired at %s and was removed from the state.", expiration),
+		},
+	}
+}
+
 func keyManagerSecretV1WaitForSecretDeletion(kmClient *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		err := secrets.Delete(kmClient, id).Err
@@ -126,3 +179,92 @@ func resourceSecretV1PayloadBase64CustomizeDiff(diff *schema.ResourceDiff) error
 
 	return nil
 }
+
+// keyManagerSecretV1PayloadFile opens the file referenced by payload_file.
+func keyManagerSecretV1PayloadFile(payloadFile string) (*os.File, error) {
+	path, err := homedir.Expand(payloadFile)
+	if err != nil {
+		return nil, fmt.Errorf("Error expanding homedir in payload_file (%s): %s", payloadFile, err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("Error opening openstack_keymanager_secret_v1 payload_file (%s): %s", payloadFile, err)
+	}
+
+	return file, nil
+}
+
+// keyManagerSecretV1PayloadFileSHA256 returns the sha256 checksum of the
+// payload stored in the payload_file. Base64 encoded payloads are decoded
+// first, because Barbican returns the decoded payload.
+func keyManagerSecretV1PayloadFileSHA256(payloadFile, encoding string) (string, error) {
+	file, err := keyManagerSecretV1PayloadFile(payloadFile)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	var r io.Reader = file
+	if encoding == "base64" {
+		r = base64.NewDecoder(base64.StdEncoding, file)
+	}
+
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, r); err != nil {
+		return "", fmt.Errorf("Error reading openstack_keymanager_secret_v1 payload_file (%s): %s", payloadFile, err)
+	}
+
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+// keyManagerSecretV1UpdatePayload streams the payload to an existing secret,
+// which does not already contain a payload.
+func keyManagerSecretV1UpdatePayload(kmClient *gophercloud.ServiceClient, id string, payload io.Reader, contentType, contentEncoding string) error {
+	h := make(map[string]string)
+	if contentType != "" {
+		h["Content-Type"] = contentType
+	}
+	if contentEncoding != "" {
+		h["Content-Encoding"] = contentEncoding
+	}
+
+	resp, err := kmClient.Put(kmClient.ServiceURL("secrets", id), payload, nil, &gophercloud.RequestOpts{
+		MoreHeaders: h,
+		OkCodes:     []int{204},
+	})
+	_, _, err = gophercloud.ParseResponse(resp, err)
+	return err
+}
+
+func resourceSecretV1PayloadFileCustomizeDiff(diff *schema.ResourceDiff) error {
+	if !diff.NewValueKnown("payload_file") || !diff.NewValueKnown("payload_content_encoding") {
+		return nil
+	}
+
+	payloadFile := diff.Get("payload_file").(string)
+	if payloadFile == "" {
+		return nil
+	}
+
+	sum, err := keyManagerSecretV1PayloadFileSHA256(payloadFile, diff.Get("payload_content_encoding").(string))
+	if err != nil {
+		// The payload_file may be created during the apply.
+		log.Printf("[DEBUG] Unable to compute the openstack_keymanager_secret_v1 payload_file checksum: %s", err)
+		return nil
+	}
+
+	if diff.Get("payload_file_sha256").(string) == sum {
+		return nil
+	}
+
+	if err := diff.SetNew("payload_file_sha256", sum); err != nil {
+		return err
+	}
+
+	if diff.Id() != "" {
+		return diff.ForceNew("payload_file_sha256")
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/amphorae"
+)
+
+func flattenLBAmphoraeV2(allAmphorae []amphorae.Amphora) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(allAmphorae))
+
+	for _, a := range allAmphorae {
+		res = append(res, map[string]interface{}{
+			"id":              a.ID,
+			"loadbalancer_id": a.LoadbalancerID,
+			"compute_id":      a.ComputeID,
+			"lb_network_ip":   a.LBNetworkIP,
+			"ha_ip":           a.HAIP,
+			"ha_port_id":      a.HAPortID,
+			"vrrp_ip":         a.VRRPIP,
+			"vrrp_port_id":    a.VRRPPortID,
+			"role":            a.Role,
+			"status":          a.Status,
+			"image_id":        a.ImageID,
+			"cached_zone":     a.CachedZone,
+			"created_at":      a.CreatedAt.Format(time.RFC3339),
+			"updated_at":      a.UpdatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return res
+}
+
+// lbAmphoraV2FindReplacement returns the amphora which took over the role of
+// the failed over amphora within the same load balancer.
+func lbAmphoraV2FindReplacement(allAmphorae []amphorae.Amphora, old *amphorae.Amphora) *amphorae.Amphora {
+	for i, a := range allAmphorae {
+		if a.ID != old.ID && a.Role == old.Role && a.Status == "ALLOCATED" {
+			return &allAmphorae[i]
+		}
+	}
+
+	return nil
+}
+
+func waitForLBV2AmphoraReplacement(ctx context.Context, lbClient *gophercloud.ServiceClient, old *amphorae.Amphora, timeout time.Duration) (*amphorae.Amphora, error) {
+	log.Printf("[DEBUG] Waiting for the replacement of amphora %s to become ALLOCATED.", old.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{"ALLOCATED"},
+		Pending:    []string{"PENDING"},
+		Refresh:    resourceLBV2AmphoraReplacementRefreshFunc(lbClient, old),
+		Timeout:    timeout,
+		Delay:      0,
+		MinTimeout: 2 * time.Second,
+	}
+
+	v, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Error waiting for the replacement of amphora %s to become ALLOCATED: %s", old.ID, err)
+	}
+
+	return v.(*amphorae.Amphora), nil
+}
+
+func resourceLBV2AmphoraReplacementRefreshFunc(lbClient *gophercloud.ServiceClient, old *amphorae.Amphora) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		listOpts := amphorae.ListOpts{
+			LoadbalancerID: old.LoadbalancerID,
+			Role:           old.Role,
+		}
+
+		allPages, err := amphorae.List(lbClient, listOpts).AllPages()
+		if err != nil {
+			return nil, "", err
+		}
+
+		allAmphorae, err := amphorae.ExtractAmphorae(allPages)
+		if err != nil {
+			return nil, "", err
+		}
+
+		replacement := lbAmphoraV2FindReplacement(allAmphorae, old)
+		if replacement == nil {
+			return old, "PENDING", nil
+		}
+
+		return replacement, replacement.Status, nil
+	}
+}
@@ -0,0 +1,30 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/amphorae"
+)
+
+func TestLBAmphoraV2FindReplacement(t *testing.T) {
+	old := &amphorae.Amphora{
+		ID:     "amphora_1",
+		Role:   "MASTER",
+		Status: "ALLOCATED",
+	}
+
+	allAmphorae := []amphorae.Amphora{
+		{ID: "amphora_1", Role: "MASTER", Status: "ALLOCATED"},
+		{ID: "amphora_2", Role: "BACKUP", Status: "ALLOCATED"},
+		{ID: "amphora_3", Role: "MASTER", Status: "BOOTING"},
+	}
+
+	assert.Nil(t, lbAmphoraV2FindReplacement(allAmphorae, old))
+
+	allAmphorae[2].Status = "ALLOCATED"
+
+	expected := &amphorae.Amphora{ID: "amphora_3", Role: "MASTER", Status: "ALLOCATED"}
+	assert.Equal(t, expected, lbAmphoraV2FindReplacement(allAmphorae, old))
+}
@@ -0,0 +1,89 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// lbAvailabilityZoneV2 represents an Octavia availability zone.
+// Availability zones are identified by their name.
+type lbAvailabilityZoneV2 struct {
+	Name                      string `json:"name"`
+	Description               string `json:"description"`
+	AvailabilityZoneProfileID string `json:"availability_zone_profile_id"`
+	Enabled                   bool   `json:"enabled"`
+}
+
+// lbAvailabilityZoneV2CreateOpts represents the attributes used when creating
+// a new availability zone.
+type lbAvailabilityZoneV2CreateOpts struct {
+	Name                      string `json:"name" required:"true"`
+	Description               string `json:"description,omitempty"`
+	AvailabilityZoneProfileID string `json:"availability_zone_profile_id" required:"true"`
+	Enabled                   *bool  `json:"enabled,omitempty"`
+}
+
+// lbAvailabilityZoneV2UpdateOpts represents the attributes used when updating
+// an existing availability zone.
+type lbAvailabilityZoneV2UpdateOpts struct {
+	Description *string `json:"description,omitempty"`
+	Enabled     *bool   `json:"enabled,omitempty"`
+}
+
+type lbAvailabilityZoneV2Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets a lbAvailabilityZoneV2Result as an availability zone.
+func (r lbAvailabilityZoneV2Result) Extract() (*lbAvailabilityZoneV2, error) {
+	var s struct {
+		AvailabilityZone *lbAvailabilityZoneV2 `json:"availability_zone"`
+	}
+	err := r.ExtractInto(&s)
+	return s.AvailabilityZone, err
+}
+
+func lbAvailabilityZoneV2RootURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL("lbaas", "availabilityzones")
+}
+
+func lbAvailabilityZoneV2URL(client *gophercloud.ServiceClient, name string) string {
+	return client.ServiceURL("lbaas", "availabilityzones", name)
+}
+
+func lbAvailabilityZoneV2Create(client *gophercloud.ServiceClient, opts lbAvailabilityZoneV2CreateOpts) (r lbAvailabilityZoneV2Result) {
+	b, err := gophercloud.BuildRequestBody(opts, "availability_zone")
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(lbAvailabilityZoneV2RootURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func lbAvailabilityZoneV2Get(client *gophercloud.ServiceClient, name string) (r lbAvailabilityZoneV2Result) {
+	resp, err := client.Get(lbAvailabilityZoneV2URL(client, name), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func lbAvailabilityZoneV2Update(client *gophercloud.ServiceClient, name string, opts lbAvailabilityZoneV2UpdateOpts) (r lbAvailabilityZoneV2Result) {
+	b, err := gophercloud.BuildRequestBody(opts, "availability_zone")
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Put(lbAvailabilityZoneV2URL(client, name), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func lbAvailabilityZoneV2Delete(client *gophercloud.ServiceClient, name string) (r gophercloud.ErrResult) {
+	resp, err := client.Delete(lbAvailabilityZoneV2URL(client, name), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
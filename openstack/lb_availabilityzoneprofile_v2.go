@@ -0,0 +1,89 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// lbAvailabilityZoneProfileV2 represents an Octavia availability zone profile.
+type lbAvailabilityZoneProfileV2 struct {
+	ID                   string `json:"id"`
+	Name                 string `json:"name"`
+	ProviderName         string `json:"provider_name"`
+	AvailabilityZoneData string `json:"availability_zone_data"`
+}
+
+// lbAvailabilityZoneProfileV2CreateOpts represents the attributes used when
+// creating a new availability zone profile.
+type lbAvailabilityZoneProfileV2CreateOpts struct {
+	Name                 string `json:"name" required:"true"`
+	ProviderName         string `json:"provider_name" required:"true"`
+	AvailabilityZoneData string `json:"availability_zone_data" required:"true"`
+}
+
+// lbAvailabilityZoneProfileV2UpdateOpts represents the attributes used when
+// updating an existing availability zone profile.
+type lbAvailabilityZoneProfileV2UpdateOpts struct {
+	Name                 *string `json:"name,omitempty"`
+	ProviderName         *string `json:"provider_name,omitempty"`
+	AvailabilityZoneData *string `json:"availability_zone_data,omitempty"`
+}
+
+type lbAvailabilityZoneProfileV2Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets a lbAvailabilityZoneProfileV2Result as an availability
+// zone profile.
+func (r lbAvailabilityZoneProfileV2Result) Extract() (*lbAvailabilityZoneProfileV2, error) {
+	var s struct {
+		AvailabilityZoneProfile *lbAvailabilityZoneProfileV2 `json:"availability_zone_profile"`
+	}
+	err := r.ExtractInto(&s)
+	return s.AvailabilityZoneProfile, err
+}
+
+func lbAvailabilityZoneProfileV2RootURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL("lbaas", "availabilityzoneprofiles")
+}
+
+func lbAvailabilityZoneProfileV2URL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("lbaas", "availabilityzoneprofiles", id)
+}
+
+func lbAvailabilityZoneProfileV2Create(client *gophercloud.ServiceClient, opts lbAvailabilityZoneProfileV2CreateOpts) (r lbAvailabilityZoneProfileV2Result) {
+	b, err := gophercloud.BuildRequestBody(opts, "availability_zone_profile")
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(lbAvailabilityZoneProfileV2RootURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func lbAvailabilityZoneProfileV2Get(client *gophercloud.ServiceClient, id string) (r lbAvailabilityZoneProfileV2Result) {
+	resp, err := client.Get(lbAvailabilityZoneProfileV2URL(client, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func lbAvailabilityZoneProfileV2Update(client *gophercloud.ServiceClient, id string, opts lbAvailabilityZoneProfileV2UpdateOpts) (r lbAvailabilityZoneProfileV2Result) {
+	b, err := gophercloud.BuildRequestBody(opts, "availability_zone_profile")
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Put(lbAvailabilityZoneProfileV2URL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func lbAvailabilityZoneProfileV2Delete(client *gophercloud.ServiceClient, id string) (r gophercloud.ErrResult) {
+	resp, err := client.Delete(lbAvailabilityZoneProfileV2URL(client, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
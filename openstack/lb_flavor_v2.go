@@ -0,0 +1,121 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// lbFlavorV2 represents an Octavia flavor.
+type lbFlavorV2 struct {
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	FlavorProfileID string `json:"flavor_profile_id"`
+	Enabled         bool   `json:"enabled"`
+}
+
+// lbFlavorV2CreateOpts represents the attributes used when creating a new
+// flavor.
+type lbFlavorV2CreateOpts struct {
+	Name            string `json:"name" required:"true"`
+	Description     string `json:"description,omitempty"`
+	FlavorProfileID string `json:"flavor_profile_id" required:"true"`
+	Enabled         *bool  `json:"enabled,omitempty"`
+}
+
+// lbFlavorV2UpdateOpts represents the attributes used when updating an
+// existing flavor.
+type lbFlavorV2UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Enabled     *bool   `json:"enabled,omitempty"`
+}
+
+// lbFlavorV2ListOpts allows to filter the list of flavors.
+type lbFlavorV2ListOpts struct {
+	ID              string `q:"id"`
+	Name            string `q:"name"`
+	FlavorProfileID string `q:"flavor_profile_id"`
+}
+
+type lbFlavorV2Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets a lbFlavorV2Result as a flavor.
+func (r lbFlavorV2Result) Extract() (*lbFlavorV2, error) {
+	var s struct {
+		Flavor *lbFlavorV2 `json:"flavor"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Flavor, err
+}
+
+type lbFlavorsV2Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets a lbFlavorsV2Result as a list of flavors.
+func (r lbFlavorsV2Result) Extract() ([]lbFlavorV2, error) {
+	var s struct {
+		Flavors []lbFlavorV2 `json:"flavors"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Flavors, err
+}
+
+func lbFlavorV2RootURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL("lbaas", "flavors")
+}
+
+func lbFlavorV2URL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("lbaas", "flavors", id)
+}
+
+func lbFlavorV2Create(client *gophercloud.ServiceClient, opts lbFlavorV2CreateOpts) (r lbFlavorV2Result) {
+	b, err := gophercloud.BuildRequestBody(opts, "flavor")
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(lbFlavorV2RootURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func lbFlavorV2Get(client *gophercloud.ServiceClient, id string) (r lbFlavorV2Result) {
+	resp, err := client.Get(lbFlavorV2URL(client, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func lbFlavorV2Update(client *gophercloud.ServiceClient, id string, opts lbFlavorV2UpdateOpts) (r lbFlavorV2Result) {
+	b, err := gophercloud.BuildRequestBody(opts, "flavor")
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Put(lbFlavorV2URL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func lbFlavorV2Delete(client *gophercloud.ServiceClient, id string) (r gophercloud.ErrResult) {
+	resp, err := client.Delete(lbFlavorV2URL(client, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func lbFlavorV2List(client *gophercloud.ServiceClient, opts lbFlavorV2ListOpts) (r lbFlavorsV2Result) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Get(lbFlavorV2RootURL(client)+q.String(), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
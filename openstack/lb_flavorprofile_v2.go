@@ -0,0 +1,119 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// lbFlavorProfileV2 represents an Octavia flavor profile.
+type lbFlavorProfileV2 struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	ProviderName string `json:"provider_name"`
+	FlavorData   string `json:"flavor_data"`
+}
+
+// lbFlavorProfileV2CreateOpts represents the attributes used when creating a
+// new flavor profile.
+type lbFlavorProfileV2CreateOpts struct {
+	Name         string `json:"name" required:"true"`
+	ProviderName string `json:"provider_name" required:"true"`
+	FlavorData   string `json:"flavor_data" required:"true"`
+}
+
+// lbFlavorProfileV2UpdateOpts represents the attributes used when updating an
+// existing flavor profile.
+type lbFlavorProfileV2UpdateOpts struct {
+	Name         *string `json:"name,omitempty"`
+	ProviderName *string `json:"provider_name,omitempty"`
+	FlavorData   *string `json:"flavor_data,omitempty"`
+}
+
+// lbFlavorProfileV2ListOpts allows to filter the list of flavor profiles.
+type lbFlavorProfileV2ListOpts struct {
+	ID           string `q:"id"`
+	Name         string `q:"name"`
+	ProviderName string `q:"provider_name"`
+}
+
+type lbFlavorProfileV2Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets a lbFlavorProfileV2Result as a flavor profile.
+func (r lbFlavorProfileV2Result) Extract() (*lbFlavorProfileV2, error) {
+	var s struct {
+		FlavorProfile *lbFlavorProfileV2 `json:"flavorprofile"`
+	}
+	err := r.ExtractInto(&s)
+	return s.FlavorProfile, err
+}
+
+type lbFlavorProfilesV2Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets a lbFlavorProfilesV2Result as a list of flavor profiles.
+func (r lbFlavorProfilesV2Result) Extract() ([]lbFlavorProfileV2, error) {
+	var s struct {
+		FlavorProfiles []lbFlavorProfileV2 `json:"flavorprofiles"`
+	}
+	err := r.ExtractInto(&s)
+	return s.FlavorProfiles, err
+}
+
+func lbFlavorProfileV2RootURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL("lbaas", "flavorprofiles")
+}
+
+func lbFlavorProfileV2URL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("lbaas", "flavorprofiles", id)
+}
+
+func lbFlavorProfileV2Create(client *gophercloud.ServiceClient, opts lbFlavorProfileV2CreateOpts) (r lbFlavorProfileV2Result) {
+	b, err := gophercloud.BuildRequestBody(opts, "flavorprofile")
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(lbFlavorProfileV2RootURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func lbFlavorProfileV2Get(client *gophercloud.ServiceClient, id string) (r lbFlavorProfileV2Result) {
+	resp, err := client.Get(lbFlavorProfileV2URL(client, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func lbFlavorProfileV2Update(client *gophercloud.ServiceClient, id string, opts lbFlavorProfileV2UpdateOpts) (r lbFlavorProfileV2Result) {
+	b, err := gophercloud.BuildRequestBody(opts, "flavorprofile")
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Put(lbFlavorProfileV2URL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func lbFlavorProfileV2Delete(client *gophercloud.ServiceClient, id string) (r gophercloud.ErrResult) {
+	resp, err := client.Delete(lbFlavorProfileV2URL(client, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func lbFlavorProfileV2List(client *gophercloud.ServiceClient, opts lbFlavorProfileV2ListOpts) (r lbFlavorProfilesV2Result) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Get(lbFlavorProfileV2RootURL(client)+q.String(), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
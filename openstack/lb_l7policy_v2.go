@@ -0,0 +1,129 @@
+package openstack
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/l7policies"
+)
+
+// lbV2L7PolicyMinAPIVersions contains the minimum Octavia API version
+// required by the L7 policy arguments which are not available since 2.0.
+var lbV2L7PolicyMinAPIVersions = map[string]string{
+	"redirect_prefix":    "2.8",
+	"redirect_http_code": "2.8",
+}
+
+// lbV2L7PolicyActionMinAPIVersions contains the minimum Octavia API version
+// required by the L7 policy actions which are not available since 2.0.
+var lbV2L7PolicyActionMinAPIVersions = map[string]string{
+	"REDIRECT_PREFIX": "2.8",
+}
+
+// L7PolicyCreateOpts represents the attributes used when creating a new L7 policy.
+type L7PolicyCreateOpts struct {
+	l7policies.CreateOpts
+	RedirectPrefix   string   `json:"redirect_prefix,omitempty"`
+	RedirectHTTPCode int      `json:"redirect_http_code,omitempty"`
+	Tags             []string `json:"tags,omitempty"`
+}
+
+// ToL7PolicyCreateMap casts a CreateOpts struct to a map.
+// It overrides l7policies.ToL7PolicyCreateMap to add the redirect_prefix,
+// redirect_http_code and tags fields.
+func (opts L7PolicyCreateOpts) ToL7PolicyCreateMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "l7policy")
+}
+
+// L7PolicyUpdateOpts represents the attributes used when updating an existing L7 policy.
+type L7PolicyUpdateOpts struct {
+	l7policies.UpdateOpts
+	RedirectPrefix   *string   `json:"redirect_prefix,omitempty"`
+	RedirectHTTPCode *int      `json:"redirect_http_code,omitempty"`
+	Tags             *[]string `json:"tags,omitempty"`
+}
+
+// ToL7PolicyUpdateMap casts an UpdateOpts struct to a map.
+// It overrides l7policies.ToL7PolicyUpdateMap to add the redirect_prefix,
+// redirect_http_code and tags fields.
+func (opts L7PolicyUpdateOpts) ToL7PolicyUpdateMap() (map[string]interface{}, error) {
+	b, err := BuildRequest(opts, "l7policy")
+	if err != nil {
+		return nil, err
+	}
+
+	// Unset the redirect arguments the same way l7policies.ToL7PolicyUpdateMap does.
+	m := b["l7policy"].(map[string]interface{})
+
+	if m["redirect_pool_id"] == "" {
+		m["redirect_pool_id"] = nil
+	}
+
+	if m["redirect_url"] == "" {
+		m["redirect_url"] = nil
+	}
+
+	if m["redirect_prefix"] == "" {
+		m["redirect_prefix"] = nil
+	}
+
+	return b, nil
+}
+
+// lbL7PolicyV2 represents an L7 policy including the Octavia attributes
+// which are not exposed by gophercloud.
+type lbL7PolicyV2 struct {
+	l7policies.L7Policy
+	RedirectPrefix   string   `json:"redirect_prefix"`
+	RedirectHTTPCode int      `json:"redirect_http_code"`
+	Tags             []string `json:"tags"`
+}
+
+func lbL7PolicyV2Get(lbClient *gophercloud.ServiceClient, id string) (*lbL7PolicyV2, error) {
+	var l7Policy lbL7PolicyV2
+
+	err := l7policies.Get(lbClient, id).ExtractIntoStructPtr(&l7Policy, "l7policy")
+	if err != nil {
+		return nil, err
+	}
+
+	return &l7Policy, nil
+}
+
+// lbV2CheckL7PolicyAPIVersion verifies that the Octavia API supports the
+// L7 policy arguments and the L7 policy action.
+func lbV2CheckL7PolicyAPIVersion(d *schema.ResourceData, lbClient *gophercloud.ServiceClient, create bool) error {
+	if create || d.HasChange("action") {
+		action := d.Get("action").(string)
+		if required, ok := lbV2L7PolicyActionMinAPIVersions[action]; ok {
+			if err := lbV2CheckMinAPIVersion(lbClient, required, fmt.Sprintf("action %s", action)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return lbV2CheckArgumentsAPIVersion(d, lbClient, lbV2L7PolicyMinAPIVersions, create)
+}
+
+// lbL7PolicyV2CustomizeDiff ensures that the redirect arguments match the
+// L7 policy action.
+func lbL7PolicyV2CustomizeDiff(diff *schema.ResourceDiff) error {
+	action := diff.Get("action").(string)
+
+	err := checkL7PolicyAction(action,
+		diff.Get("redirect_url").(string),
+		diff.Get("redirect_pool_id").(string),
+		diff.Get("redirect_prefix").(string))
+	if err != nil {
+		return err
+	}
+
+	// redirect_http_code is computed, so only check it when it's changed.
+	if !diff.HasChange("redirect_http_code") {
+		return nil
+	}
+
+	return checkL7PolicyRedirectHTTPCode(action, diff.Get("redirect_http_code").(int))
+}
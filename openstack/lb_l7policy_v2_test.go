@@ -0,0 +1,82 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/l7policies"
+)
+
+func TestL7PolicyCreateOptsToL7PolicyCreateMap(t *testing.T) {
+	opts := L7PolicyCreateOpts{
+		CreateOpts: l7policies.CreateOpts{
+			Action:     l7policies.ActionReject,
+			ListenerID: "listener",
+		},
+		Tags: []string{"tag1"},
+	}
+
+	expected := map[string]interface{}{
+		"l7policy": map[string]interface{}{
+			"action":      "REJECT",
+			"listener_id": "listener",
+			"tags":        []interface{}{"tag1"},
+		},
+	}
+
+	actual, err := opts.ToL7PolicyCreateMap()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestL7PolicyUpdateOptsToL7PolicyUpdateMap(t *testing.T) {
+	redirectURL := ""
+	redirectPrefix := "https://www.example.com"
+	redirectHTTPCode := 308
+	tags := []string{}
+	opts := L7PolicyUpdateOpts{
+		UpdateOpts: l7policies.UpdateOpts{
+			Action:      "REDIRECT_PREFIX",
+			RedirectURL: &redirectURL,
+		},
+		RedirectPrefix:   &redirectPrefix,
+		RedirectHTTPCode: &redirectHTTPCode,
+		Tags:             &tags,
+	}
+
+	expected := map[string]interface{}{
+		"l7policy": map[string]interface{}{
+			"action":             "REDIRECT_PREFIX",
+			"redirect_url":       nil,
+			"redirect_prefix":    "https://www.example.com",
+			"redirect_http_code": float64(308),
+			"tags":               []interface{}{},
+		},
+	}
+
+	actual, err := opts.ToL7PolicyUpdateMap()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestCheckL7PolicyAction(t *testing.T) {
+	assert.NoError(t, checkL7PolicyAction("REJECT", "", "", ""))
+	assert.NoError(t, checkL7PolicyAction("REDIRECT_TO_POOL", "", "pool", ""))
+	assert.NoError(t, checkL7PolicyAction("REDIRECT_TO_URL", "https://www.example.com", "", ""))
+	assert.NoError(t, checkL7PolicyAction("REDIRECT_PREFIX", "", "", "https://www.example.com"))
+
+	assert.Error(t, checkL7PolicyAction("REJECT", "", "", "https://www.example.com"))
+	assert.Error(t, checkL7PolicyAction("REDIRECT_TO_POOL", "", "pool", "https://www.example.com"))
+	assert.Error(t, checkL7PolicyAction("REDIRECT_TO_URL", "https://www.example.com", "", "https://www.example.com"))
+	assert.Error(t, checkL7PolicyAction("REDIRECT_PREFIX", "https://www.example.com", "", "https://www.example.com"))
+}
+
+func TestCheckL7PolicyRedirectHTTPCode(t *testing.T) {
+	assert.NoError(t, checkL7PolicyRedirectHTTPCode("REJECT", 0))
+	assert.NoError(t, checkL7PolicyRedirectHTTPCode("REDIRECT_TO_URL", 301))
+	assert.NoError(t, checkL7PolicyRedirectHTTPCode("REDIRECT_PREFIX", 307))
+
+	assert.Error(t, checkL7PolicyRedirectHTTPCode("REJECT", 302))
+	assert.Error(t, checkL7PolicyRedirectHTTPCode("REDIRECT_TO_POOL", 302))
+}
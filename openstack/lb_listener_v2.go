@@ -0,0 +1,89 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+	octavialisteners "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/listeners"
+)
+
+// lbV2ListenerMinAPIVersions contains the minimum Octavia API version
+// required by the listener arguments which are not available since 2.0.
+var lbV2ListenerMinAPIVersions = map[string]string{
+	"client_authentication":       "2.8",
+	"client_ca_tls_container_ref": "2.8",
+	"client_crl_container_ref":    "2.8",
+	"tls_ciphers":                 "2.15",
+	"tls_versions":                "2.17",
+	"alpn_protocols":              "2.20",
+}
+
+// ListenerCreateOpts represents the attributes used when creating a new Octavia listener.
+type ListenerCreateOpts struct {
+	octavialisteners.CreateOpts
+	TLSCiphers              string   `json:"tls_ciphers,omitempty"`
+	ALPNProtocols           []string `json:"alpn_protocols,omitempty"`
+	ClientAuthentication    string   `json:"client_authentication,omitempty"`
+	ClientCATLSContainerRef string   `json:"client_ca_tls_container_ref,omitempty"`
+	ClientCRLContainerRef   string   `json:"client_crl_container_ref,omitempty"`
+}
+
+// ToListenerCreateMap casts a CreateOpts struct to a map.
+// It overrides listeners.ToListenerCreateMap to add the TLS and client
+// authentication fields.
+func (opts ListenerCreateOpts) ToListenerCreateMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "listener")
+}
+
+// ListenerUpdateOpts represents the attributes used when updating an existing Octavia listener.
+type ListenerUpdateOpts struct {
+	octavialisteners.UpdateOpts
+	TLSCiphers              *string   `json:"tls_ciphers,omitempty"`
+	ALPNProtocols           *[]string `json:"alpn_protocols,omitempty"`
+	ClientAuthentication    *string   `json:"client_authentication,omitempty"`
+	ClientCATLSContainerRef *string   `json:"client_ca_tls_container_ref,omitempty"`
+	ClientCRLContainerRef   *string   `json:"client_crl_container_ref,omitempty"`
+}
+
+// ToListenerUpdateMap casts an UpdateOpts struct to a map.
+// It overrides listeners.ToListenerUpdateMap to add the TLS and client
+// authentication fields.
+func (opts ListenerUpdateOpts) ToListenerUpdateMap() (map[string]interface{}, error) {
+	b, err := BuildRequest(opts, "listener")
+	if err != nil {
+		return nil, err
+	}
+
+	// Octavia expects null to unset the client authentication containers.
+	m := b["listener"].(map[string]interface{})
+
+	if m["client_ca_tls_container_ref"] == "" {
+		m["client_ca_tls_container_ref"] = nil
+	}
+
+	if m["client_crl_container_ref"] == "" {
+		m["client_crl_container_ref"] = nil
+	}
+
+	return b, nil
+}
+
+// lbListenerV2 represents an Octavia listener including the attributes
+// which are not exposed by gophercloud.
+type lbListenerV2 struct {
+	octavialisteners.Listener
+	TLSCiphers              string   `json:"tls_ciphers"`
+	ALPNProtocols           []string `json:"alpn_protocols"`
+	ClientAuthentication    string   `json:"client_authentication"`
+	ClientCATLSContainerRef string   `json:"client_ca_tls_container_ref"`
+	ClientCRLContainerRef   string   `json:"client_crl_container_ref"`
+}
+
+func lbListenerV2Get(lbClient *gophercloud.ServiceClient, id string) (*lbListenerV2, error) {
+	var listener lbListenerV2
+
+	err := octavialisteners.Get(lbClient, id).ExtractIntoStructPtr(&listener, "listener")
+	if err != nil {
+		return nil, err
+	}
+
+	return &listener, nil
+}
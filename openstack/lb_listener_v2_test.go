@@ -0,0 +1,37 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	octavialisteners "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/listeners"
+)
+
+func TestListenerUpdateOptsToListenerUpdateMap(t *testing.T) {
+	name := "listener_1"
+	clientAuthentication := "NONE"
+	clientCATLSContainerRef := ""
+	clientCRLContainerRef := ""
+	opts := ListenerUpdateOpts{
+		UpdateOpts: octavialisteners.UpdateOpts{
+			Name: &name,
+		},
+		ClientAuthentication:    &clientAuthentication,
+		ClientCATLSContainerRef: &clientCATLSContainerRef,
+		ClientCRLContainerRef:   &clientCRLContainerRef,
+	}
+
+	expected := map[string]interface{}{
+		"listener": map[string]interface{}{
+			"name":                        "listener_1",
+			"client_authentication":       "NONE",
+			"client_ca_tls_container_ref": nil,
+			"client_crl_container_ref":    nil,
+		},
+	}
+
+	actual, err := opts.ToListenerUpdateMap()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
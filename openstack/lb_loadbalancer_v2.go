@@ -0,0 +1,99 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+	octavialoadbalancers "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/loadbalancers"
+)
+
+// lbV2LoadBalancerMinAPIVersions contains the minimum Octavia API version
+// required by the load balancer arguments which are not available since 2.0.
+var lbV2LoadBalancerMinAPIVersions = map[string]string{
+	"additional_vips": "2.26",
+}
+
+// lbLoadBalancerV2AdditionalVip represents an additional VIP of a load balancer.
+type lbLoadBalancerV2AdditionalVip struct {
+	SubnetID  string `json:"subnet_id"`
+	IPAddress string `json:"ip_address,omitempty"`
+}
+
+// LoadBalancerCreateOpts represents the attributes used when creating a new Octavia load balancer.
+type LoadBalancerCreateOpts struct {
+	octavialoadbalancers.CreateOpts
+	AdditionalVips []lbLoadBalancerV2AdditionalVip `json:"additional_vips,omitempty"`
+}
+
+// ToLoadBalancerCreateMap casts a CreateOpts struct to a map.
+// It overrides loadbalancers.ToLoadBalancerCreateMap to add the additional_vips field.
+func (opts LoadBalancerCreateOpts) ToLoadBalancerCreateMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "loadbalancer")
+}
+
+// lbLoadBalancerV2 represents an Octavia load balancer including the
+// attributes which are not exposed by gophercloud.
+type lbLoadBalancerV2 struct {
+	octavialoadbalancers.LoadBalancer
+	AdditionalVips []lbLoadBalancerV2AdditionalVip
+}
+
+// lbLoadBalancerV2Get retrieves an Octavia load balancer. The extra attributes
+// are extracted separately since octavialoadbalancers.LoadBalancer implements
+// its own json.Unmarshaler.
+func lbLoadBalancerV2Get(lbClient *gophercloud.ServiceClient, id string) (*lbLoadBalancerV2, error) {
+	r := octavialoadbalancers.Get(lbClient, id)
+
+	lb, err := r.Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	var s struct {
+		AdditionalVips []lbLoadBalancerV2AdditionalVip `json:"additional_vips"`
+	}
+	err = r.ExtractIntoStructPtr(&s, "loadbalancer")
+	if err != nil {
+		return nil, err
+	}
+
+	return &lbLoadBalancerV2{
+		LoadBalancer:   *lb,
+		AdditionalVips: s.AdditionalVips,
+	}, nil
+}
+
+func expandLBLoadBalancerV2AdditionalVips(raw []interface{}) []lbLoadBalancerV2AdditionalVip {
+	vips := make([]lbLoadBalancerV2AdditionalVip, 0, len(raw))
+
+	for _, v := range raw {
+		vip := v.(map[string]interface{})
+		vips = append(vips, lbLoadBalancerV2AdditionalVip{
+			SubnetID:  vip["subnet_id"].(string),
+			IPAddress: vip["ip_address"].(string),
+		})
+	}
+
+	return vips
+}
+
+func flattenLBLoadBalancerV2AdditionalVips(vips []lbLoadBalancerV2AdditionalVip) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(vips))
+
+	for _, vip := range vips {
+		res = append(res, map[string]interface{}{
+			"subnet_id":  vip.SubnetID,
+			"ip_address": vip.IPAddress,
+		})
+	}
+
+	return res
+}
+
+func flattenLBLoadBalancerV2Stats(stats *octavialoadbalancers.Stats) map[string]interface{} {
+	return map[string]interface{}{
+		"active_connections": stats.ActiveConnections,
+		"bytes_in":           stats.BytesIn,
+		"bytes_out":          stats.BytesOut,
+		"request_errors":     stats.RequestErrors,
+		"total_connections":  stats.TotalConnections,
+	}
+}
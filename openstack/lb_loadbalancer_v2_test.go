@@ -0,0 +1,90 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	octavialoadbalancers "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/loadbalancers"
+)
+
+func TestExpandLBLoadBalancerV2AdditionalVips(t *testing.T) {
+	raw := []interface{}{
+		map[string]interface{}{
+			"subnet_id":  "subnet_1",
+			"ip_address": "192.168.198.10",
+		},
+		map[string]interface{}{
+			"subnet_id":  "subnet_2",
+			"ip_address": "",
+		},
+	}
+
+	expected := []lbLoadBalancerV2AdditionalVip{
+		{SubnetID: "subnet_1", IPAddress: "192.168.198.10"},
+		{SubnetID: "subnet_2"},
+	}
+
+	assert.Equal(t, expected, expandLBLoadBalancerV2AdditionalVips(raw))
+}
+
+func TestFlattenLBLoadBalancerV2AdditionalVips(t *testing.T) {
+	vips := []lbLoadBalancerV2AdditionalVip{
+		{SubnetID: "subnet_1", IPAddress: "192.168.198.10"},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"subnet_id":  "subnet_1",
+			"ip_address": "192.168.198.10",
+		},
+	}
+
+	assert.Equal(t, expected, flattenLBLoadBalancerV2AdditionalVips(vips))
+}
+
+func TestLoadBalancerCreateOptsToLoadBalancerCreateMap(t *testing.T) {
+	opts := LoadBalancerCreateOpts{
+		CreateOpts: octavialoadbalancers.CreateOpts{
+			VipSubnetID: "subnet_1",
+		},
+		AdditionalVips: []lbLoadBalancerV2AdditionalVip{
+			{SubnetID: "subnet_2"},
+		},
+	}
+
+	expected := map[string]interface{}{
+		"loadbalancer": map[string]interface{}{
+			"vip_subnet_id": "subnet_1",
+			"additional_vips": []interface{}{
+				map[string]interface{}{
+					"subnet_id": "subnet_2",
+				},
+			},
+		},
+	}
+
+	actual, err := opts.ToLoadBalancerCreateMap()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestFlattenLBLoadBalancerV2Stats(t *testing.T) {
+	stats := &octavialoadbalancers.Stats{
+		ActiveConnections: 1,
+		BytesIn:           2,
+		BytesOut:          3,
+		RequestErrors:     4,
+		TotalConnections:  5,
+	}
+
+	expected := map[string]interface{}{
+		"active_connections": 1,
+		"bytes_in":           2,
+		"bytes_out":          3,
+		"request_errors":     4,
+		"total_connections":  5,
+	}
+
+	assert.Equal(t, expected, flattenLBLoadBalancerV2Stats(stats))
+}
@@ -0,0 +1,131 @@
+package openstack
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud"
+	octaviapools "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/pools"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/pools"
+)
+
+// MemberCreateOpts represents the attributes used when creating a new member.
+type MemberCreateOpts struct {
+	pools.CreateMemberOpts
+	Tags []string `json:"tags,omitempty"`
+}
+
+// ToMemberCreateMap casts a CreateOpts struct to a map.
+// It overrides pools.ToMemberCreateMap to add the tags field.
+func (opts MemberCreateOpts) ToMemberCreateMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "member")
+}
+
+// MemberUpdateOpts represents the attributes used when updating an existing member.
+type MemberUpdateOpts struct {
+	pools.UpdateMemberOpts
+	Tags *[]string `json:"tags,omitempty"`
+}
+
+// ToMemberUpdateMap casts an UpdateOpts struct to a map.
+// It overrides pools.ToMemberUpdateMap to add the tags field.
+func (opts MemberUpdateOpts) ToMemberUpdateMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "member")
+}
+
+// BatchUpdateMemberOpts represents the attributes of a member in a batch
+// update of the members.
+type BatchUpdateMemberOpts struct {
+	octaviapools.BatchUpdateMemberOpts
+	Tags *[]string `json:"tags,omitempty"`
+}
+
+// ToBatchMemberUpdateMap casts a BatchUpdateMemberOpts struct to a map.
+// It overrides octaviapools.ToBatchMemberUpdateMap to send an empty list of
+// tags, which removes the tags of the member.
+func (opts BatchUpdateMemberOpts) ToBatchMemberUpdateMap() (map[string]interface{}, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if b["subnet_id"] == "" {
+		b["subnet_id"] = nil
+	}
+
+	return b, nil
+}
+
+// lbMemberV2 represents a member including the Octavia attributes which are
+// not exposed by gophercloud.
+type lbMemberV2 struct {
+	pools.Member
+	Tags []string `json:"tags"`
+}
+
+// lbMemberV2Create creates a member. It replaces pools.CreateMember which
+// doesn't accept a pools.CreateMemberOptsBuilder.
+func lbMemberV2Create(lbClient *gophercloud.ServiceClient, poolID string, opts pools.CreateMemberOptsBuilder) (r pools.CreateMemberResult) {
+	b, err := opts.ToMemberCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := lbClient.Post(lbClient.ServiceURL("lbaas", "pools", poolID, "members"), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// lbMembersV2BatchUpdate updates the members of a pool in a batch. It
+// replaces octaviapools.BatchUpdateMembers which doesn't accept a
+// octaviapools.BatchUpdateMemberOptsBuilder.
+func lbMembersV2BatchUpdate(lbClient *gophercloud.ServiceClient, poolID string, opts []BatchUpdateMemberOpts) (r octaviapools.UpdateMembersResult) {
+	members := make([]map[string]interface{}, 0, len(opts))
+	for _, opt := range opts {
+		b, err := opt.ToBatchMemberUpdateMap()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		members = append(members, b)
+	}
+
+	b := map[string]interface{}{"members": members}
+
+	resp, err := lbClient.Put(lbClient.ServiceURL("lbaas", "pools", poolID, "members"), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func lbMemberV2Get(lbClient *gophercloud.ServiceClient, poolID, id string) (*lbMemberV2, error) {
+	var member lbMemberV2
+
+	err := pools.GetMember(lbClient, poolID, id).ExtractIntoStructPtr(&member, "member")
+	if err != nil {
+		return nil, err
+	}
+
+	return &member, nil
+}
+
+// lbMemberV2DrainDiffSuppressFunc returns a DiffSuppressFunc, which
+// suppresses the diff of an attribute set to its drained value outside of
+// Terraform, when ignore_drain_changes is enabled. Any other change, e.g.
+// draining the member in the configuration, is still applied.
+func lbMemberV2DrainDiffSuppressFunc(drained string) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		if !d.Get("ignore_drain_changes").(bool) || new == "" {
+			return false
+		}
+
+		if old == drained && new != drained {
+			log.Printf("[DEBUG] Ignoring drained %s %s of %s", k, old, d.Id())
+			return true
+		}
+
+		return false
+	}
+}
@@ -0,0 +1,85 @@
+package openstack
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/pools"
+)
+
+func TestMemberCreateOptsToMemberCreateMap(t *testing.T) {
+	opts := MemberCreateOpts{
+		CreateMemberOpts: pools.CreateMemberOpts{
+			Address:      "192.168.199.110",
+			ProtocolPort: 8080,
+		},
+		Tags: []string{"tag1", "tag2"},
+	}
+
+	expected := map[string]interface{}{
+		"member": map[string]interface{}{
+			"address":       "192.168.199.110",
+			"protocol_port": float64(8080),
+			"tags":          []interface{}{"tag1", "tag2"},
+		},
+	}
+
+	actual, err := opts.ToMemberCreateMap()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestLBMemberV2IgnoreDrainChangesDiff(t *testing.T) {
+	newState := func(weight, adminStateUp, ignore string) *terraform.InstanceState {
+		return &terraform.InstanceState{
+			ID: "member-1",
+			Attributes: map[string]string{
+				"id":                   "member-1",
+				"address":              "192.168.199.110",
+				"protocol_port":        "8080",
+				"pool_id":              "pool-1",
+				"weight":               weight,
+				"admin_state_up":       adminStateUp,
+				"ignore_drain_changes": ignore,
+			},
+		}
+	}
+
+	newConfig := func(weight int, adminStateUp, ignore bool) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"address":              "192.168.199.110",
+			"protocol_port":        8080,
+			"pool_id":              "pool-1",
+			"weight":               weight,
+			"admin_state_up":       adminStateUp,
+			"ignore_drain_changes": ignore,
+		})
+	}
+
+	r := resourceMemberV2()
+
+	// A member drained outside of Terraform is not reverted.
+	diff, err := r.Diff(context.Background(), newState("0", "false", "true"), newConfig(10, true, true), nil)
+	assert.NoError(t, err)
+	assert.Nil(t, diff)
+
+	// Without ignore_drain_changes the drained member is reverted.
+	diff, err = r.Diff(context.Background(), newState("0", "false", "false"), newConfig(10, true, false), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "10", diff.Attributes["weight"].New)
+	assert.Equal(t, "true", diff.Attributes["admin_state_up"].New)
+
+	// Draining the member in the configuration is applied.
+	diff, err = r.Diff(context.Background(), newState("10", "true", "true"), newConfig(0, false, true), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "0", diff.Attributes["weight"].New)
+	assert.Equal(t, "false", diff.Attributes["admin_state_up"].New)
+
+	// Other changes of the configuration are applied.
+	diff, err = r.Diff(context.Background(), newState("10", "true", "true"), newConfig(20, true, true), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "20", diff.Attributes["weight"].New)
+}
@@ -0,0 +1,112 @@
+package openstack
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud"
+	octaviamonitors "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/monitors"
+)
+
+// lbV2MonitorMinAPIVersions contains the minimum Octavia API version
+// required by the monitor arguments which are not available since 2.0.
+var lbV2MonitorMinAPIVersions = map[string]string{
+	"http_version": "2.10",
+	"domain_name":  "2.10",
+}
+
+// lbV2MonitorTypeMinAPIVersions contains the minimum Octavia API version
+// required by the monitor types which are not available since 2.0.
+var lbV2MonitorTypeMinAPIVersions = map[string]string{
+	"SCTP": "2.23",
+}
+
+// MonitorCreateOpts represents the attributes used when creating a new Octavia monitor.
+type MonitorCreateOpts struct {
+	octaviamonitors.CreateOpts
+	HTTPVersion *float64 `json:"http_version,omitempty"`
+	DomainName  string   `json:"domain_name,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+}
+
+// ToMonitorCreateMap casts a CreateOpts struct to a map.
+// It overrides monitors.ToMonitorCreateMap to add the http_version,
+// domain_name and tags fields.
+func (opts MonitorCreateOpts) ToMonitorCreateMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "healthmonitor")
+}
+
+// MonitorUpdateOpts represents the attributes used when updating an existing Octavia monitor.
+type MonitorUpdateOpts struct {
+	octaviamonitors.UpdateOpts
+	HTTPVersion *float64  `json:"http_version,omitempty"`
+	DomainName  *string   `json:"domain_name,omitempty"`
+	Tags        *[]string `json:"tags,omitempty"`
+}
+
+// ToMonitorUpdateMap casts an UpdateOpts struct to a map.
+// It overrides monitors.ToMonitorUpdateMap to add the http_version,
+// domain_name and tags fields.
+func (opts MonitorUpdateOpts) ToMonitorUpdateMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "healthmonitor")
+}
+
+// lbMonitorV2 represents an Octavia monitor including the attributes
+// which are not exposed by gophercloud.
+type lbMonitorV2 struct {
+	octaviamonitors.Monitor
+	HTTPVersion *float64 `json:"http_version"`
+	DomainName  string   `json:"domain_name"`
+	Tags        []string `json:"tags"`
+}
+
+func lbMonitorV2Get(lbClient *gophercloud.ServiceClient, id string) (*lbMonitorV2, error) {
+	var monitor lbMonitorV2
+
+	err := octaviamonitors.Get(lbClient, id).ExtractIntoStructPtr(&monitor, "healthmonitor")
+	if err != nil {
+		return nil, err
+	}
+
+	return &monitor, nil
+}
+
+// expandLBMonitorV2HTTPVersion converts the http_version argument into the
+// number expected by the Octavia API.
+func expandLBMonitorV2HTTPVersion(v string) (*float64, error) {
+	if v == "" {
+		return nil, nil
+	}
+
+	version, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid http_version %s: %s", v, err)
+	}
+
+	return &version, nil
+}
+
+func flattenLBMonitorV2HTTPVersion(v *float64) string {
+	if v == nil {
+		return ""
+	}
+
+	return strconv.FormatFloat(*v, 'f', 1, 64)
+}
+
+// lbV2CheckMonitorAPIVersion verifies that the Octavia API supports the
+// monitor arguments and the monitor type.
+func lbV2CheckMonitorAPIVersion(d *schema.ResourceData, lbClient *gophercloud.ServiceClient, create bool) error {
+	if create {
+		monitorType := d.Get("type").(string)
+		if required, ok := lbV2MonitorTypeMinAPIVersions[monitorType]; ok {
+			if err := lbV2CheckMinAPIVersion(lbClient, required, fmt.Sprintf("type %s", monitorType)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return lbV2CheckArgumentsAPIVersion(d, lbClient, lbV2MonitorMinAPIVersions, create)
+}
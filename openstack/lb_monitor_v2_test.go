@@ -0,0 +1,60 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	octaviamonitors "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/monitors"
+)
+
+func TestExpandLBMonitorV2HTTPVersion(t *testing.T) {
+	actual, err := expandLBMonitorV2HTTPVersion("1.1")
+	assert.NoError(t, err)
+	assert.Equal(t, 1.1, *actual)
+
+	actual, err = expandLBMonitorV2HTTPVersion("")
+	assert.NoError(t, err)
+	assert.Nil(t, actual)
+
+	_, err = expandLBMonitorV2HTTPVersion("foo")
+	assert.Error(t, err)
+}
+
+func TestFlattenLBMonitorV2HTTPVersion(t *testing.T) {
+	version := 1.0
+
+	assert.Equal(t, "1.0", flattenLBMonitorV2HTTPVersion(&version))
+	assert.Equal(t, "", flattenLBMonitorV2HTTPVersion(nil))
+}
+
+func TestMonitorCreateOptsToMonitorCreateMap(t *testing.T) {
+	httpVersion := 1.1
+	opts := MonitorCreateOpts{
+		CreateOpts: octaviamonitors.CreateOpts{
+			PoolID:     "pool",
+			Type:       "HTTP",
+			Delay:      20,
+			Timeout:    10,
+			MaxRetries: 5,
+		},
+		HTTPVersion: &httpVersion,
+		DomainName:  "www.example.com",
+	}
+
+	expected := map[string]interface{}{
+		"healthmonitor": map[string]interface{}{
+			"pool_id":      "pool",
+			"type":         "HTTP",
+			"delay":        float64(20),
+			"timeout":      float64(10),
+			"max_retries":  float64(5),
+			"http_version": 1.1,
+			"domain_name":  "www.example.com",
+		},
+	}
+
+	actual, err := opts.ToMonitorCreateMap()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
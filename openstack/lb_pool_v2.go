@@ -0,0 +1,128 @@
+package openstack
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/pools"
+)
+
+// lbV2PoolMinAPIVersions contains the minimum Octavia API version
+// required by the pool arguments which are not available since 2.0.
+var lbV2PoolMinAPIVersions = map[string]string{
+	"tls_enabled":          "2.8",
+	"tls_container_ref":    "2.8",
+	"ca_tls_container_ref": "2.8",
+	"crl_container_ref":    "2.8",
+	"tls_ciphers":          "2.15",
+	"tls_versions":         "2.17",
+}
+
+// PoolCreateOpts represents the attributes used when creating a new pool.
+type PoolCreateOpts struct {
+	pools.CreateOpts
+	TLSEnabled        *bool    `json:"tls_enabled,omitempty"`
+	TLSContainerRef   string   `json:"tls_container_ref,omitempty"`
+	CATLSContainerRef string   `json:"ca_tls_container_ref,omitempty"`
+	CRLContainerRef   string   `json:"crl_container_ref,omitempty"`
+	TLSCiphers        string   `json:"tls_ciphers,omitempty"`
+	TLSVersions       []string `json:"tls_versions,omitempty"`
+	Tags              []string `json:"tags,omitempty"`
+}
+
+// ToPoolCreateMap casts a CreateOpts struct to a map.
+// It overrides pools.ToPoolCreateMap to add the backend re-encryption and
+// tags fields.
+func (opts PoolCreateOpts) ToPoolCreateMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "pool")
+}
+
+// PoolUpdateOpts represents the attributes used when updating an existing pool.
+type PoolUpdateOpts struct {
+	pools.UpdateOpts
+	TLSEnabled        *bool                     `json:"tls_enabled,omitempty"`
+	TLSContainerRef   *string                   `json:"tls_container_ref,omitempty"`
+	CATLSContainerRef *string                   `json:"ca_tls_container_ref,omitempty"`
+	CRLContainerRef   *string                   `json:"crl_container_ref,omitempty"`
+	TLSCiphers        *string                   `json:"tls_ciphers,omitempty"`
+	TLSVersions       *[]string                 `json:"tls_versions,omitempty"`
+	Tags              *[]string                 `json:"tags,omitempty"`
+	Persistence       *pools.SessionPersistence `json:"session_persistence,omitempty"`
+}
+
+// ToPoolUpdateMap casts an UpdateOpts struct to a map.
+// It overrides pools.ToPoolUpdateMap to add the backend re-encryption,
+// tags and session persistence fields, and to send null for the cleared TLS
+// containers.
+func (opts PoolUpdateOpts) ToPoolUpdateMap() (map[string]interface{}, error) {
+	b, err := BuildRequest(opts, "pool")
+	if err != nil {
+		return nil, err
+	}
+
+	m := b["pool"].(map[string]interface{})
+
+	// An empty session persistence is sent as null to remove it.
+	if opts.Persistence != nil && opts.Persistence.Type == "" {
+		m["session_persistence"] = nil
+	}
+
+	// Octavia expects null to unset the TLS containers.
+	for _, k := range []string{"tls_container_ref", "ca_tls_container_ref", "crl_container_ref"} {
+		if m[k] == "" {
+			m[k] = nil
+		}
+	}
+
+	return b, nil
+}
+
+// lbPoolV2 represents a pool including the Octavia attributes which are
+// not exposed by gophercloud.
+type lbPoolV2 struct {
+	pools.Pool
+	TLSEnabled        bool     `json:"tls_enabled"`
+	TLSContainerRef   string   `json:"tls_container_ref"`
+	CATLSContainerRef string   `json:"ca_tls_container_ref"`
+	CRLContainerRef   string   `json:"crl_container_ref"`
+	TLSCiphers        string   `json:"tls_ciphers"`
+	TLSVersions       []string `json:"tls_versions"`
+	Tags              []string `json:"tags"`
+}
+
+func lbPoolV2Get(lbClient *gophercloud.ServiceClient, id string) (*lbPoolV2, error) {
+	var pool lbPoolV2
+
+	err := pools.Get(lbClient, id).ExtractIntoStructPtr(&pool, "pool")
+	if err != nil {
+		return nil, err
+	}
+
+	return &pool, nil
+}
+
+// lbPoolV2TLSCustomizeDiff ensures that the backend re-encryption arguments
+// are only used with the protocols supporting them.
+func lbPoolV2TLSCustomizeDiff(diff *schema.ResourceDiff) error {
+	protocol := diff.Get("protocol").(string)
+	tlsEnabled := diff.Get("tls_enabled").(bool)
+
+	if tlsEnabled {
+		switch protocol {
+		case "PROXY", "PROXYV2", "UDP", "SCTP":
+			return fmt.Errorf("tls_enabled can not be set to true for a %s pool", protocol)
+		}
+
+		return nil
+	}
+
+	for _, v := range []string{"tls_container_ref", "ca_tls_container_ref", "crl_container_ref"} {
+		if diff.Get(v).(string) != "" {
+			return fmt.Errorf("%s can only be set if tls_enabled is true", v)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/pools"
+)
+
+func TestPoolCreateOptsToPoolCreateMap(t *testing.T) {
+	tlsEnabled := true
+	opts := PoolCreateOpts{
+		CreateOpts: pools.CreateOpts{
+			LBMethod:       pools.LBMethodRoundRobin,
+			Protocol:       pools.ProtocolHTTP,
+			LoadbalancerID: "lb",
+		},
+		TLSEnabled:  &tlsEnabled,
+		TLSVersions: []string{"TLSv1.3"},
+	}
+
+	expected := map[string]interface{}{
+		"pool": map[string]interface{}{
+			"lb_algorithm":    "ROUND_ROBIN",
+			"protocol":        "HTTP",
+			"loadbalancer_id": "lb",
+			"tls_enabled":     true,
+			"tls_versions":    []interface{}{"TLSv1.3"},
+		},
+	}
+
+	actual, err := opts.ToPoolCreateMap()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestPoolUpdateOptsToPoolUpdateMap(t *testing.T) {
+	tlsEnabled := false
+	tlsCiphers := "ECDHE-RSA-AES256-GCM-SHA384"
+	opts := PoolUpdateOpts{
+		TLSEnabled: &tlsEnabled,
+		TLSCiphers: &tlsCiphers,
+	}
+
+	expected := map[string]interface{}{
+		"pool": map[string]interface{}{
+			"tls_enabled": false,
+			"tls_ciphers": "ECDHE-RSA-AES256-GCM-SHA384",
+		},
+	}
+
+	actual, err := opts.ToPoolUpdateMap()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestPoolUpdateOptsToPoolUpdateMap_persistence(t *testing.T) {
+	opts := PoolUpdateOpts{
+		Persistence: &pools.SessionPersistence{
+			Type:       "APP_COOKIE",
+			CookieName: "session",
+		},
+	}
+
+	expected := map[string]interface{}{
+		"pool": map[string]interface{}{
+			"session_persistence": map[string]interface{}{
+				"type":        "APP_COOKIE",
+				"cookie_name": "session",
+			},
+		},
+	}
+
+	actual, err := opts.ToPoolUpdateMap()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+
+	opts.Persistence = &pools.SessionPersistence{}
+
+	expected = map[string]interface{}{
+		"pool": map[string]interface{}{
+			"session_persistence": nil,
+		},
+	}
+
+	actual, err = opts.ToPoolUpdateMap()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestPoolUpdateOptsToPoolUpdateMap_containers(t *testing.T) {
+	tlsContainerRef := "https://barbican.example.com/v1/containers/1"
+	caTLSContainerRef := ""
+	crlContainerRef := ""
+	opts := PoolUpdateOpts{
+		TLSContainerRef:   &tlsContainerRef,
+		CATLSContainerRef: &caTLSContainerRef,
+		CRLContainerRef:   &crlContainerRef,
+	}
+
+	expected := map[string]interface{}{
+		"pool": map[string]interface{}{
+			"tls_container_ref":    "https://barbican.example.com/v1/containers/1",
+			"ca_tls_container_ref": nil,
+			"crl_container_ref":    nil,
+		},
+	}
+
+	actual, err := opts.ToPoolUpdateMap()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
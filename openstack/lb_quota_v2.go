@@ -0,0 +1,12 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// lbQuotaV2Delete resets the quota of a project to the default values.
+func lbQuotaV2Delete(client *gophercloud.ServiceClient, projectID string) (r gophercloud.ErrResult) {
+	resp, err := client.Delete(client.ServiceURL("quotas", projectID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
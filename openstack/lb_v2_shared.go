@@ -4,12 +4,15 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/gophercloud/gophercloud"
+	octaviaapiversions "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/apiversions"
 	octavialisteners "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/listeners"
 	octavialoadbalancers "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/loadbalancers"
 	octaviamonitors "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/monitors"
@@ -67,9 +70,142 @@ func chooseLBV2AccTestClient(config *Config, region string) (*gophercloud.Servic
 	return config.NetworkingV2Client(region)
 }
 
+// lbV2MaxAPIVersion returns the highest Octavia API version out of a list
+// of API versions, e.g. "2.17" for "v2.0", ..., "v2.17".
+func lbV2MaxAPIVersion(versions []octaviaapiversions.APIVersion) string {
+	var maxVersion string
+
+	for _, v := range versions {
+		version := strings.TrimPrefix(v.ID, "v")
+		if maxVersion == "" {
+			maxVersion = version
+			continue
+		}
+
+		if newer, err := compatibleMicroversion("min", maxVersion, version); err == nil && newer {
+			maxVersion = version
+		}
+	}
+
+	return maxVersion
+}
+
+// lbV2CheckMinAPIVersion returns an error if the Octavia API doesn't support
+// the required API version of a given argument.
+func lbV2CheckMinAPIVersion(lbClient *gophercloud.ServiceClient, required string, argument string) error {
+	allPages, err := octaviaapiversions.List(lbClient).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to query Octavia API versions: %s", err)
+	}
+
+	allVersions, err := octaviaapiversions.ExtractAPIVersions(allPages)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve Octavia API versions: %s", err)
+	}
+
+	maxVersion := lbV2MaxAPIVersion(allVersions)
+	compatible, err := compatibleMicroversion("min", required, maxVersion)
+	if err != nil {
+		return fmt.Errorf("Error comparing Octavia API versions: %s", err)
+	}
+
+	if !compatible {
+		return fmt.Errorf("%s is not supported by this cloud: it requires Octavia API version %s, "+
+			"the highest supported version is %s", argument, required, maxVersion)
+	}
+
+	return nil
+}
+
+// lbV2CheckArgumentsAPIVersion verifies that the Octavia API supports the
+// arguments of minVersions which are set on create or changed on update.
+func lbV2CheckArgumentsAPIVersion(d *schema.ResourceData, lbClient *gophercloud.ServiceClient, minVersions map[string]string, create bool) error {
+	var required, argument string
+
+	for k, version := range minVersions {
+		var used bool
+		if create {
+			_, used = d.GetOk(k)
+		} else {
+			used = d.HasChange(k)
+		}
+
+		if !used {
+			continue
+		}
+
+		if required != "" {
+			// Skip the argument if its version isn't newer than the required one.
+			if older, err := compatibleMicroversion("min", version, required); err != nil || older {
+				continue
+			}
+		}
+
+		required = version
+		argument = k
+	}
+
+	if required == "" {
+		return nil
+	}
+
+	return lbV2CheckMinAPIVersion(lbClient, required, argument)
+}
+
+// lbV2TagsMinAPIVersion is the minimum Octavia API version supporting tags.
+const lbV2TagsMinAPIVersion = "2.5"
+
+// lbV2ExpandTags returns the tags to send to the load balancing API when they
+// are set on create or changed on update. The default tags of the provider
+// are merged into them. The tags are skipped with a warning when the API
+// doesn't support them.
+func lbV2ExpandTags(d *schema.ResourceData, lbClient *gophercloud.ServiceClient, defaultTags []string, create bool) *[]string {
+	if create {
+		if _, ok := d.GetOk("tags"); !ok && len(defaultTags) == 0 {
+			return nil
+		}
+	} else if !d.HasChange("tags") {
+		return nil
+	}
+
+	if !lbV2SupportsTags(lbClient) {
+		return nil
+	}
+
+	tags := expandObjectDefaultTags(defaultTags, expandToStringSlice(d.Get("tags").(*schema.Set).List()))
+
+	return &tags
+}
+
+// lbV2SetTags sets the tags returned by the load balancing API. No tags are
+// returned when the API doesn't support them, in which case the configured
+// tags are kept to avoid a perpetual diff. Default tags of the provider,
+// which are not configured on the resource, are not set either.
+func lbV2SetTags(d *schema.ResourceData, tags []string, defaultTags []string) {
+	if tags == nil {
+		return
+	}
+
+	configuredTags := d.Get("tags").(*schema.Set)
+	isDefaultTag := make(map[string]bool, len(defaultTags))
+	for _, tag := range defaultTags {
+		isDefaultTag[tag] = true
+	}
+
+	resourceTags := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if isDefaultTag[tag] && !configuredTags.Contains(tag) {
+			continue
+		}
+		resourceTags = append(resourceTags, tag)
+	}
+
+	d.Set("tags", resourceTags)
+}
+
 // chooseLBV2LoadbalancerUpdateOpts will determine which load balancer update options to use:
 // either the Octavia/LBaaS or the Neutron/Networking v2.
-func chooseLBV2LoadbalancerUpdateOpts(d *schema.ResourceData, config *Config) (neutronloadbalancers.UpdateOptsBuilder, error) {
+func chooseLBV2LoadbalancerUpdateOpts(d *schema.ResourceData, config *Config, lbClient *gophercloud.ServiceClient) (neutronloadbalancers.UpdateOptsBuilder, error) {
 	var hasChange bool
 
 	if config.UseOctavia {
@@ -92,15 +228,9 @@ func chooseLBV2LoadbalancerUpdateOpts(d *schema.ResourceData, config *Config) (n
 			updateOpts.AdminStateUp = &asu
 		}
 
-		if d.HasChange("tags") {
+		if tags := lbV2ExpandTags(d, lbClient, config.DefaultTags, false); tags != nil {
 			hasChange = true
-			if v, ok := d.GetOk("tags"); ok {
-				tags := v.(*schema.Set).List()
-				tagsToUpdate := expandToStringSlice(tags)
-				updateOpts.Tags = &tagsToUpdate
-			} else {
-				updateOpts.Tags = &[]string{}
-			}
+			updateOpts.Tags = tags
 		}
 
 		if hasChange {
@@ -136,7 +266,7 @@ func chooseLBV2LoadbalancerUpdateOpts(d *schema.ResourceData, config *Config) (n
 
 // chooseLBV2ListenerCreateOpts will determine which load balancer listener Create options to use:
 // either the Octavia/LBaaS or the Neutron/Networking v2.
-func chooseLBV2ListenerCreateOpts(d *schema.ResourceData, config *Config) (neutronlisteners.CreateOptsBuilder, error) {
+func chooseLBV2ListenerCreateOpts(d *schema.ResourceData, config *Config, lbClient *gophercloud.ServiceClient) (neutronlisteners.CreateOptsBuilder, error) {
 	adminStateUp := d.Get("admin_state_up").(bool)
 
 	var sniContainerRefs []string
@@ -206,7 +336,29 @@ func chooseLBV2ListenerCreateOpts(d *schema.ResourceData, config *Config) (neutr
 			opts.AllowedCIDRs = allowedCidrs
 		}
 
-		createOpts = opts
+		if raw, ok := d.GetOk("tls_versions"); ok {
+			for _, v := range raw.(*schema.Set).List() {
+				opts.TLSVersions = append(opts.TLSVersions, octavialisteners.TLSVersion(v.(string)))
+			}
+		}
+
+		if tags := lbV2ExpandTags(d, lbClient, config.DefaultTags, true); tags != nil {
+			opts.Tags = *tags
+		}
+
+		extOpts := ListenerCreateOpts{
+			CreateOpts:              opts,
+			TLSCiphers:              d.Get("tls_ciphers").(string),
+			ClientAuthentication:    d.Get("client_authentication").(string),
+			ClientCATLSContainerRef: d.Get("client_ca_tls_container_ref").(string),
+			ClientCRLContainerRef:   d.Get("client_crl_container_ref").(string),
+		}
+
+		if raw, ok := d.GetOk("alpn_protocols"); ok {
+			extOpts.ALPNProtocols = expandToStringSlice(raw.(*schema.Set).List())
+		}
+
+		createOpts = extOpts
 
 		return createOpts, nil
 	}
@@ -237,7 +389,7 @@ func chooseLBV2ListenerCreateOpts(d *schema.ResourceData, config *Config) (neutr
 
 // chooseLBV2ListenerUpdateOpts will determine which load balancer listener Update options to use:
 // either the Octavia/LBaaS or the Neutron/Networking v2.
-func chooseLBV2ListenerUpdateOpts(d *schema.ResourceData, config *Config) (neutronlisteners.UpdateOptsBuilder, error) {
+func chooseLBV2ListenerUpdateOpts(d *schema.ResourceData, config *Config, lbClient *gophercloud.ServiceClient) (neutronlisteners.UpdateOptsBuilder, error) {
 	var hasChange bool
 
 	if config.UseOctavia {
@@ -329,7 +481,9 @@ func chooseLBV2ListenerUpdateOpts(d *schema.ResourceData, config *Config) (neutr
 
 		if d.HasChange("allowed_cidrs") {
 			hasChange = true
-			var allowedCidrs []string
+			// An explicit empty list is required to clear
+			// the allowed CIDRs, since null is ignored.
+			allowedCidrs := []string{}
 			if raw, ok := d.GetOk("allowed_cidrs"); ok {
 				for _, v := range raw.([]interface{}) {
 					allowedCidrs = append(allowedCidrs, v.(string))
@@ -338,8 +492,56 @@ func chooseLBV2ListenerUpdateOpts(d *schema.ResourceData, config *Config) (neutr
 			opts.AllowedCIDRs = &allowedCidrs
 		}
 
+		if d.HasChange("tls_versions") {
+			hasChange = true
+			tlsVersions := []octavialisteners.TLSVersion{}
+			for _, v := range d.Get("tls_versions").(*schema.Set).List() {
+				tlsVersions = append(tlsVersions, octavialisteners.TLSVersion(v.(string)))
+			}
+			opts.TLSVersions = &tlsVersions
+		}
+
+		if tags := lbV2ExpandTags(d, lbClient, config.DefaultTags, false); tags != nil {
+			hasChange = true
+			opts.Tags = tags
+		}
+
+		extOpts := ListenerUpdateOpts{
+			UpdateOpts: opts,
+		}
+
+		if d.HasChange("tls_ciphers") {
+			hasChange = true
+			tlsCiphers := d.Get("tls_ciphers").(string)
+			extOpts.TLSCiphers = &tlsCiphers
+		}
+
+		if d.HasChange("alpn_protocols") {
+			hasChange = true
+			alpnProtocols := expandToStringSlice(d.Get("alpn_protocols").(*schema.Set).List())
+			extOpts.ALPNProtocols = &alpnProtocols
+		}
+
+		if d.HasChange("client_authentication") {
+			hasChange = true
+			clientAuthentication := d.Get("client_authentication").(string)
+			extOpts.ClientAuthentication = &clientAuthentication
+		}
+
+		if d.HasChange("client_ca_tls_container_ref") {
+			hasChange = true
+			clientCATLSContainerRef := d.Get("client_ca_tls_container_ref").(string)
+			extOpts.ClientCATLSContainerRef = &clientCATLSContainerRef
+		}
+
+		if d.HasChange("client_crl_container_ref") {
+			hasChange = true
+			clientCRLContainerRef := d.Get("client_crl_container_ref").(string)
+			extOpts.ClientCRLContainerRef = &clientCRLContainerRef
+		}
+
 		if hasChange {
-			return opts, nil
+			return extOpts, nil
 		}
 	}
 
@@ -470,7 +672,7 @@ func resourceLBV2ListenerRefreshFunc(lbClient *gophercloud.ServiceClient, lbID s
 
 // chooseLBV2MonitorCreateOpts will determine which load balancer monitor Create options to use:
 // either the Octavia/LBaaS or the Neutron/Networking v2.
-func chooseLBV2MonitorCreateOpts(d *schema.ResourceData, config *Config) neutronmonitors.CreateOptsBuilder {
+func chooseLBV2MonitorCreateOpts(d *schema.ResourceData, config *Config, lbClient *gophercloud.ServiceClient) (neutronmonitors.CreateOptsBuilder, error) {
 	adminStateUp := d.Get("admin_state_up").(bool)
 
 	var createOpts neutronmonitors.CreateOptsBuilder
@@ -492,7 +694,22 @@ func chooseLBV2MonitorCreateOpts(d *schema.ResourceData, config *Config) neutron
 			AdminStateUp:   &adminStateUp,
 		}
 
-		createOpts = opts
+		httpVersion, err := expandLBMonitorV2HTTPVersion(d.Get("http_version").(string))
+		if err != nil {
+			return nil, err
+		}
+
+		extOpts := MonitorCreateOpts{
+			CreateOpts:  opts,
+			HTTPVersion: httpVersion,
+			DomainName:  d.Get("domain_name").(string),
+		}
+
+		if tags := lbV2ExpandTags(d, lbClient, config.DefaultTags, true); tags != nil {
+			extOpts.Tags = *tags
+		}
+
+		createOpts = extOpts
 	} else {
 		// Use Neutron.
 		opts := neutronmonitors.CreateOpts{
@@ -512,17 +729,17 @@ func chooseLBV2MonitorCreateOpts(d *schema.ResourceData, config *Config) neutron
 		createOpts = opts
 	}
 
-	return createOpts
+	return createOpts, nil
 }
 
 // chooseLBV2MonitorUpdateOpts will determine which load balancer monitor Update options to use:
 // either the Octavia/LBaaS or the Neutron/Networking v2.
-func chooseLBV2MonitorUpdateOpts(d *schema.ResourceData, config *Config) neutronmonitors.UpdateOptsBuilder {
+func chooseLBV2MonitorUpdateOpts(d *schema.ResourceData, config *Config, lbClient *gophercloud.ServiceClient) (neutronmonitors.UpdateOptsBuilder, error) {
 	var hasChange bool
 
 	if config.UseOctavia {
 		// Use Octavia.
-		var opts octaviamonitors.UpdateOpts
+		var opts MonitorUpdateOpts
 
 		if d.HasChange("url_path") {
 			hasChange = true
@@ -562,9 +779,26 @@ func chooseLBV2MonitorUpdateOpts(d *schema.ResourceData, config *Config) neutron
 			hasChange = true
 			opts.HTTPMethod = d.Get("http_method").(string)
 		}
+		if d.HasChange("http_version") {
+			hasChange = true
+			httpVersion, err := expandLBMonitorV2HTTPVersion(d.Get("http_version").(string))
+			if err != nil {
+				return nil, err
+			}
+			opts.HTTPVersion = httpVersion
+		}
+		if d.HasChange("domain_name") {
+			hasChange = true
+			domainName := d.Get("domain_name").(string)
+			opts.DomainName = &domainName
+		}
+		if tags := lbV2ExpandTags(d, lbClient, config.DefaultTags, false); tags != nil {
+			hasChange = true
+			opts.Tags = tags
+		}
 
 		if hasChange {
-			return opts
+			return opts, nil
 		}
 	} else {
 		// Use Neutron.
@@ -606,11 +840,11 @@ func chooseLBV2MonitorUpdateOpts(d *schema.ResourceData, config *Config) neutron
 		}
 
 		if hasChange {
-			return opts
+			return opts, nil
 		}
 	}
 
-	return nil
+	return nil, nil
 }
 
 func waitForLBV2LoadBalancer(ctx context.Context, lbClient *gophercloud.ServiceClient, lbID string, target string, pending []string, timeout time.Duration) error {
@@ -1076,7 +1310,39 @@ func waitForLBV2L7Rule(ctx context.Context, lbClient *gophercloud.ServiceClient,
 	return nil
 }
 
+// expandLBPoolPersistenceV2 returns an empty SessionPersistence when the
+// persistence block is not set.
+func expandLBPoolPersistenceV2(raw []interface{}) (neutronpools.SessionPersistence, error) {
+	var persistence neutronpools.SessionPersistence
+	if len(raw) == 0 || raw[0] == nil {
+		return persistence, nil
+	}
+
+	pV := raw[0].(map[string]interface{})
+
+	persistence.Type = pV["type"].(string)
+
+	if persistence.Type == "APP_COOKIE" {
+		if pV["cookie_name"].(string) == "" {
+			return persistence, fmt.Errorf(
+				"Persistence cookie_name needs to be set if using 'APP_COOKIE' persistence type")
+		}
+		persistence.CookieName = pV["cookie_name"].(string)
+	} else {
+		if pV["cookie_name"].(string) != "" {
+			return persistence, fmt.Errorf(
+				"Persistence cookie_name can only be set if using 'APP_COOKIE' persistence type")
+		}
+	}
+
+	return persistence, nil
+}
+
 func flattenLBPoolPersistenceV2(p neutronpools.SessionPersistence) []map[string]interface{} {
+	if p.Type == "" {
+		return []map[string]interface{}{}
+	}
+
 	return []map[string]interface{}{
 		{
 			"type":        p.Type,
@@ -1085,27 +1351,109 @@ func flattenLBPoolPersistenceV2(p neutronpools.SessionPersistence) []map[string]
 	}
 }
 
+// lbMembersV2DrainDiffSuppressFunc suppresses the diff of a member, which
+// was drained outside of Terraform, when ignore_drain_changes is enabled. A
+// member is drained, when its weight is set to 0, backup to true or
+// admin_state_up to false, and it doesn't differ from the configured member
+// otherwise. The diff of the other members is kept.
+func lbMembersV2DrainDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	if !d.Get("ignore_drain_changes").(bool) {
+		return false
+	}
+
+	// The diff of a member is keyed by its hash, e.g. member.1234.weight.
+	parts := strings.Split(k, ".")
+	if len(parts) < 3 {
+		return false
+	}
+
+	o, n := d.GetChange("member")
+	oldMembers, newMembers := o.(*schema.Set), n.(*schema.Set)
+
+	for _, oldMember := range oldMembers.List() {
+		for _, newMember := range newMembers.List() {
+			if parts[1] != strconv.Itoa(oldMembers.F(oldMember)) && parts[1] != strconv.Itoa(newMembers.F(newMember)) {
+				continue
+			}
+
+			if lbMembersV2Drained(oldMembers.F, oldMember.(map[string]interface{}), newMember.(map[string]interface{})) {
+				log.Printf("[DEBUG] Ignoring the drained member %s", k)
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// lbMembersV2Drained returns true, when the old member only differs from the
+// new member in its drained weight, backup or admin_state_up.
+func lbMembersV2Drained(hash schema.SchemaSetFunc, oldMember, newMember map[string]interface{}) bool {
+	drained := map[string]interface{}{
+		"weight":         0,
+		"backup":         true,
+		"admin_state_up": false,
+	}
+
+	member := make(map[string]interface{}, len(oldMember))
+	for k, v := range oldMember {
+		member[k] = v
+	}
+
+	for k, v := range drained {
+		if oldMember[k] != newMember[k] && oldMember[k] != v {
+			return false
+		}
+		member[k] = newMember[k]
+	}
+
+	return hash(member) == hash(newMember)
+}
+
 func flattenLBMembersV2(members []octaviapools.Member) []map[string]interface{} {
 	m := make([]map[string]interface{}, len(members))
 
 	for i, member := range members {
+		tags := make([]interface{}, len(member.Tags))
+		for j, tag := range member.Tags {
+			tags[j] = tag
+		}
+
 		m[i] = map[string]interface{}{
-			"name":           member.Name,
-			"weight":         member.Weight,
-			"admin_state_up": member.AdminStateUp,
-			"subnet_id":      member.SubnetID,
-			"address":        member.Address,
-			"protocol_port":  member.ProtocolPort,
-			"id":             member.ID,
-			"backup":         member.Backup,
+			"name":            member.Name,
+			"weight":          member.Weight,
+			"admin_state_up":  member.AdminStateUp,
+			"subnet_id":       member.SubnetID,
+			"address":         member.Address,
+			"protocol_port":   member.ProtocolPort,
+			"id":              member.ID,
+			"backup":          member.Backup,
+			"monitor_address": member.MonitorAddress,
+			"monitor_port":    member.MonitorPort,
+			"tags":            schema.NewSet(schema.HashString, tags),
 		}
 	}
 
 	return m
 }
 
-func expandLBMembersV2(members *schema.Set, lbClient *gophercloud.ServiceClient) []octaviapools.BatchUpdateMemberOpts {
-	var m []octaviapools.BatchUpdateMemberOpts
+// expandLBMembersV2 returns the options of the batch update of the members.
+// The tags of a member, which had tags in oldMembers, are removed with an
+// empty list, when the API supports tags.
+func expandLBMembersV2(members, oldMembers *schema.Set, lbClient *gophercloud.ServiceClient) []BatchUpdateMemberOpts {
+	var m []BatchUpdateMemberOpts
+
+	tagged := make(map[string]bool)
+	if oldMembers != nil {
+		for _, raw := range oldMembers.List() {
+			rawMap := raw.(map[string]interface{})
+			if rawMap["tags"].(*schema.Set).Len() > 0 {
+				tagged[fmt.Sprintf("%s:%d", rawMap["address"], rawMap["protocol_port"])] = true
+			}
+		}
+	}
+
+	var supportsTags *bool
 
 	if members != nil {
 		for _, raw := range members.List() {
@@ -1115,13 +1463,15 @@ func expandLBMembersV2(members *schema.Set, lbClient *gophercloud.ServiceClient)
 			weight := rawMap["weight"].(int)
 			adminStateUp := rawMap["admin_state_up"].(bool)
 
-			member := octaviapools.BatchUpdateMemberOpts{
-				Address:      rawMap["address"].(string),
-				ProtocolPort: rawMap["protocol_port"].(int),
-				Name:         &name,
-				SubnetID:     &subnetID,
-				Weight:       &weight,
-				AdminStateUp: &adminStateUp,
+			member := BatchUpdateMemberOpts{
+				BatchUpdateMemberOpts: octaviapools.BatchUpdateMemberOpts{
+					Address:      rawMap["address"].(string),
+					ProtocolPort: rawMap["protocol_port"].(int),
+					Name:         &name,
+					SubnetID:     &subnetID,
+					Weight:       &weight,
+					AdminStateUp: &adminStateUp,
+				},
 			}
 
 			// backup requires octavia minor version 2.1. Only set when specified
@@ -1130,6 +1480,29 @@ func expandLBMembersV2(members *schema.Set, lbClient *gophercloud.ServiceClient)
 				member.Backup = &backup
 			}
 
+			if monitorAddress := rawMap["monitor_address"].(string); monitorAddress != "" {
+				member.MonitorAddress = &monitorAddress
+			}
+
+			if monitorPort := rawMap["monitor_port"].(int); monitorPort != 0 {
+				member.MonitorPort = &monitorPort
+			}
+
+			// tags requires octavia minor version 2.5. Only set when specified
+			// or when the tags of the member are removed.
+			tags := expandToStringSlice(rawMap["tags"].(*schema.Set).List())
+			if len(tags) > 0 {
+				member.Tags = &tags
+			} else if tagged[fmt.Sprintf("%s:%d", member.Address, member.ProtocolPort)] {
+				if supportsTags == nil {
+					supported := lbV2SupportsTags(lbClient)
+					supportsTags = &supported
+				}
+				if *supportsTags {
+					member.Tags = &tags
+				}
+			}
+
 			m = append(m, member)
 		}
 	}
@@ -1137,6 +1510,21 @@ func expandLBMembersV2(members *schema.Set, lbClient *gophercloud.ServiceClient)
 	return m
 }
 
+// lbV2SupportsTags returns true, when the load balancing API supports tags.
+func lbV2SupportsTags(lbClient *gophercloud.ServiceClient) bool {
+	if lbClient.Type != octaviaLBClientType {
+		log.Printf("[WARN] Skipping tags: they are only supported when using octavia")
+		return false
+	}
+
+	if err := lbV2CheckMinAPIVersion(lbClient, lbV2TagsMinAPIVersion, "tags"); err != nil {
+		log.Printf("[WARN] Skipping tags: %s", err)
+		return false
+	}
+
+	return true
+}
+
 func resourceLoadBalancerV2SetSecurityGroups(networkingClient *gophercloud.ServiceClient, vipPortID string, d *schema.ResourceData) error {
 	if vipPortID != "" {
 		if v, ok := d.GetOk("security_group_ids"); ok {
@@ -1,9 +1,20 @@
 package openstack
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/gophercloud/gophercloud"
+	octaviaapiversions "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/apiversions"
+	octaviapools "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/pools"
+	neutronpools "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/pools"
 )
 
 func TestExpandLBV2ListenerHeadersMap(t *testing.T) {
@@ -34,3 +45,296 @@ func TestExpandLBV2ListenerHeadersMap_err(t *testing.T) {
 	assert.Error(t, err)
 	assert.Empty(t, actual)
 }
+
+func TestLBV2MaxAPIVersion(t *testing.T) {
+	versions := []octaviaapiversions.APIVersion{
+		{ID: "v2.0", Status: "SUPPORTED"},
+		{ID: "v2.9", Status: "SUPPORTED"},
+		{ID: "v2.17", Status: "CURRENT"},
+		{ID: "v2.10", Status: "SUPPORTED"},
+	}
+
+	assert.Equal(t, "2.17", lbV2MaxAPIVersion(versions))
+	assert.Equal(t, "", lbV2MaxAPIVersion(nil))
+}
+
+func TestExpandLBMembersV2(t *testing.T) {
+	membersResource := resourceMembersV2().Schema["member"].Elem.(*schema.Resource)
+	members := schema.NewSet(schema.HashResource(membersResource), []interface{}{
+		map[string]interface{}{
+			"name":            "member_1",
+			"address":         "192.168.199.110",
+			"protocol_port":   8080,
+			"weight":          10,
+			"subnet_id":       "",
+			"admin_state_up":  true,
+			"backup":          false,
+			"monitor_address": "192.168.199.112",
+			"monitor_port":    8081,
+			"tags":            schema.NewSet(schema.HashString, []interface{}{"tag1"}),
+		},
+	})
+
+	name := "member_1"
+	weight := 10
+	subnetID := ""
+	adminStateUp := true
+	backup := false
+	monitorAddress := "192.168.199.112"
+	monitorPort := 8081
+	tags := []string{"tag1"}
+	expected := []BatchUpdateMemberOpts{
+		{
+			BatchUpdateMemberOpts: octaviapools.BatchUpdateMemberOpts{
+				Address:        "192.168.199.110",
+				ProtocolPort:   8080,
+				Name:           &name,
+				Weight:         &weight,
+				SubnetID:       &subnetID,
+				AdminStateUp:   &adminStateUp,
+				Backup:         &backup,
+				MonitorAddress: &monitorAddress,
+				MonitorPort:    &monitorPort,
+			},
+			Tags: &tags,
+		},
+	}
+
+	actual := expandLBMembersV2(members, nil, nil)
+	assert.Equal(t, expected, actual)
+}
+
+func testLBV2VersionsClient(t *testing.T, maxVersion string) *gophercloud.ServiceClient {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"versions": [{"id": "v2.0", "status": "SUPPORTED"}, {"id": "%s", "status": "CURRENT"}]}`, maxVersion)
+	})
+
+	return &gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{},
+		Endpoint:       server.URL + "/v2.0/",
+		Type:           octaviaLBClientType,
+	}
+}
+
+func TestExpandLBMembersV2RemoveTags(t *testing.T) {
+	membersResource := resourceMembersV2().Schema["member"].Elem.(*schema.Resource)
+	newMembers := func(tags ...interface{}) *schema.Set {
+		return schema.NewSet(schema.HashResource(membersResource), []interface{}{
+			map[string]interface{}{
+				"name":            "member_1",
+				"address":         "192.168.199.110",
+				"protocol_port":   8080,
+				"weight":          1,
+				"subnet_id":       "",
+				"admin_state_up":  true,
+				"backup":          false,
+				"monitor_address": "",
+				"monitor_port":    0,
+				"tags":            schema.NewSet(schema.HashString, tags),
+			},
+		})
+	}
+
+	// The tags of the member are removed with an empty list.
+	actual := expandLBMembersV2(newMembers(), newMembers("tag1"), testLBV2VersionsClient(t, "v2.5"))
+	if assert.Len(t, actual, 1) && assert.NotNil(t, actual[0].Tags) {
+		assert.Empty(t, *actual[0].Tags)
+	}
+
+	b, err := actual[0].ToBatchMemberUpdateMap()
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{}, b["tags"])
+
+	// The tags are skipped, when the API doesn't support them.
+	actual = expandLBMembersV2(newMembers(), newMembers("tag1"), testLBV2VersionsClient(t, "v2.4"))
+	if assert.Len(t, actual, 1) {
+		assert.Nil(t, actual[0].Tags)
+	}
+
+	// A member without tags before doesn't send any tags.
+	actual = expandLBMembersV2(newMembers(), newMembers(), nil)
+	if assert.Len(t, actual, 1) {
+		assert.Nil(t, actual[0].Tags)
+	}
+}
+
+func TestResourceMembersV2IgnoreDrainChangesDiff(t *testing.T) {
+	membersResource := resourceMembersV2().Schema["member"].Elem.(*schema.Resource)
+	hash := schema.HashResource(membersResource)
+
+	newMember := func(weight int, backup, adminStateUp bool) map[string]interface{} {
+		return map[string]interface{}{
+			"name":            "",
+			"address":         "192.168.199.110",
+			"protocol_port":   8080,
+			"weight":          weight,
+			"subnet_id":       "",
+			"backup":          backup,
+			"admin_state_up":  adminStateUp,
+			"monitor_address": "",
+			"monitor_port":    0,
+			"tags":            schema.NewSet(schema.HashString, nil),
+		}
+	}
+
+	newState := func(member map[string]interface{}) *terraform.InstanceState {
+		prefix := fmt.Sprintf("member.%d.", hash(member))
+		return &terraform.InstanceState{
+			ID: "pool-1",
+			Attributes: map[string]string{
+				"id":                      "pool-1",
+				"pool_id":                 "pool-1",
+				"ignore_drain_changes":    "true",
+				"member.#":                "1",
+				prefix + "id":             "member-1",
+				prefix + "address":        "192.168.199.110",
+				prefix + "protocol_port":  "8080",
+				prefix + "weight":         fmt.Sprint(member["weight"]),
+				prefix + "backup":         fmt.Sprint(member["backup"]),
+				prefix + "admin_state_up": fmt.Sprint(member["admin_state_up"]),
+				prefix + "monitor_port":   "0",
+				prefix + "tags.#":         "0",
+			},
+		}
+	}
+
+	newConfig := func(ignore bool, members ...map[string]interface{}) *terraform.ResourceConfig {
+		rawMembers := make([]interface{}, len(members))
+		for i, member := range members {
+			rawMembers[i] = map[string]interface{}{
+				"address":        member["address"],
+				"protocol_port":  member["protocol_port"],
+				"weight":         member["weight"],
+				"backup":         member["backup"],
+				"admin_state_up": member["admin_state_up"],
+			}
+		}
+
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"pool_id":              "pool-1",
+			"ignore_drain_changes": ignore,
+			"member":               rawMembers,
+		})
+	}
+
+	r := resourceMembersV2()
+	drained := newMember(0, true, false)
+	active := newMember(10, false, true)
+
+	// A member drained outside of Terraform is not reverted.
+	diff, err := r.Diff(context.Background(), newState(drained), newConfig(true, active), nil)
+	assert.NoError(t, err)
+	assert.Nil(t, diff)
+
+	// Without ignore_drain_changes the drained member is reverted.
+	diff, err = r.Diff(context.Background(), newState(drained), newConfig(false, active), nil)
+	assert.NoError(t, err)
+	if assert.NotNil(t, diff) {
+		prefix := fmt.Sprintf("member.%d.", hash(active))
+		assert.Equal(t, "10", diff.Attributes[prefix+"weight"].New)
+	}
+
+	// A new member is added, while the drained member is kept.
+	other := newMember(10, false, true)
+	other["address"] = "192.168.199.111"
+	diff, err = r.Diff(context.Background(), newState(drained), newConfig(true, active, other), nil)
+	assert.NoError(t, err)
+	if assert.NotNil(t, diff) {
+		assert.Equal(t, "2", diff.Attributes["member.#"].New)
+		assert.NotNil(t, diff.Attributes[fmt.Sprintf("member.%d.address", hash(other))])
+		assert.Nil(t, diff.Attributes[fmt.Sprintf("member.%d.weight", hash(active))])
+		assert.Nil(t, diff.Attributes[fmt.Sprintf("member.%d.weight", hash(drained))])
+	}
+
+	// Draining the member in the configuration is applied.
+	diff, err = r.Diff(context.Background(), newState(active), newConfig(true, drained), nil)
+	assert.NoError(t, err)
+	if assert.NotNil(t, diff) {
+		prefix := fmt.Sprintf("member.%d.", hash(drained))
+		assert.Equal(t, "0", diff.Attributes[prefix+"weight"].New)
+		assert.Equal(t, "true", diff.Attributes[prefix+"backup"].New)
+		assert.Equal(t, "false", diff.Attributes[prefix+"admin_state_up"].New)
+	}
+
+	// Other changes of the configuration are applied.
+	diff, err = r.Diff(context.Background(), newState(active), newConfig(true, newMember(20, false, true)), nil)
+	assert.NoError(t, err)
+	if assert.NotNil(t, diff) {
+		prefix := fmt.Sprintf("member.%d.", hash(newMember(20, false, true)))
+		assert.Equal(t, "20", diff.Attributes[prefix+"weight"].New)
+	}
+}
+
+func TestExpandLBPoolPersistenceV2(t *testing.T) {
+	raw := []interface{}{
+		map[string]interface{}{
+			"type":        "APP_COOKIE",
+			"cookie_name": "session",
+		},
+	}
+
+	expected := neutronpools.SessionPersistence{
+		Type:       "APP_COOKIE",
+		CookieName: "session",
+	}
+
+	actual, err := expandLBPoolPersistenceV2(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+
+	actual, err = expandLBPoolPersistenceV2([]interface{}{})
+	assert.NoError(t, err)
+	assert.Equal(t, neutronpools.SessionPersistence{}, actual)
+}
+
+func TestExpandLBPoolPersistenceV2_err(t *testing.T) {
+	raw := []interface{}{
+		map[string]interface{}{
+			"type":        "APP_COOKIE",
+			"cookie_name": "",
+		},
+	}
+
+	_, err := expandLBPoolPersistenceV2(raw)
+	assert.Error(t, err)
+
+	raw = []interface{}{
+		map[string]interface{}{
+			"type":        "SOURCE_IP",
+			"cookie_name": "session",
+		},
+	}
+
+	_, err = expandLBPoolPersistenceV2(raw)
+	assert.Error(t, err)
+}
+
+func TestFlattenLBPoolPersistenceV2(t *testing.T) {
+	expected := []map[string]interface{}{
+		{
+			"type":        "HTTP_COOKIE",
+			"cookie_name": "",
+		},
+	}
+
+	assert.Equal(t, expected, flattenLBPoolPersistenceV2(neutronpools.SessionPersistence{Type: "HTTP_COOKIE"}))
+	assert.Empty(t, flattenLBPoolPersistenceV2(neutronpools.SessionPersistence{}))
+}
+
+func TestLBV2SetTags(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceLoadBalancerV2().Schema, map[string]interface{}{
+		"tags": []interface{}{"web", "env=dev"},
+	})
+
+	lbV2SetTags(d, []string{"web", "env=dev", "cost-center=1234", "external"}, []string{"cost-center=1234", "env=dev"})
+
+	expected := []string{"env=dev", "external", "web"}
+	actual := expandToStringSlice(d.Get("tags").(*schema.Set).List())
+	assert.ElementsMatch(t, expected, actual)
+}
@@ -0,0 +1,198 @@
+package openstack
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceDatabaseInstanceV1V0 is the schema of openstack_db_instance_v1
+// before addresses became a list of address blocks.
+func resourceDatabaseInstanceV1V0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"flavor_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"size": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"datastore": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"version": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"network": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uuid": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"port": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"fixed_ip_v4": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"fixed_ip_v6": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"database": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"charset": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"collate": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"user": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"password": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"host": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"databases": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"configuration_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"restore_point": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backup_ref": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"replica_of": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"replica_count": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"promote_to_replica_source": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"replicas": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// resourceDatabaseInstanceV1StateUpgradeV0 converts the addresses into
+// address blocks. Their type is unknown until the next read.
+func resourceDatabaseInstanceV1StateUpgradeV0(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	rawAddresses, _ := rawState["addresses"].([]interface{})
+
+	addresses := make([]interface{}, 0, len(rawAddresses))
+	for _, raw := range rawAddresses {
+		address, ok := raw.(string)
+		if !ok {
+			continue
+		}
+
+		addresses = append(addresses, map[string]interface{}{
+			"address": address,
+			"type":    "",
+		})
+	}
+
+	rawState["addresses"] = addresses
+
+	return rawState, nil
+}
@@ -0,0 +1,102 @@
+package openstack
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceDNSRecordSetV2V0 is the schema of openstack_dns_recordset_v2 before
+// records became a set.
+func resourceDNSRecordSetV2V0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"records": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"ttl": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"value_specs": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"disable_status_check": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"zone_share": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// resourceDNSRecordSetV2StateUpgradeV0 drops records from the list, which
+// only differ from a previous one in their formatting, since the set treats
+// them as the same record.
+func resourceDNSRecordSetV2StateUpgradeV0(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	rawRecords, _ := rawState["records"].([]interface{})
+
+	seen := make(map[string]bool, len(rawRecords))
+	records := make([]interface{}, 0, len(rawRecords))
+	for _, raw := range rawRecords {
+		record, ok := raw.(string)
+		if !ok {
+			continue
+		}
+
+		normalized := dnsRecordSetV2CanonicalRecord(record)
+		if seen[normalized] {
+			continue
+		}
+		seen[normalized] = true
+		records = append(records, record)
+	}
+
+	rawState["records"] = records
+
+	return rawState, nil
+}
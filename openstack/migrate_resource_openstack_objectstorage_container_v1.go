@@ -0,0 +1,140 @@
+package openstack
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceObjectStorageContainerV1V0 is the schema of
+// openstack_objectstorage_container_v1 before versioning became a boolean.
+func resourceObjectStorageContainerV1V0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"container_read": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"read_acl": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"referrers": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"users": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"rlistings": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"container_sync_to": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"container_sync_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"container_write": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"write_acl": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"users": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"content_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"versioning": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"location": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"quota_bytes": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"quota_count": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"temp_url_key": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"temp_url_key_2": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+		},
+	}
+}
+
+// resourceObjectStorageContainerV1StateUpgradeV0 moves the legacy versioning
+// block to versioning_legacy, since versioning now enables the object
+// versioning of Swift.
+func resourceObjectStorageContainerV1StateUpgradeV0(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	versioningLegacy := []interface{}{}
+	if v, ok := rawState["versioning"].([]interface{}); ok {
+		versioningLegacy = v
+	}
+
+	rawState["versioning_legacy"] = versioningLegacy
+	rawState["versioning"] = false
+
+	return rawState, nil
+}
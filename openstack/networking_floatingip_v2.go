@@ -2,12 +2,16 @@ package openstack
 
 import (
 	"fmt"
+	"net"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/dns"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
 )
 
 type floatingIPExtended struct {
@@ -55,3 +59,40 @@ func networkingFloatingIPV2StateRefreshFunc(client *gophercloud.ServiceClient, f
 		return fip, fip.Status, nil
 	}
 }
+
+// networkingFloatingIPV2FixedIPConfigured reports whether fixed_ip is set in
+// the configuration. The computed fixed_ip of a previous port mustn't be sent
+// when the port changes.
+func networkingFloatingIPV2FixedIPConfigured(d *schema.ResourceData) bool {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return false
+	}
+
+	return !rawConfig.GetAttr("fixed_ip").IsNull()
+}
+
+// networkingFloatingIPV2ValidateFixedIP ensures that fixedIP is one of the
+// fixed IPs of the port, so that the floating IP isn't associated with another
+// fixed IP of a port with several ones.
+func networkingFloatingIPV2ValidateFixedIP(client *gophercloud.ServiceClient, portID, fixedIP string) error {
+	port, err := ports.Get(client, portID).Extract()
+	if err != nil {
+		return fmt.Errorf("Unable to get port %s to validate fixed_ip %s: %s", portID, fixedIP, err)
+	}
+
+	ip := net.ParseIP(fixedIP)
+	candidates := make([]string, 0, len(port.FixedIPs))
+	for _, v := range port.FixedIPs {
+		if v.IPAddress == fixedIP || (ip != nil && ip.Equal(net.ParseIP(v.IPAddress))) {
+			return nil
+		}
+		candidates = append(candidates, fmt.Sprintf("%s (subnet %s)", v.IPAddress, v.SubnetID))
+	}
+
+	if len(candidates) == 0 {
+		return fmt.Errorf("fixed_ip %s can't be used, because port %s has no fixed IPs", fixedIP, portID)
+	}
+
+	return fmt.Errorf("fixed_ip %s isn't a fixed IP of port %s, use one of: %s", fixedIP, portID, strings.Join(candidates, ", "))
+}
@@ -0,0 +1,188 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestNetworkingFloatingIPV2UpdateTimeout(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/floatingips/fip-1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"floatingip": {"id": "fip-1", "description": "fip_2", "status": "PENDING_UPDATE"}}`)
+	})
+
+	config := testAccUnitConfig("network")
+
+	state := &terraform.InstanceState{
+		ID:         "fip-1",
+		Attributes: map[string]string{"description": "fip_1"},
+	}
+	diff := &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"description": {Old: "fip_1", New: "fip_2"},
+		},
+	}
+	timeouts := &schema.ResourceTimeout{Update: schema.DefaultTimeout(time.Second)}
+	assert.NoError(t, timeouts.DiffEncode(diff))
+
+	start := time.Now()
+	_, diags := resourceNetworkingFloatingIPV2().Apply(context.Background(), state, diff, config)
+
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "to become available")
+	assert.Less(t, int64(time.Since(start)), int64(5*time.Second))
+}
+
+func TestNetworkingFloatingIPV2Import(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/floatingips", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+
+		switch r.URL.Query().Get("floating_ip_address") {
+		case "192.0.2.10":
+			fmt.Fprint(w, `{"floatingips": [{"id": "fip-1", "floating_ip_address": "192.0.2.10"}]}`)
+		case "2001:db8::10":
+			fmt.Fprint(w, `{"floatingips": [{"id": "fip-2", "floating_ip_address": "2001:db8::10"}]}`)
+		case "192.0.2.20":
+			fmt.Fprint(w, `{"floatingips": [{"id": "fip-3"}, {"id": "fip-4"}]}`)
+		default:
+			fmt.Fprint(w, `{"floatingips": []}`)
+		}
+	})
+
+	config := testAccUnitConfig("network")
+
+	testCases := []struct {
+		id       string
+		expected string
+		err      string
+	}{
+		{"fip-0", "fip-0", ""},
+		{"192.0.2.10", "fip-1", ""},
+		{"2001:db8::10", "fip-2", ""},
+		{"192.0.2.20", "", "there are more than one openstack_networking_floatingip_v2 with 192.0.2.20 IP"},
+		{"192.0.2.30", "", "there are no openstack_networking_floatingip_v2 with 192.0.2.30 IP"},
+	}
+
+	for _, tc := range testCases {
+		d := resourceNetworkingFloatingIPV2().TestResourceData()
+		d.SetId(tc.id)
+
+		result, err := resourceNetworkFloatingIPV2Import(context.Background(), d, config)
+		if tc.err != "" {
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), tc.err)
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, tc.expected, result[0].Id())
+	}
+}
+
+func TestNetworkingFloatingIPV2ValidateFixedIP(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/ports/port-1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"port": {"id": "port-1", "fixed_ips": [{"subnet_id": "subnet-1", "ip_address": "192.168.199.10"}, {"subnet_id": "subnet-2", "ip_address": "2001:db8::10"}]}}`)
+	})
+
+	client := thclient.ServiceClient()
+
+	assert.NoError(t, networkingFloatingIPV2ValidateFixedIP(client, "port-1", "192.168.199.10"))
+	assert.NoError(t, networkingFloatingIPV2ValidateFixedIP(client, "port-1", "2001:db8:0::10"))
+
+	err := networkingFloatingIPV2ValidateFixedIP(client, "port-1", "192.168.199.11")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "use one of: 192.168.199.10 (subnet subnet-1), 2001:db8::10 (subnet subnet-2)")
+	}
+}
+
+func TestNetworkingFloatingIPV2UpdatePortFixedIP(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/ports/port-2", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"port": {"id": "port-2", "fixed_ips": [{"subnet_id": "subnet-1", "ip_address": "192.168.199.20"}, {"subnet_id": "subnet-1", "ip_address": "192.168.199.21"}]}}`)
+	})
+
+	var updates []string
+	th.Mux.HandleFunc("/floatingips/fip-1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+
+		if r.Method == "PUT" {
+			body, err := ioutil.ReadAll(r.Body)
+			assert.NoError(t, err)
+			updates = append(updates, string(body))
+		}
+
+		fmt.Fprint(w, `{"floatingip": {"id": "fip-1", "floating_network_id": "net-1", "port_id": "port-2", "fixed_ip_address": "192.168.199.21", "status": "ACTIVE"}}`)
+	})
+
+	th.Mux.HandleFunc("/networks", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"networks": [{"id": "net-1", "name": "public"}]}`)
+	})
+
+	config := testAccUnitConfig("network")
+
+	state := &terraform.InstanceState{
+		ID: "fip-1",
+		Attributes: map[string]string{
+			"port_id":  "port-1",
+			"fixed_ip": "192.168.199.21",
+		},
+	}
+	diff := &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"port_id": {Old: "port-1", New: "port-2"},
+		},
+		RawConfig: cty.ObjectVal(map[string]cty.Value{
+			"port_id":  cty.StringVal("port-2"),
+			"fixed_ip": cty.StringVal("192.168.199.21"),
+		}),
+	}
+
+	_, diags := resourceNetworkingFloatingIPV2().Apply(context.Background(), state, diff, config)
+
+	assert.False(t, diags.HasError())
+	if assert.Len(t, updates, 1) {
+		assert.JSONEq(t, `{"floatingip": {"port_id": "port-2", "fixed_ip_address": "192.168.199.21"}}`, updates[0])
+	}
+
+	diff.Attributes["fixed_ip"] = &terraform.ResourceAttrDiff{Old: "192.168.199.21", New: "192.168.199.30"}
+	diff.RawConfig = cty.ObjectVal(map[string]cty.Value{
+		"port_id":  cty.StringVal("port-2"),
+		"fixed_ip": cty.StringVal("192.168.199.30"),
+	})
+
+	_, diags = resourceNetworkingFloatingIPV2().Apply(context.Background(), state, diff, config)
+
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "fixed_ip 192.168.199.30 isn't a fixed IP of port port-2, use one of: 192.168.199.20 (subnet subnet-1), 192.168.199.21 (subnet subnet-1)")
+	assert.Len(t, updates, 1)
+}
@@ -1,6 +1,9 @@
 package openstack
 
 import (
+	"context"
+	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -9,6 +12,7 @@ import (
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/extradhcpopts"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
+	th "github.com/gophercloud/gophercloud/testhelper"
 )
 
 func TestExpandNetworkingPortDHCPOptsV2Create(t *testing.T) {
@@ -273,3 +277,56 @@ func TestExpandNetworkingPortFixedIPV2SomeFixedIPs(t *testing.T) {
 
 	assert.ElementsMatch(t, expectedFixedIP, actualFixedIP)
 }
+
+func TestDataSourceNetworkingPortV2FixedIPSubnetID(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/ports", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"ports": [
+			{"id": "port-1", "fixed_ips": [{"subnet_id": "subnet-1", "ip_address": "10.0.0.5"}, {"subnet_id": "subnet-2", "ip_address": "10.0.1.5"}]},
+			{"id": "port-2", "fixed_ips": [{"subnet_id": "subnet-3", "ip_address": "10.0.0.5"}],
+			 "dns_assignment": [{"hostname": "host-2", "ip_address": "10.0.0.5", "fqdn": "host-2.example.com."}]}
+		]}`)
+	})
+
+	config := testAccUnitConfig("network")
+
+	testCases := []struct {
+		fixedIP  string
+		subnetID string
+		expected string
+		err      string
+	}{
+		{"10.0.0.5", "", "", "More than one openstack_networking_port_v2 found (2)"},
+		{"10.0.0.5", "subnet-3", "port-2", ""},
+		{"10.0.0.5", "subnet-2", "", "No openstack_networking_port_v2 found"},
+		{"", "subnet-1", "port-1", ""},
+	}
+
+	for _, tc := range testCases {
+		d := dataSourceNetworkingPortV2().TestResourceData()
+		d.Set("fixed_ip", tc.fixedIP)
+		d.Set("fixed_ip_subnet_id", tc.subnetID)
+
+		diags := dataSourceNetworkingPortV2Read(context.Background(), d, config)
+		if tc.err != "" {
+			if assert.True(t, diags.HasError(), "%s %s", tc.fixedIP, tc.subnetID) {
+				assert.Equal(t, tc.err, diags[0].Summary)
+			}
+			continue
+		}
+
+		assert.False(t, diags.HasError(), "%v", diags)
+		assert.Equal(t, tc.expected, d.Id())
+	}
+
+	d := dataSourceNetworkingPortV2().TestResourceData()
+	d.Set("fixed_ip_subnet_id", "subnet-3")
+	assert.False(t, dataSourceNetworkingPortV2Read(context.Background(), d, config).HasError())
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"hostname": "host-2", "ip_address": "10.0.0.5", "fqdn": "host-2.example.com."},
+	}, d.Get("dns_assignment"))
+}
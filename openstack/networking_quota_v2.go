@@ -0,0 +1,35 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/quotas"
+)
+
+// networkingQuotaV2Details maps the quota details to the names of the quota
+// attributes.
+func networkingQuotaV2Details(q *quotas.QuotaDetailSet) map[string]quotas.QuotaDetail {
+	return map[string]quotas.QuotaDetail{
+		"floatingip":          q.FloatingIP,
+		"network":             q.Network,
+		"port":                q.Port,
+		"rbac_policy":         q.RBACPolicy,
+		"router":              q.Router,
+		"security_group":      q.SecurityGroup,
+		"security_group_rule": q.SecurityGroupRule,
+		"subnet":              q.Subnet,
+		"subnetpool":          q.SubnetPool,
+		"trunk":               q.Trunk,
+	}
+}
+
+// networkingQuotaV2Usage returns the used and reserved number of resources
+// for each quota.
+func networkingQuotaV2Usage(details map[string]quotas.QuotaDetail) (map[string]int, map[string]int) {
+	used := make(map[string]int, len(details))
+	reserved := make(map[string]int, len(details))
+	for name, detail := range details {
+		used[name] = detail.Used
+		reserved[name] = detail.Reserved
+	}
+
+	return used, reserved
+}
@@ -0,0 +1,28 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/quotas"
+)
+
+func TestNetworkingQuotaV2Usage(t *testing.T) {
+	details := networkingQuotaV2Details(&quotas.QuotaDetailSet{
+		Port:  quotas.QuotaDetail{Limit: 50, Used: 10, Reserved: 2},
+		Trunk: quotas.QuotaDetail{Limit: -1, Used: 1},
+	})
+
+	assert.Len(t, details, 10)
+	assert.Equal(t, 50, details["port"].Limit)
+	assert.Equal(t, -1, details["trunk"].Limit)
+
+	used, reserved := networkingQuotaV2Usage(details)
+	assert.Equal(t, 10, used["port"])
+	assert.Equal(t, 2, reserved["port"])
+	assert.Equal(t, 1, used["trunk"])
+	assert.Equal(t, 0, used["network"])
+	assert.Len(t, used, 10)
+	assert.Len(t, reserved, 10)
+}
@@ -1,9 +1,13 @@
 package openstack
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
 )
 
@@ -61,3 +65,36 @@ func flattenNetworkingRouterExternalFixedIPsV2(externalFixedIPs []routers.Extern
 
 	return fixedIPs
 }
+
+// networkingRouterV2EnableSNATConfigured reports whether enable_snat is set in
+// the configuration, as opposed to being only computed from the current state.
+func networkingRouterV2EnableSNATConfigured(d *schema.ResourceData) bool {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return false
+	}
+
+	return !rawConfig.GetAttr("enable_snat").IsNull()
+}
+
+// networkingRouterV2GatewayFloatingIPs returns the floating IPs, which are
+// associated through the router and prevent its external gateway from being
+// cleared.
+func networkingRouterV2GatewayFloatingIPs(client *gophercloud.ServiceClient, routerID string) ([]string, error) {
+	allPages, err := floatingips.List(client, floatingips.ListOpts{RouterID: routerID}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	allFIPs, err := floatingips.ExtractFloatingIPs(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	fips := make([]string, len(allFIPs))
+	for i, fip := range allFIPs {
+		fips[i] = fmt.Sprintf("%s (%s)", fip.FloatingIP, fip.ID)
+	}
+
+	return fips, nil
+}
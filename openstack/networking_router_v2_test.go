@@ -1,11 +1,20 @@
 package openstack
 
 import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
+	th "github.com/gophercloud/gophercloud/testhelper"
 )
 
 func TestExpandNetworkingRouterExternalFixedIPsV2(t *testing.T) {
@@ -66,3 +75,120 @@ func TestFlattenNetworkingRouterExternalFixedIPsV2(t *testing.T) {
 
 	assert.ElementsMatch(t, expectedExternalFixedIPs, actualExternalFixedIPs)
 }
+
+func TestNetworkingRouterV2UpdateTimeout(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/routers/router-1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"router": {"id": "router-1", "name": "router_2", "status": "PENDING_UPDATE"}}`)
+	})
+
+	config := testAccUnitConfig("network")
+
+	state := &terraform.InstanceState{
+		ID:         "router-1",
+		Attributes: map[string]string{"name": "router_1"},
+	}
+	diff := &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"name": {Old: "router_1", New: "router_2"},
+		},
+	}
+	timeouts := &schema.ResourceTimeout{Update: schema.DefaultTimeout(time.Second)}
+	assert.NoError(t, timeouts.DiffEncode(diff))
+
+	start := time.Now()
+	_, diags := resourceNetworkingRouterV2().Apply(context.Background(), state, diff, config)
+
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "to become available")
+	assert.Less(t, int64(time.Since(start)), int64(5*time.Second))
+}
+
+func TestNetworkingRouterV2UpdateExternalNetwork(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var updates []string
+	th.Mux.HandleFunc("/routers/router-1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+
+		if r.Method == "PUT" {
+			body, err := ioutil.ReadAll(r.Body)
+			assert.NoError(t, err)
+			updates = append(updates, string(body))
+		}
+
+		// Neutron resets enable_snat to true.
+		fmt.Fprint(w, `{"router": {"id": "router-1", "status": "ACTIVE", "external_gateway_info": {"network_id": "net-2", "enable_snat": true}}}`)
+	})
+
+	th.Mux.HandleFunc("/floatingips", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestFormValues(t, r, map[string]string{"router_id": "router-1"})
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"floatingips": []}`)
+	})
+
+	config := testAccUnitConfig("network")
+
+	state := &terraform.InstanceState{
+		ID: "router-1",
+		Attributes: map[string]string{
+			"external_network_id": "net-1",
+			"enable_snat":         "false",
+		},
+	}
+	diff := &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"external_network_id": {Old: "net-1", New: "net-2"},
+		},
+		RawConfig: cty.ObjectVal(map[string]cty.Value{
+			"external_network_id": cty.StringVal("net-2"),
+			"enable_snat":         cty.False,
+		}),
+	}
+
+	_, diags := resourceNetworkingRouterV2().Apply(context.Background(), state, diff, config)
+
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "enable_snat was set to true instead of false")
+	if assert.Len(t, updates, 2) {
+		assert.JSONEq(t, `{"router": {"external_gateway_info": {}}}`, updates[0])
+		assert.JSONEq(t, `{"router": {"external_gateway_info": {"network_id": "net-2", "enable_snat": false}}}`, updates[1])
+	}
+}
+
+func TestNetworkingRouterV2UpdateExternalNetworkFloatingIPs(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/routers/router-1", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected %s request", r.Method)
+	})
+
+	th.Mux.HandleFunc("/floatingips", func(w http.ResponseWriter, r *http.Request) {
+		th.TestFormValues(t, r, map[string]string{"router_id": "router-1"})
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"floatingips": [{"id": "fip-1", "floating_ip_address": "192.0.2.10"}, {"id": "fip-2", "floating_ip_address": "192.0.2.11"}]}`)
+	})
+
+	config := testAccUnitConfig("network")
+
+	state := &terraform.InstanceState{
+		ID:         "router-1",
+		Attributes: map[string]string{"external_network_id": "net-1"},
+	}
+	diff := &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"external_network_id": {Old: "net-1", New: "net-2"},
+		},
+	}
+
+	_, diags := resourceNetworkingRouterV2().Apply(context.Background(), state, diff, config)
+
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "used by the floating IPs: 192.0.2.10 (fip-1), 192.0.2.11 (fip-2)")
+}
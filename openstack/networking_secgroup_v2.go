@@ -1,12 +1,14 @@
 package openstack
 
 import (
+	"fmt"
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/groups"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/rules"
 )
 
 // networkingSecgroupV2StateRefreshFuncDelete returns a special case resource.StateRefreshFunc to try to delete a secgroup.
@@ -42,3 +44,72 @@ func networkingSecgroupV2StateRefreshFuncDelete(networkingClient *gophercloud.Se
 		return r, "ACTIVE", nil
 	}
 }
+
+// networkingSecgroupV2StateRefreshFuncDeleteDefaultRules returns a resource.StateRefreshFunc
+// which deletes all rules of a secgroup and reports whether the secgroup was empty.
+func networkingSecgroupV2StateRefreshFuncDeleteDefaultRules(networkingClient *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		sg, err := groups.Get(networkingClient, id).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		if len(sg.Rules) == 0 {
+			return sg, "EMPTY", nil
+		}
+
+		for _, rule := range sg.Rules {
+			log.Printf("[DEBUG] Deleting default rule %s of openstack_networking_secgroup_v2 %s", rule.ID, id)
+			err := rules.Delete(networkingClient, rule.ID).ExtractErr()
+			if err != nil {
+				if _, ok := err.(gophercloud.ErrDefault404); ok {
+					continue
+				}
+
+				return nil, "", err
+			}
+		}
+
+		return sg, "PENDING", nil
+	}
+}
+
+func expandNetworkingSecGroupV2ReplaceDefaultRules(rawRules []interface{}) ([]rules.CreateOpts, error) {
+	opts := make([]rules.CreateOpts, len(rawRules))
+
+	for i, raw := range rawRules {
+		rawRule := raw.(map[string]interface{})
+
+		direction, err := resourceNetworkingSecGroupRuleV2Direction(rawRule["direction"].(string))
+		if err != nil {
+			return nil, err
+		}
+
+		ethertype, err := resourceNetworkingSecGroupRuleV2EtherType(rawRule["ethertype"].(string))
+		if err != nil {
+			return nil, err
+		}
+
+		opts[i] = rules.CreateOpts{
+			Description:    rawRule["description"].(string),
+			Direction:      direction,
+			EtherType:      ethertype,
+			PortRangeMin:   rawRule["port_range_min"].(int),
+			PortRangeMax:   rawRule["port_range_max"].(int),
+			RemoteGroupID:  rawRule["remote_group_id"].(string),
+			RemoteIPPrefix: rawRule["remote_ip_prefix"].(string),
+		}
+
+		if v := rawRule["protocol"].(string); v != "" {
+			protocol, err := resourceNetworkingSecGroupRuleV2Protocol(v)
+			if err != nil {
+				return nil, err
+			}
+			opts[i].Protocol = protocol
+		} else if opts[i].PortRangeMin != 0 || opts[i].PortRangeMax != 0 {
+			return nil, fmt.Errorf("A protocol must be specified when using port_range_min and port_range_max in replace_default_rules")
+		}
+	}
+
+	return opts, nil
+}
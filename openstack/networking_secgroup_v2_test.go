@@ -0,0 +1,142 @@
+package openstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestNetworkingSecGroupV2DeleteDefaultRulesDelayed(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var mu sync.Mutex
+	var gets int
+	var deleted, created []string
+	rules := []string{}
+
+	th.Mux.HandleFunc("/security-groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		mu.Lock()
+		defer mu.Unlock()
+
+		// The default rules are created asynchronously.
+		rules = []string{}
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"security_group": {"id": "sg-1", "name": "sg_1", "tenant_id": "project-1"}}`)
+	})
+
+	th.Mux.HandleFunc("/security-groups/sg-1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		mu.Lock()
+		defer mu.Unlock()
+
+		gets++
+		if gets == 2 {
+			rules = append(rules, "default-egress-v4", "default-egress-v6")
+		}
+
+		ruleList := make([]string, len(rules))
+		for i, rule := range rules {
+			ruleList[i] = fmt.Sprintf(`{"id": "%s", "security_group_id": "sg-1"}`, rule)
+		}
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"security_group": {"id": "sg-1", "name": "sg_1", "tenant_id": "project-1", "security_group_rules": [%s]}}`, strings.Join(ruleList, ","))
+	})
+
+	th.Mux.HandleFunc("/security-group-rules/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		mu.Lock()
+		defer mu.Unlock()
+
+		id := strings.TrimPrefix(r.URL.Path, "/security-group-rules/")
+		deleted = append(deleted, id)
+		for i, rule := range rules {
+			if rule == id {
+				rules = append(rules[:i], rules[i+1:]...)
+				break
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	th.Mux.HandleFunc("/security-group-rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		mu.Lock()
+		defer mu.Unlock()
+
+		var body struct {
+			Rule map[string]interface{} `json:"security_group_rule"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "sg-1", body.Rule["security_group_id"])
+		created = append(created, fmt.Sprintf("%s/%s/%v", body.Rule["direction"], body.Rule["protocol"], body.Rule["port_range_min"]))
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"security_group_rule": {"id": "rule-1"}}`)
+	})
+
+	config := testAccUnitConfig("network")
+
+	d := schema.TestResourceDataRaw(t, resourceNetworkingSecGroupV2().Schema, map[string]interface{}{
+		"name": "sg_1",
+		"replace_default_rules": []interface{}{
+			map[string]interface{}{
+				"direction":        "ingress",
+				"ethertype":        "IPv4",
+				"protocol":         "tcp",
+				"port_range_min":   22,
+				"port_range_max":   22,
+				"remote_ip_prefix": "192.0.2.0/24",
+			},
+		},
+	})
+
+	diags := resourceNetworkingSecGroupV2Create(context.Background(), d, config)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "sg-1", d.Id())
+	assert.Equal(t, []string{"default-egress-v4", "default-egress-v6"}, deleted)
+	assert.Equal(t, []string{"ingress/tcp/22"}, created)
+}
+
+func TestExpandNetworkingSecGroupV2ReplaceDefaultRules(t *testing.T) {
+	_, err := expandNetworkingSecGroupV2ReplaceDefaultRules([]interface{}{
+		map[string]interface{}{
+			"description":      "",
+			"direction":        "ingress",
+			"ethertype":        "IPv4",
+			"protocol":         "",
+			"port_range_min":   22,
+			"port_range_max":   22,
+			"remote_group_id":  "",
+			"remote_ip_prefix": "",
+		},
+	})
+	assert.Error(t, err)
+
+	_, err = expandNetworkingSecGroupV2ReplaceDefaultRules([]interface{}{
+		map[string]interface{}{
+			"description":      "",
+			"direction":        "sideways",
+			"ethertype":        "IPv4",
+			"protocol":         "",
+			"port_range_min":   0,
+			"port_range_max":   0,
+			"remote_group_id":  "",
+			"remote_ip_prefix": "",
+		},
+	})
+	assert.Error(t, err)
+}
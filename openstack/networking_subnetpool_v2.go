@@ -1,7 +1,11 @@
 package openstack
 
 import (
+	"fmt"
+	"net"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/subnetpools"
@@ -24,3 +28,75 @@ func networkingSubnetpoolV2StateRefreshFunc(client *gophercloud.ServiceClient, i
 		return subnetpool, "ACTIVE", nil
 	}
 }
+
+// networkingSubnetPoolV2PrefixesCustomizeDiff ensures that the prefixes of an
+// existing subnetpool are only added or extended. Neutron rejects an update,
+// which doesn't cover all the address space of the current prefixes.
+func networkingSubnetPoolV2PrefixesCustomizeDiff(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" || !diff.HasChange("prefixes") || !diff.NewValueKnown("prefixes") {
+		return nil
+	}
+
+	o, n := diff.GetChange("prefixes")
+
+	var newPrefixes []*net.IPNet
+	for _, v := range expandToStringSlice(n.([]interface{})) {
+		_, prefix, err := net.ParseCIDR(v)
+		if err != nil {
+			// Unknown or invalid values are left to the Neutron API.
+			return nil
+		}
+		newPrefixes = append(newPrefixes, prefix)
+	}
+
+	for _, v := range expandToStringSlice(o.([]interface{})) {
+		_, prefix, err := net.ParseCIDR(v)
+		if err != nil {
+			continue
+		}
+		if !networkingSubnetPoolV2PrefixCovered(prefix, newPrefixes) {
+			return fmt.Errorf("prefix %s can't be removed from openstack_networking_subnetpool_v2 %s: prefixes can only be added or extended", v, diff.Id())
+		}
+	}
+
+	return nil
+}
+
+// networkingSubnetPoolV2PrefixCovered reports whether the address space of
+// prefix is covered by prefixes, either by a single one or by several more
+// specific ones.
+func networkingSubnetPoolV2PrefixCovered(prefix *net.IPNet, prefixes []*net.IPNet) bool {
+	ones, bits := prefix.Mask.Size()
+
+	var overlaps bool
+	for _, p := range prefixes {
+		pOnes, pBits := p.Mask.Size()
+		if pBits != bits {
+			continue
+		}
+		if pOnes <= ones && p.Contains(prefix.IP) {
+			return true
+		}
+		if pOnes > ones && prefix.Contains(p.IP) {
+			overlaps = true
+		}
+	}
+
+	if !overlaps {
+		return false
+	}
+
+	// Split the prefix in halves and check them separately.
+	lower := &net.IPNet{
+		IP:   prefix.IP,
+		Mask: net.CIDRMask(ones+1, bits),
+	}
+	upper := &net.IPNet{
+		IP:   append(net.IP{}, prefix.IP...),
+		Mask: net.CIDRMask(ones+1, bits),
+	}
+	upper.IP[ones/8] |= 0x80 >> uint(ones%8)
+
+	return networkingSubnetPoolV2PrefixCovered(lower, prefixes) &&
+		networkingSubnetPoolV2PrefixCovered(upper, prefixes)
+}
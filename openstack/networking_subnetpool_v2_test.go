@@ -0,0 +1,85 @@
+package openstack
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetworkingSubnetPoolV2PrefixCovered(t *testing.T) {
+	testCases := []struct {
+		prefix   string
+		prefixes []string
+		expected bool
+	}{
+		{"10.10.0.0/24", []string{"10.10.0.0/24"}, true},
+		{"10.10.0.0/24", []string{"10.11.0.0/24", "10.10.0.0/16"}, true},
+		{"10.10.0.0/23", []string{"10.10.0.0/24", "10.10.1.0/24"}, true},
+		{"10.10.0.0/22", []string{"10.10.0.0/24", "10.10.1.0/24", "10.10.2.0/23"}, true},
+		{"10.10.0.0/16", []string{"10.10.0.0/24"}, false},
+		{"10.10.0.0/23", []string{"10.10.0.0/24", "10.10.2.0/24"}, false},
+		{"10.10.0.0/24", []string{"10.11.0.0/24"}, false},
+		{"10.10.0.0/24", []string{"::/0"}, false},
+		{"2001:db8::/64", []string{"2001:db8::/48"}, true},
+		{"2001:db8::/63", []string{"2001:db8::/64", "2001:db8:0:1::/64"}, true},
+		{"2001:db8::/63", []string{"2001:db8::/64"}, false},
+	}
+
+	for _, tc := range testCases {
+		_, prefix, err := net.ParseCIDR(tc.prefix)
+		assert.NoError(t, err)
+
+		var prefixes []*net.IPNet
+		for _, v := range tc.prefixes {
+			_, p, err := net.ParseCIDR(v)
+			assert.NoError(t, err)
+			prefixes = append(prefixes, p)
+		}
+
+		assert.Equal(t, tc.expected, networkingSubnetPoolV2PrefixCovered(prefix, prefixes), "%s in %v", tc.prefix, tc.prefixes)
+	}
+}
+
+func TestResourceNetworkingSubnetPoolV2PrefixesDiff(t *testing.T) {
+	r := resourceNetworkingSubnetPoolV2()
+
+	state := &terraform.InstanceState{
+		ID: "subnetpool-1",
+		Attributes: map[string]string{
+			"name":       "subnetpool_1",
+			"prefixes.#": "2",
+			"prefixes.0": "10.10.0.0/16",
+			"prefixes.1": "10.11.11.0/24",
+		},
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":     "subnetpool_1",
+		"prefixes": []interface{}{"10.10.0.0/16", "10.11.11.0/24", "10.12.0.0/24"},
+	})
+	diff, err := r.Diff(context.Background(), state, config, nil)
+	assert.NoError(t, err)
+	if assert.NotNil(t, diff) {
+		assert.False(t, diff.RequiresNew())
+		assert.Equal(t, "10.12.0.0/24", diff.Attributes["prefixes.2"].New)
+	}
+
+	config = terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":     "subnetpool_1",
+		"prefixes": []interface{}{"10.10.0.0/16", "10.11.0.0/16"},
+	})
+	_, err = r.Diff(context.Background(), state, config, nil)
+	assert.NoError(t, err)
+
+	config = terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":     "subnetpool_1",
+		"prefixes": []interface{}{"10.10.0.0/16"},
+	})
+	_, err = r.Diff(context.Background(), state, config, nil)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "prefix 10.11.11.0/24 can't be removed")
+	}
+}
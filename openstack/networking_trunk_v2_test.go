@@ -1,12 +1,18 @@
 package openstack
 
 import (
+	"context"
+	"fmt"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/trunks"
+	th "github.com/gophercloud/gophercloud/testhelper"
 )
 
 func TestFlattenNetworkingTrunkSubportsV2(t *testing.T) {
@@ -106,3 +112,34 @@ func TestExpandNetworkingTrunkSubportsRemoveV2(t *testing.T) {
 
 	assert.ElementsMatch(t, expectedRemoveSubports, actualRemoveSubports)
 }
+
+func TestNetworkingTrunkV2UpdateTimeout(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/trunks/trunk-1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"trunk": {"id": "trunk-1", "name": "trunk_2", "status": "BUILD"}}`)
+	})
+
+	config := testAccUnitConfig("network")
+
+	state := &terraform.InstanceState{
+		ID:         "trunk-1",
+		Attributes: map[string]string{"name": "trunk_1"},
+	}
+	diff := &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"name": {Old: "trunk_1", New: "trunk_2"},
+		},
+	}
+	timeouts := &schema.ResourceTimeout{Update: schema.DefaultTimeout(time.Second)}
+	assert.NoError(t, timeouts.DiffEncode(diff))
+
+	start := time.Now()
+	_, diags := resourceNetworkingTrunkV2().Apply(context.Background(), state, diff, config)
+
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "to become available")
+	assert.Less(t, int64(time.Since(start)), int64(5*time.Second))
+}
@@ -0,0 +1,93 @@
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// The account metadata keys which are managed by dedicated arguments.
+const (
+	objectStorageAccountV1QuotaBytes  = "Quota-Bytes"
+	objectStorageAccountV1TempURLKey  = "Temp-Url-Key"
+	objectStorageAccountV1TempURLKey2 = "Temp-Url-Key-2"
+)
+
+// objectStorageAccountV1IsSystemMetadata returns true if the metadata key is
+// managed by a dedicated argument.
+func objectStorageAccountV1IsSystemMetadata(key string) bool {
+	switch http.CanonicalHeaderKey(key) {
+	case objectStorageAccountV1QuotaBytes,
+		objectStorageAccountV1TempURLKey,
+		objectStorageAccountV1TempURLKey2:
+		return true
+	}
+
+	return false
+}
+
+// objectStorageAccountV1Endpoint returns the object storage endpoint of the
+// projectID account, based on the endpoint of the currentProjectID account.
+func objectStorageAccountV1Endpoint(endpoint, currentProjectID, projectID string) (string, error) {
+	if currentProjectID == projectID {
+		return endpoint, nil
+	}
+
+	current := "AUTH_" + currentProjectID
+	if !strings.Contains(endpoint, current) {
+		return "", fmt.Errorf("Unable to determine the object storage endpoint of project %s from %s", projectID, endpoint)
+	}
+
+	return strings.Replace(endpoint, current, "AUTH_"+projectID, 1), nil
+}
+
+// objectStorageAccountV1Client returns a copy of the object storage client
+// for the projectID account, and the ID of the account project.
+func objectStorageAccountV1Client(client *gophercloud.ServiceClient, projectID string) (*gophercloud.ServiceClient, string, error) {
+	project, err := getProjectFromToken(client)
+	if err != nil {
+		if projectID == "" {
+			return nil, "", fmt.Errorf("Error extracting project ID from token: %s", err)
+		}
+
+		// The token project is unknown, e.g. with swauth.
+		return client, projectID, nil
+	}
+
+	if projectID == "" {
+		return client, project.ID, nil
+	}
+
+	endpoint, err := objectStorageAccountV1Endpoint(client.Endpoint, project.ID, projectID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	c := *client
+	c.Endpoint = endpoint
+	if c.ResourceBase != "" {
+		c.ResourceBase = strings.Replace(c.ResourceBase, "AUTH_"+project.ID, "AUTH_"+projectID, 1)
+	}
+
+	return &c, projectID, nil
+}
+
+// objectStorageAccountV1Metadata returns the account metadata for the keys
+// which are managed by the resource. The Swift headers are case insensitive,
+// so the key names of the managed metadata are kept.
+func objectStorageAccountV1Metadata(metadata map[string]string, managed map[string]interface{}) map[string]string {
+	m := make(map[string]string)
+
+	for key := range managed {
+		for k, v := range metadata {
+			if strings.EqualFold(k, key) {
+				m[key] = v
+				break
+			}
+		}
+	}
+
+	return m
+}
@@ -0,0 +1,48 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObjectStorageAccountV1Endpoint(t *testing.T) {
+	endpoint, err := objectStorageAccountV1Endpoint("https://swift.example.com/v1/AUTH_p1/", "p1", "p1")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://swift.example.com/v1/AUTH_p1/", endpoint)
+
+	endpoint, err = objectStorageAccountV1Endpoint("https://swift.example.com/v1/AUTH_p1/", "p1", "p2")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://swift.example.com/v1/AUTH_p2/", endpoint)
+
+	_, err = objectStorageAccountV1Endpoint("https://swift.example.com/v1/", "p1", "p2")
+	assert.Error(t, err)
+}
+
+func TestObjectStorageAccountV1Metadata(t *testing.T) {
+	metadata := map[string]string{
+		"Test":      "true",
+		"Uppertest": "true",
+		"Other":     "value",
+	}
+
+	managed := map[string]interface{}{
+		"test":      "false",
+		"upperTest": "true",
+		"missing":   "true",
+	}
+
+	expected := map[string]string{
+		"test":      "true",
+		"upperTest": "true",
+	}
+
+	assert.Equal(t, expected, objectStorageAccountV1Metadata(metadata, managed))
+}
+
+func TestObjectStorageAccountV1IsSystemMetadata(t *testing.T) {
+	assert.True(t, objectStorageAccountV1IsSystemMetadata("quota-bytes"))
+	assert.True(t, objectStorageAccountV1IsSystemMetadata("Temp-URL-Key"))
+	assert.True(t, objectStorageAccountV1IsSystemMetadata("Temp-URL-Key-2"))
+	assert.False(t, objectStorageAccountV1IsSystemMetadata("Quota-Count"))
+}
@@ -0,0 +1,233 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/containers"
+)
+
+// The container metadata keys which are managed by dedicated arguments.
+const (
+	objectStorageContainerV1QuotaBytes  = "Quota-Bytes"
+	objectStorageContainerV1QuotaCount  = "Quota-Count"
+	objectStorageContainerV1TempURLKey  = "Temp-Url-Key"
+	objectStorageContainerV1TempURLKey2 = "Temp-Url-Key-2"
+)
+
+// objectStorageContainerV1IsSystemMetadata returns true if the metadata key
+// is managed by a dedicated argument.
+func objectStorageContainerV1IsSystemMetadata(key string) bool {
+	switch http.CanonicalHeaderKey(key) {
+	case objectStorageContainerV1QuotaBytes,
+		objectStorageContainerV1QuotaCount,
+		objectStorageContainerV1TempURLKey,
+		objectStorageContainerV1TempURLKey2:
+		return true
+	}
+
+	return false
+}
+
+// objectStorageContainerV1QuotaMetadata returns the value of a quota
+// metadata, or 0 if the quota isn't set.
+func objectStorageContainerV1QuotaMetadata(metadata map[string]string, key string) int {
+	v, err := strconv.Atoi(metadata[key])
+	if err != nil {
+		return 0
+	}
+
+	return v
+}
+
+// expandObjectStorageContainerV1ACL compiles a read_acl or write_acl block
+// to the Swift ACL syntax.
+func expandObjectStorageContainerV1ACL(raw []interface{}) string {
+	if len(raw) == 0 || raw[0] == nil {
+		return ""
+	}
+
+	v := raw[0].(map[string]interface{})
+	var acl []string
+
+	if referrers, ok := v["referrers"].([]interface{}); ok {
+		for _, referrer := range referrers {
+			acl = append(acl, ".r:"+referrer.(string))
+		}
+	}
+
+	if rlistings, ok := v["rlistings"].(bool); ok && rlistings {
+		acl = append(acl, ".rlistings")
+	}
+
+	if users, ok := v["users"].([]interface{}); ok {
+		for _, user := range users {
+			acl = append(acl, user.(string))
+		}
+	}
+
+	return strings.Join(acl, ",")
+}
+
+// flattenObjectStorageContainerV1ACL parses a Swift ACL to a read_acl or
+// write_acl block.
+func flattenObjectStorageContainerV1ACL(elements []string, read bool) []map[string]interface{} {
+	referrers := []string{}
+	users := []string{}
+	rlistings := false
+
+	for _, element := range elements {
+		element = strings.TrimSpace(element)
+		if element == "" {
+			continue
+		}
+
+		if element == ".rlistings" {
+			rlistings = true
+			continue
+		}
+
+		if strings.HasPrefix(element, ".") {
+			if parts := strings.SplitN(element, ":", 2); len(parts) == 2 {
+				switch parts[0] {
+				case ".r", ".ref", ".referer", ".referrer":
+					referrers = append(referrers, parts[1])
+					continue
+				}
+			}
+		}
+
+		users = append(users, element)
+	}
+
+	if len(referrers) == 0 && len(users) == 0 && !rlistings {
+		return nil
+	}
+
+	acl := map[string]interface{}{
+		"users": users,
+	}
+
+	if read {
+		acl["referrers"] = referrers
+		acl["rlistings"] = rlistings
+	}
+
+	return []map[string]interface{}{acl}
+}
+
+// objectStorageContainerV1CreateOpts represents the attributes used when
+// creating a new container.
+type objectStorageContainerV1CreateOpts struct {
+	containers.CreateOpts
+	VersionsEnabled bool
+}
+
+// ToContainerCreateMap formats a objectStorageContainerV1CreateOpts into a map
+// of headers.
+// It overrides containers.ToContainerCreateMap to add the X-Versions-Enabled
+// header.
+func (opts objectStorageContainerV1CreateOpts) ToContainerCreateMap() (map[string]string, error) {
+	h, err := opts.CreateOpts.ToContainerCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.VersionsEnabled {
+		h["X-Versions-Enabled"] = "true"
+	}
+
+	return h, nil
+}
+
+// objectStorageContainerV1UpdateOpts represents the attributes used when
+// updating an existing container.
+type objectStorageContainerV1UpdateOpts struct {
+	containers.UpdateOpts
+	VersionsEnabled *bool
+}
+
+// ToContainerUpdateMap formats a objectStorageContainerV1UpdateOpts into a map
+// of headers.
+// It overrides containers.ToContainerUpdateMap to add the X-Versions-Enabled
+// header.
+func (opts objectStorageContainerV1UpdateOpts) ToContainerUpdateMap() (map[string]string, error) {
+	h, err := opts.UpdateOpts.ToContainerUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.VersionsEnabled != nil {
+		h["X-Versions-Enabled"] = strconv.FormatBool(*opts.VersionsEnabled)
+	}
+
+	return h, nil
+}
+
+// objectStorageContainerV1ObjectVersion represents an object version returned
+// by a container listing with the versions query parameter.
+type objectStorageContainerV1ObjectVersion struct {
+	Name      string `json:"name"`
+	VersionID string `json:"version_id"`
+}
+
+// objectStorageContainerV1ListVersions lists a page of the object versions of
+// a container, starting after the marker and versionMarker.
+func objectStorageContainerV1ListVersions(client *gophercloud.ServiceClient, containerName, marker, versionMarker string) ([]objectStorageContainerV1ObjectVersion, error) {
+	query := url.Values{}
+	query.Set("format", "json")
+	query.Set("versions", "")
+	if marker != "" {
+		query.Set("marker", marker)
+	}
+	if versionMarker != "" {
+		query.Set("version_marker", versionMarker)
+	}
+
+	var versions []objectStorageContainerV1ObjectVersion
+	resp, err := client.Get(client.ServiceURL(containerName)+"?"+query.Encode(), &versions, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	_, _, err = gophercloud.ParseResponse(resp, err)
+
+	return versions, err
+}
+
+// objectStorageContainerV1DeleteVersions deletes all the object versions of a
+// container, so that a versioned container can be deleted.
+func objectStorageContainerV1DeleteVersions(client *gophercloud.ServiceClient, containerName string) error {
+	var marker, versionMarker string
+
+	for {
+		versions, err := objectStorageContainerV1ListVersions(client, containerName, marker, versionMarker)
+		if err != nil {
+			return fmt.Errorf("error listing object versions of objectstorage_container_v1 '%s': %s", containerName, err)
+		}
+
+		if len(versions) == 0 {
+			return nil
+		}
+
+		for _, v := range versions {
+			log.Printf("[DEBUG] Deleting object '%s' version '%s' from objectstorage_container_v1 '%s'", v.Name, v.VersionID, containerName)
+			resp, err := client.Delete(client.ServiceURL(containerName, v.Name)+"?version-id="+url.QueryEscape(v.VersionID), &gophercloud.RequestOpts{
+				OkCodes: []int{204},
+			})
+			_, _, err = gophercloud.ParseResponse(resp, err)
+			if err != nil {
+				if _, ok := err.(gophercloud.ErrDefault404); ok {
+					continue
+				}
+				return fmt.Errorf("error deleting object '%s' version '%s' from objectstorage_container_v1 '%s': %s", v.Name, v.VersionID, containerName, err)
+			}
+		}
+
+		last := versions[len(versions)-1]
+		marker, versionMarker = last.Name, last.VersionID
+	}
+}
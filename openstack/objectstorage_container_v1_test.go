@@ -0,0 +1,97 @@
+package openstack
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObjectStorageContainerV1IsSystemMetadata(t *testing.T) {
+	assert.True(t, objectStorageContainerV1IsSystemMetadata("Quota-Bytes"))
+	assert.True(t, objectStorageContainerV1IsSystemMetadata("quota-count"))
+	assert.True(t, objectStorageContainerV1IsSystemMetadata("Temp-URL-Key"))
+	assert.True(t, objectStorageContainerV1IsSystemMetadata("temp-url-key-2"))
+	assert.False(t, objectStorageContainerV1IsSystemMetadata("test"))
+}
+
+func TestObjectStorageContainerV1QuotaMetadata(t *testing.T) {
+	metadata := map[string]string{
+		"Quota-Bytes": "1024",
+		"Quota-Count": "invalid",
+	}
+
+	assert.Equal(t, 1024, objectStorageContainerV1QuotaMetadata(metadata, objectStorageContainerV1QuotaBytes))
+	assert.Equal(t, 0, objectStorageContainerV1QuotaMetadata(metadata, objectStorageContainerV1QuotaCount))
+	assert.Equal(t, 0, objectStorageContainerV1QuotaMetadata(map[string]string{}, objectStorageContainerV1QuotaCount))
+}
+
+func TestExpandObjectStorageContainerV1ACL(t *testing.T) {
+	readACL := []interface{}{
+		map[string]interface{}{
+			"referrers": []interface{}{"*", "-bad.example.com"},
+			"users":     []interface{}{"project_1:user_1", "project_2:*"},
+			"rlistings": true,
+		},
+	}
+	assert.Equal(t, ".r:*,.r:-bad.example.com,.rlistings,project_1:user_1,project_2:*", expandObjectStorageContainerV1ACL(readACL))
+
+	writeACL := []interface{}{
+		map[string]interface{}{
+			"users": []interface{}{"project_1:user_1"},
+		},
+	}
+	assert.Equal(t, "project_1:user_1", expandObjectStorageContainerV1ACL(writeACL))
+
+	assert.Equal(t, "", expandObjectStorageContainerV1ACL([]interface{}{}))
+	assert.Equal(t, "", expandObjectStorageContainerV1ACL([]interface{}{nil}))
+}
+
+func TestFlattenObjectStorageContainerV1ACL(t *testing.T) {
+	expected := []map[string]interface{}{
+		{
+			"referrers": []string{"*", "-bad.example.com"},
+			"users":     []string{"project_1:user_1"},
+			"rlistings": true,
+		},
+	}
+	actual := flattenObjectStorageContainerV1ACL([]string{".r:*", " .referrer:-bad.example.com", ".rlistings", "project_1:user_1"}, true)
+	assert.Equal(t, expected, actual)
+
+	expected = []map[string]interface{}{
+		{
+			"users": []string{"project_1:user_1", "project_2:*"},
+		},
+	}
+	actual = flattenObjectStorageContainerV1ACL([]string{"project_1:user_1", "project_2:*"}, false)
+	assert.Equal(t, expected, actual)
+
+	assert.Nil(t, flattenObjectStorageContainerV1ACL([]string{""}, true))
+}
+
+func TestResourceObjectStorageContainerV1StateUpgradeV0(t *testing.T) {
+	rawState := map[string]interface{}{
+		"name": "container_1",
+		"versioning": []interface{}{
+			map[string]interface{}{
+				"type":     "versions",
+				"location": "container_1_versions",
+			},
+		},
+	}
+
+	actual, err := resourceObjectStorageContainerV1StateUpgradeV0(context.Background(), rawState, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, false, actual["versioning"])
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{
+			"type":     "versions",
+			"location": "container_1_versions",
+		},
+	}, actual["versioning_legacy"])
+
+	actual, err = resourceObjectStorageContainerV1StateUpgradeV0(context.Background(), map[string]interface{}{"name": "container_1"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, false, actual["versioning"])
+	assert.Equal(t, []interface{}{}, actual["versioning_legacy"])
+}
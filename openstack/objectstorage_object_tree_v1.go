@@ -0,0 +1,272 @@
+package openstack
+
+import (
+	"crypto/md5"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/mitchellh/go-homedir"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// objectStorageObjectTreeV1DefaultConcurrency is the number of objects, which
+// are uploaded or deleted at once, when concurrency is not set.
+const objectStorageObjectTreeV1DefaultConcurrency = 8
+
+// objectStorageObjectTreeV1File represents a local file of the source
+// directory and the object it is uploaded to.
+type objectStorageObjectTreeV1File struct {
+	Path string
+	Name string
+	ETag string
+	Size int64
+}
+
+// objectStorageObjectTreeV1Walk returns the regular files of the source
+// directory, keyed by their object name. The object name is the path of the
+// file relative to the source directory, using slashes, after the prefix.
+func objectStorageObjectTreeV1Walk(sourceDir, prefix string) (map[string]objectStorageObjectTreeV1File, error) {
+	root, err := homedir.Expand(sourceDir)
+	if err != nil {
+		return nil, fmt.Errorf("Error expanding homedir in source_dir (%s): %s", sourceDir, err)
+	}
+
+	files := make(map[string]objectStorageObjectTreeV1File)
+	err = filepath.Walk(root, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			if !info.IsDir() {
+				log.Printf("[DEBUG] Skipping %s in openstack_objectstorage_object_tree_v1 source_dir: not a regular file", p)
+			}
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+
+		etag, err := objectStorageObjectTreeV1FileETag(p)
+		if err != nil {
+			return err
+		}
+
+		name := prefix + filepath.ToSlash(rel)
+		files[name] = objectStorageObjectTreeV1File{
+			Path: p,
+			Name: name,
+			ETag: etag,
+			Size: info.Size(),
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error reading openstack_objectstorage_object_tree_v1 source_dir (%s): %s", sourceDir, err)
+	}
+
+	return files, nil
+}
+
+// objectStorageObjectTreeV1FileETag returns the MD5 checksum of the file,
+// which is the ETag Swift reports for the uploaded object.
+func objectStorageObjectTreeV1FileETag(p string) (string, error) {
+	file, err := os.Open(p)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := md5.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%x", hash.Sum(nil)), nil
+}
+
+// objectStorageObjectTreeV1ETags returns the files attribute for the files.
+func objectStorageObjectTreeV1ETags(files map[string]objectStorageObjectTreeV1File) map[string]string {
+	etags := make(map[string]string, len(files))
+	for name, file := range files {
+		etags[name] = file.ETag
+	}
+
+	return etags
+}
+
+func objectStorageObjectTreeV1ExpandETags(v interface{}) map[string]string {
+	etags := make(map[string]string)
+	for name, etag := range v.(map[string]interface{}) {
+		etags[name] = etag.(string)
+	}
+
+	return etags
+}
+
+// objectStorageObjectTreeV1ContentType guesses the content type of an object
+// from its extension. The content_types overrides take precedence over the
+// system MIME types. An empty content type lets Swift guess it.
+func objectStorageObjectTreeV1ContentType(name string, contentTypes map[string]interface{}) string {
+	ext := strings.ToLower(path.Ext(name))
+	if ext == "" {
+		return ""
+	}
+
+	for k, v := range contentTypes {
+		if strings.ToLower("."+strings.TrimPrefix(k, ".")) == ext {
+			return v.(string)
+		}
+	}
+
+	return mime.TypeByExtension(ext)
+}
+
+// objectStorageObjectTreeV1Upload uploads the file as an object. The ETag is
+// sent, so that Swift rejects a file modified during the upload.
+func objectStorageObjectTreeV1Upload(client *gophercloud.ServiceClient, containerName string, file objectStorageObjectTreeV1File, contentType string, metadata map[string]string) error {
+	f, err := os.Open(file.Path)
+	if err != nil {
+		return fmt.Errorf("Error opening openstack_objectstorage_object_tree_v1 file (%s): %s", file.Path, err)
+	}
+	defer f.Close()
+
+	createOpts := &objects.CreateOpts{
+		Content:       f,
+		ContentLength: file.Size,
+		ContentType:   contentType,
+		ETag:          file.ETag,
+		Metadata:      metadata,
+	}
+
+	log.Printf("[DEBUG] Uploading openstack_objectstorage_object_tree_v1 file %s to %s/%s", file.Path, containerName, file.Name)
+	_, err = objects.Create(client, containerName, file.Name, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error uploading openstack_objectstorage_object_tree_v1 file %s to %s/%s: %s", file.Path, containerName, file.Name, err)
+	}
+
+	return nil
+}
+
+// objectStorageObjectTreeV1Delete removes an object. An already removed object
+// is ignored.
+func objectStorageObjectTreeV1Delete(client *gophercloud.ServiceClient, containerName, name string) error {
+	log.Printf("[DEBUG] Deleting openstack_objectstorage_object_tree_v1 object %s/%s", containerName, name)
+	_, err := objects.Delete(client, containerName, name, nil).Extract()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			return nil
+		}
+		return fmt.Errorf("Error deleting openstack_objectstorage_object_tree_v1 object %s/%s: %s", containerName, name, err)
+	}
+
+	return nil
+}
+
+// objectStorageObjectTreeV1ListETags returns the ETags of the objects of the
+// container starting with the prefix, keyed by their name.
+func objectStorageObjectTreeV1ListETags(client *gophercloud.ServiceClient, containerName, prefix string) (map[string]string, error) {
+	etags := make(map[string]string)
+	listOpts := &objects.ListOpts{
+		Full:   true,
+		Prefix: prefix,
+	}
+	err := objects.List(client, containerName, listOpts).EachPage(func(page pagination.Page) (bool, error) {
+		objectList, err := objects.ExtractInfo(page)
+		if err != nil {
+			return false, err
+		}
+
+		for _, o := range objectList {
+			etags[o.Name] = o.Hash
+		}
+
+		return true, nil
+	})
+
+	return etags, err
+}
+
+// objectStorageObjectTreeV1SortedNames returns the sorted object names, so
+// that the objects are uploaded and deleted in a stable order.
+func objectStorageObjectTreeV1SortedNames(etags map[string]string) []string {
+	names := make([]string, 0, len(etags))
+	for name := range etags {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// objectStorageObjectTreeV1Sync uploads the new and changed files and deletes
+// the objects, whose files were removed. All files are uploaded again if
+// uploadAll is set. It returns the ETags of the objects after the sync, which
+// only include the successful uploads and deletions, if an error occurred.
+func objectStorageObjectTreeV1Sync(client *gophercloud.ServiceClient, containerName string, files map[string]objectStorageObjectTreeV1File, etags map[string]string, uploadAll bool, contentTypes map[string]interface{}, metadata map[string]string, concurrency int) (map[string]string, error) {
+	result := make(map[string]string, len(etags))
+	for name, etag := range etags {
+		result[name] = etag
+	}
+
+	var mu sync.Mutex
+	var tasks []func() error
+
+	for _, name := range objectStorageObjectTreeV1SortedNames(objectStorageObjectTreeV1ETags(files)) {
+		file := files[name]
+		if !uploadAll && etags[name] == file.ETag {
+			continue
+		}
+
+		contentType := objectStorageObjectTreeV1ContentType(name, contentTypes)
+		tasks = append(tasks, func() error {
+			if err := objectStorageObjectTreeV1Upload(client, containerName, file, contentType, metadata); err != nil {
+				return err
+			}
+
+			mu.Lock()
+			result[file.Name] = file.ETag
+			mu.Unlock()
+
+			return nil
+		})
+	}
+
+	for _, name := range objectStorageObjectTreeV1SortedNames(etags) {
+		if _, ok := files[name]; ok {
+			continue
+		}
+
+		name := name
+		tasks = append(tasks, func() error {
+			if err := objectStorageObjectTreeV1Delete(client, containerName, name); err != nil {
+				return err
+			}
+
+			mu.Lock()
+			delete(result, name)
+			mu.Unlock()
+
+			return nil
+		})
+	}
+
+	log.Printf("[DEBUG] Syncing %d objects of openstack_objectstorage_object_tree_v1 in %s", len(tasks), containerName)
+	err := runTasks(tasks, concurrency)
+
+	return result, err
+}
@@ -0,0 +1,155 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestObjectStorageObjectTreeV1Walk(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf_test_objectstorage_object_tree")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "css", "empty"), 0700))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "index.html"), []byte("foo"), 0600))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "css", "site.css"), []byte("bar"), 0600))
+
+	files, err := objectStorageObjectTreeV1Walk(dir, "site/")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"site/index.html":   fooMD5(),
+		"site/css/site.css": barMD5(),
+	}, objectStorageObjectTreeV1ETags(files))
+	assert.Equal(t, filepath.Join(dir, "css", "site.css"), files["site/css/site.css"].Path)
+	assert.Equal(t, int64(3), files["site/css/site.css"].Size)
+
+	_, err = objectStorageObjectTreeV1Walk(filepath.Join(dir, "missing"), "")
+	assert.Error(t, err)
+}
+
+func TestObjectStorageObjectTreeV1ContentType(t *testing.T) {
+	contentTypes := map[string]interface{}{
+		".webmanifest": "application/manifest+json",
+		"HTML":         "text/html; charset=utf-8",
+	}
+
+	assert.Equal(t, "application/manifest+json", objectStorageObjectTreeV1ContentType("site/app.webmanifest", contentTypes))
+	assert.Equal(t, "text/html; charset=utf-8", objectStorageObjectTreeV1ContentType("site/index.html", contentTypes))
+	assert.Contains(t, objectStorageObjectTreeV1ContentType("site/css/site.CSS", nil), "text/css")
+	assert.Equal(t, "", objectStorageObjectTreeV1ContentType("site/LICENSE", contentTypes))
+}
+
+func TestObjectStorageObjectTreeV1CustomizeDiff(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf_test_objectstorage_object_tree")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "index.html"), []byte("foo"), 0600))
+
+	state := &terraform.InstanceState{
+		ID: "container_1/",
+		Attributes: map[string]string{
+			"container_name":     "container_1",
+			"source_dir":         dir,
+			"concurrency":        "8",
+			"files.%":            "2",
+			"files.index.html":   fooMD5(),
+			"files.old/file.txt": fooMD5(),
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"container_name": "container_1",
+		"source_dir":     dir,
+	})
+
+	diff, err := resourceObjectStorageObjectTreeV1().Diff(context.Background(), state, config, nil)
+	assert.NoError(t, err)
+	if assert.NotNil(t, diff) {
+		assert.False(t, diff.RequiresNew())
+		assert.Equal(t, "1", diff.Attributes["files.%"].New)
+		assert.True(t, diff.Attributes["files.old/file.txt"].NewRemoved)
+	}
+
+	// No changes are planned, when the objects match the files.
+	assert.NoError(t, os.Mkdir(filepath.Join(dir, "old"), 0700))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "old", "file.txt"), []byte("foo"), 0600))
+
+	diff, err = resourceObjectStorageObjectTreeV1().Diff(context.Background(), state, config, nil)
+	assert.NoError(t, err)
+	assert.True(t, diff == nil || diff.Empty())
+}
+
+func TestObjectStorageObjectTreeV1Sync(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	dir, err := ioutil.TempDir("", "tf_test_objectstorage_object_tree")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "index.html"), []byte("foo"), 0600))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "site.css"), []byte("bar"), 0600))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "LICENSE"), []byte("bar"), 0600))
+
+	var mu sync.Mutex
+	var requests []string
+	th.Mux.HandleFunc("/container_1/", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, fmt.Sprintf("%s %s %s %s", r.Method, r.URL.Path, r.Header.Get("Content-Type"), r.Header.Get("ETag")))
+		mu.Unlock()
+
+		switch r.URL.Path {
+		case "/container_1/LICENSE":
+			w.WriteHeader(http.StatusUnprocessableEntity)
+		case "/container_1/gone.txt":
+			w.WriteHeader(http.StatusNotFound)
+		case "/container_1/old.txt":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusCreated)
+		}
+	})
+
+	files, err := objectStorageObjectTreeV1Walk(dir, "")
+	assert.NoError(t, err)
+
+	etags := map[string]string{
+		"index.html": fooMD5(),
+		"site.css":   fooMD5(),
+		"old.txt":    fooMD5(),
+		"gone.txt":   fooMD5(),
+	}
+	contentTypes := map[string]interface{}{
+		".css": "text/css",
+	}
+
+	result, err := objectStorageObjectTreeV1Sync(thclient.ServiceClient(), "container_1", files, etags, false, contentTypes, nil, 2)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Error uploading openstack_objectstorage_object_tree_v1 file")
+	assert.Equal(t, map[string]string{
+		"index.html": fooMD5(),
+		"site.css":   barMD5(),
+	}, result)
+
+	sort.Strings(requests)
+	assert.Equal(t, []string{
+		"DELETE /container_1/gone.txt  ",
+		"DELETE /container_1/old.txt  ",
+		"PUT /container_1/LICENSE  " + barMD5(),
+		"PUT /container_1/site.css text/css " + barMD5(),
+	}, requests)
+}
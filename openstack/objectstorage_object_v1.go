@@ -0,0 +1,365 @@
+package openstack
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/containers"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// objectStorageObjectV1DefaultSegmentSize is the segment size used for the
+// large objects, when segment_size is not set.
+const objectStorageObjectV1DefaultSegmentSize int64 = 1024 * 1024 * 1024
+
+// objectStorageObjectV1Segment represents a segment of a large object.
+type objectStorageObjectV1Segment struct {
+	Container string
+	Name      string
+	ETag      string
+	Size      int64
+}
+
+// objectStorageObjectV1LargeObject represents the manifest of an existing
+// large object.
+type objectStorageObjectV1LargeObject struct {
+	// Kind is either "slo" or "dlo".
+	Kind string
+
+	// Manifest is the X-Object-Manifest header of a DLO.
+	Manifest string
+
+	Segments []objectStorageObjectV1Segment
+}
+
+// objectStorageObjectV1SLOSegment represents a segment in the SLO manifest
+// returned by Swift.
+type objectStorageObjectV1SLOSegment struct {
+	Name  string `json:"name"`
+	Hash  string `json:"hash"`
+	Bytes int64  `json:"bytes"`
+}
+
+func objectStorageObjectV1SegmentContainer(containerName string) string {
+	return containerName + "_segments"
+}
+
+// objectStorageObjectV1SegmentPrefix returns a unique prefix for the segments
+// of an upload, following the python-swiftclient layout.
+func objectStorageObjectV1SegmentPrefix(name, kind string, size, segmentSize int64) string {
+	return fmt.Sprintf("%s/%s/%d/%d/%d/", name, kind, time.Now().UnixNano(), size, segmentSize)
+}
+
+func objectStorageObjectV1SegmentSize(v int) int64 {
+	if v > 0 {
+		return int64(v)
+	}
+
+	return objectStorageObjectV1DefaultSegmentSize
+}
+
+// objectStorageObjectV1SegmentCount returns the number of segments needed to
+// upload size bytes. An empty content is uploaded as a single segment.
+func objectStorageObjectV1SegmentCount(size, segmentSize int64) int64 {
+	if size == 0 {
+		return 1
+	}
+
+	return (size + segmentSize - 1) / segmentSize
+}
+
+// objectStorageObjectV1LargeObjectETag computes the ETag which Swift reports
+// for a large object: the MD5 checksum of the concatenated segments' MD5
+// checksums.
+func objectStorageObjectV1LargeObjectETag(r io.ReaderAt, size, segmentSize int64) (string, error) {
+	var etags strings.Builder
+
+	for i := int64(0); i < objectStorageObjectV1SegmentCount(size, segmentSize); i++ {
+		hash := md5.New()
+		if _, err := io.Copy(hash, objectStorageObjectV1SegmentReader(r, i, size, segmentSize)); err != nil {
+			return "", err
+		}
+		fmt.Fprintf(&etags, "%x", hash.Sum(nil))
+	}
+
+	return fmt.Sprintf("%x", md5.Sum([]byte(etags.String()))), nil
+}
+
+func objectStorageObjectV1SegmentReader(r io.ReaderAt, i, size, segmentSize int64) *io.SectionReader {
+	offset := i * segmentSize
+	length := segmentSize
+	if offset+length > size {
+		length = size - offset
+	}
+
+	return io.NewSectionReader(r, offset, length)
+}
+
+// objectStorageObjectV1UploadSegments streams the content into segments of
+// the segment container.
+func objectStorageObjectV1UploadSegments(client *gophercloud.ServiceClient, segmentContainer, prefix string, r io.ReaderAt, size, segmentSize int64) ([]objectStorageObjectV1Segment, error) {
+	if _, err := containers.Create(client, segmentContainer, nil).Extract(); err != nil {
+		return nil, fmt.Errorf("Error creating OpenStack segment container %s: %s", segmentContainer, err)
+	}
+
+	count := objectStorageObjectV1SegmentCount(size, segmentSize)
+	segments := make([]objectStorageObjectV1Segment, 0, count)
+
+	for i := int64(0); i < count; i++ {
+		segment := objectStorageObjectV1Segment{
+			Container: segmentContainer,
+			Name:      fmt.Sprintf("%s%08d", prefix, i),
+		}
+
+		section := objectStorageObjectV1SegmentReader(r, i, size, segmentSize)
+		segment.Size = section.Size()
+
+		log.Printf("[DEBUG] Uploading OpenStack object segment %s/%s", segmentContainer, segment.Name)
+		res, err := objects.Create(client, segmentContainer, segment.Name, &objects.CreateOpts{
+			Content:       section,
+			ContentLength: segment.Size,
+		}).Extract()
+		if err != nil {
+			objectStorageObjectV1DeleteSegments(client, segments)
+			return nil, fmt.Errorf("Error uploading OpenStack object segment %s/%s: %s", segmentContainer, segment.Name, err)
+		}
+
+		segment.ETag = strings.Trim(res.ETag, `"`)
+		segments = append(segments, segment)
+	}
+
+	return segments, nil
+}
+
+// objectStorageObjectV1PutManifest writes the manifest of a large object. The
+// headers and metadata of the manifest are taken from opts.
+func objectStorageObjectV1PutManifest(client *gophercloud.ServiceClient, containerName, name string, lo *objectStorageObjectV1LargeObject, opts objects.CreateOpts) error {
+	opts.NoETag = true
+	opts.ETag = ""
+	opts.TransferEncoding = ""
+	opts.CopyFrom = ""
+
+	switch lo.Kind {
+	case "slo":
+		manifest := make([]map[string]interface{}, 0, len(lo.Segments))
+		for _, s := range lo.Segments {
+			manifest = append(manifest, map[string]interface{}{
+				"path":       fmt.Sprintf("/%s/%s", s.Container, s.Name),
+				"etag":       s.ETag,
+				"size_bytes": s.Size,
+			})
+		}
+
+		b, err := json.Marshal(manifest)
+		if err != nil {
+			return err
+		}
+
+		opts.Content = bytes.NewReader(b)
+		opts.ContentLength = int64(len(b))
+		opts.MultipartManifest = "put"
+		opts.ObjectManifest = ""
+	case "dlo":
+		opts.Content = bytes.NewReader([]byte(""))
+		opts.ContentLength = 0
+		opts.ObjectManifest = lo.Manifest
+	default:
+		return fmt.Errorf("Unsupported large object type: %s", lo.Kind)
+	}
+
+	log.Printf("[DEBUG] Writing OpenStack %s manifest %s/%s", lo.Kind, containerName, name)
+	_, err := objects.Create(client, containerName, name, opts).Extract()
+	return err
+}
+
+// objectStorageObjectV1CreateLargeObject uploads the content of opts in
+// segments and writes the manifest of the large object.
+func objectStorageObjectV1CreateLargeObject(client *gophercloud.ServiceClient, containerName, name, kind string, segmentSize int64, opts objects.CreateOpts) error {
+	r, ok := opts.Content.(io.ReaderAt)
+	if !ok {
+		return fmt.Errorf("Must specify \"source\" or \"content\" field for a large object")
+	}
+
+	segmentContainer := objectStorageObjectV1SegmentContainer(containerName)
+	prefix := objectStorageObjectV1SegmentPrefix(name, kind, opts.ContentLength, segmentSize)
+
+	segments, err := objectStorageObjectV1UploadSegments(client, segmentContainer, prefix, r, opts.ContentLength, segmentSize)
+	if err != nil {
+		return err
+	}
+
+	lo := &objectStorageObjectV1LargeObject{
+		Kind:     kind,
+		Manifest: fmt.Sprintf("%s/%s", segmentContainer, prefix),
+		Segments: segments,
+	}
+
+	if err := objectStorageObjectV1PutManifest(client, containerName, name, lo, opts); err != nil {
+		objectStorageObjectV1DeleteSegments(client, segments)
+		return err
+	}
+
+	return nil
+}
+
+// objectStorageObjectV1GetLargeObject returns the manifest of an existing
+// large object, or nil if the object is not a large object.
+func objectStorageObjectV1GetLargeObject(client *gophercloud.ServiceClient, containerName, name string) (*objectStorageObjectV1LargeObject, error) {
+	header, err := objects.Get(client, containerName, name, nil).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	if header.StaticLargeObject {
+		res := objects.Download(client, containerName, name, &objects.DownloadOpts{
+			MultipartManifest: "get",
+		})
+		content, err := res.ExtractContent()
+		if err != nil {
+			return nil, err
+		}
+
+		var sloSegments []objectStorageObjectV1SLOSegment
+		if err := json.Unmarshal(content, &sloSegments); err != nil {
+			return nil, fmt.Errorf("Error parsing OpenStack SLO manifest %s/%s: %s", containerName, name, err)
+		}
+
+		lo := &objectStorageObjectV1LargeObject{
+			Kind:     "slo",
+			Segments: make([]objectStorageObjectV1Segment, 0, len(sloSegments)),
+		}
+		for _, s := range sloSegments {
+			parts := strings.SplitN(strings.TrimPrefix(s.Name, "/"), "/", 2)
+			if len(parts) != 2 {
+				return nil, fmt.Errorf("Invalid OpenStack SLO segment path: %s", s.Name)
+			}
+
+			lo.Segments = append(lo.Segments, objectStorageObjectV1Segment{
+				Container: parts[0],
+				Name:      parts[1],
+				ETag:      s.Hash,
+				Size:      s.Bytes,
+			})
+		}
+
+		return lo, nil
+	}
+
+	if header.ObjectManifest != "" {
+		parts := strings.SplitN(header.ObjectManifest, "/", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("Invalid OpenStack DLO manifest: %s", header.ObjectManifest)
+		}
+
+		lo := &objectStorageObjectV1LargeObject{
+			Kind:     "dlo",
+			Manifest: header.ObjectManifest,
+		}
+
+		listOpts := &objects.ListOpts{
+			Full:   true,
+			Prefix: parts[1],
+		}
+		err := objects.List(client, parts[0], listOpts).EachPage(func(page pagination.Page) (bool, error) {
+			objectList, err := objects.ExtractInfo(page)
+			if err != nil {
+				return false, err
+			}
+
+			for _, o := range objectList {
+				lo.Segments = append(lo.Segments, objectStorageObjectV1Segment{
+					Container: parts[0],
+					Name:      o.Name,
+					ETag:      o.Hash,
+					Size:      o.Bytes,
+				})
+			}
+
+			return true, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return lo, nil
+	}
+
+	return nil, nil
+}
+
+// objectStorageObjectV1DeleteSegments removes the segments of a large object.
+// Errors are only logged, since the manifest has already been replaced or
+// removed.
+func objectStorageObjectV1DeleteSegments(client *gophercloud.ServiceClient, segments []objectStorageObjectV1Segment) {
+	for _, s := range segments {
+		log.Printf("[DEBUG] Deleting OpenStack object segment %s/%s", s.Container, s.Name)
+		_, err := objects.Delete(client, s.Container, s.Name, nil).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				continue
+			}
+			log.Printf("[WARN] Error deleting OpenStack object segment %s/%s: %s", s.Container, s.Name, err)
+		}
+	}
+}
+
+// objectStorageObjectV1ReadContent reads the content of an object, failing if
+// it exceeds maxLength bytes.
+func objectStorageObjectV1ReadContent(r io.Reader, maxLength int64) ([]byte, error) {
+	content, err := ioutil.ReadAll(io.LimitReader(r, maxLength+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if int64(len(content)) > maxLength {
+		return nil, fmt.Errorf("The object content exceeds max_content_length of %d bytes", maxLength)
+	}
+
+	return content, nil
+}
+
+// objectStorageObjectV1Metadata returns the X-Object-Meta-* headers of an
+// object.
+func objectStorageObjectV1Metadata(header http.Header) map[string]string {
+	metadata := make(map[string]string)
+	for k, v := range header {
+		if strings.HasPrefix(k, "X-Object-Meta-") && len(v) > 0 {
+			metadata[strings.TrimPrefix(k, "X-Object-Meta-")] = v[0]
+		}
+	}
+
+	return metadata
+}
+
+// objectStorageObjectV1UpdateOpts represents the attributes used when
+// updating the headers and metadata of an existing object.
+type objectStorageObjectV1UpdateOpts struct {
+	objects.UpdateOpts
+	RemoveDeleteAt bool
+}
+
+// ToObjectUpdateMap formats a objectStorageObjectV1UpdateOpts into a map of
+// headers.
+// It overrides objects.ToObjectUpdateMap to add the X-Remove-Delete-At header.
+func (opts objectStorageObjectV1UpdateOpts) ToObjectUpdateMap() (map[string]string, error) {
+	h, err := opts.UpdateOpts.ToObjectUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.RemoveDeleteAt {
+		h["X-Remove-Delete-At"] = "remove"
+	}
+
+	return h, nil
+}
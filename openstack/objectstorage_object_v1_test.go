@@ -0,0 +1,127 @@
+package openstack
+
+import (
+	"context"
+	"crypto/md5"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestObjectStorageObjectV1SegmentCount(t *testing.T) {
+	assert.Equal(t, int64(1), objectStorageObjectV1SegmentCount(0, 3))
+	assert.Equal(t, int64(1), objectStorageObjectV1SegmentCount(3, 3))
+	assert.Equal(t, int64(2), objectStorageObjectV1SegmentCount(4, 3))
+	assert.Equal(t, int64(3), objectStorageObjectV1SegmentCount(7, 3))
+}
+
+func TestObjectStorageObjectV1SegmentSize(t *testing.T) {
+	assert.Equal(t, objectStorageObjectV1DefaultSegmentSize, objectStorageObjectV1SegmentSize(0))
+	assert.Equal(t, int64(1048576), objectStorageObjectV1SegmentSize(1048576))
+}
+
+func TestObjectStorageObjectV1LargeObjectETag(t *testing.T) {
+	content := "foobarbaz"
+
+	expected := fmt.Sprintf("%x", md5.Sum([]byte(fmt.Sprintf("%x%x%x",
+		md5.Sum([]byte("foob")),
+		md5.Sum([]byte("arba")),
+		md5.Sum([]byte("z")),
+	))))
+
+	etag, err := objectStorageObjectV1LargeObjectETag(strings.NewReader(content), int64(len(content)), 4)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, etag)
+
+	expected = fmt.Sprintf("%x", md5.Sum([]byte(fmt.Sprintf("%x", md5.Sum([]byte(content))))))
+
+	etag, err = objectStorageObjectV1LargeObjectETag(strings.NewReader(content), int64(len(content)), 1024)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, etag)
+}
+
+func TestObjectStorageObjectV1SegmentPrefix(t *testing.T) {
+	prefix := objectStorageObjectV1SegmentPrefix("dir/file", "slo", 10, 4)
+	assert.True(t, strings.HasPrefix(prefix, "dir/file/slo/"))
+	assert.True(t, strings.HasSuffix(prefix, "/10/4/"))
+}
+
+func TestObjectStorageObjectV1ReadContent(t *testing.T) {
+	content, err := objectStorageObjectV1ReadContent(strings.NewReader("foo"), 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("foo"), content)
+
+	_, err = objectStorageObjectV1ReadContent(strings.NewReader("foobar"), 3)
+	assert.Error(t, err)
+}
+
+func TestObjectStorageObjectV1Metadata(t *testing.T) {
+	header := http.Header{
+		"Content-Type":      []string{"text/plain"},
+		"X-Object-Meta-Foo": []string{"bar"},
+	}
+
+	assert.Equal(t, map[string]string{"Foo": "bar"}, objectStorageObjectV1Metadata(header))
+}
+
+func TestObjectStorageObjectV1RemoveExpiration(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var updateHeaders http.Header
+	th.Mux.HandleFunc("/container_1/test", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			updateHeaders = r.Header
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+
+		w.Header().Add("Content-Type", "text/plain")
+		w.Header().Add("Etag", fooMD5())
+		w.WriteHeader(http.StatusOK)
+	})
+
+	config := testAccUnitConfig("object-store")
+
+	state := &terraform.InstanceState{
+		ID: "test",
+		Attributes: map[string]string{
+			"name":           "test",
+			"container_name": "container_1",
+			"content":        "foo",
+			"content_type":   "text/plain",
+			"etag":           fooMD5(),
+			"delete_after":   "3600",
+			"delete_at":      "2030-01-01T00:00:00Z",
+		},
+	}
+
+	r := resourceObjectStorageObjectV1()
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":           "test",
+		"container_name": "container_1",
+		"content":        "foo",
+		"content_type":   "text/plain",
+	}), nil)
+
+	assert.NoError(t, err)
+	if assert.NotNil(t, diff) && assert.NotNil(t, diff.Attributes["delete_at"]) {
+		assert.Equal(t, "", diff.Attributes["delete_at"].New)
+	}
+
+	s, diags := r.Apply(context.Background(), state, diff, config)
+
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "remove", updateHeaders.Get("X-Remove-Delete-At"))
+	assert.Equal(t, "", updateHeaders.Get("X-Delete-At"))
+	assert.Equal(t, "", updateHeaders.Get("X-Delete-After"))
+	if assert.NotNil(t, s) {
+		assert.Equal(t, "", s.Attributes["delete_at"])
+	}
+}
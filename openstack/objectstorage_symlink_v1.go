@@ -0,0 +1,68 @@
+package openstack
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
+)
+
+// objectStorageSymlinkV1CreateOpts represents the attributes used when
+// creating a symlink object.
+type objectStorageSymlinkV1CreateOpts struct {
+	objects.CreateOpts
+	TargetContainer string
+	TargetObject    string
+	TargetAccount   string
+}
+
+// ToObjectCreateParams formats a objectStorageSymlinkV1CreateOpts into a query
+// string and map of headers.
+// It overrides objects.ToObjectCreateParams to add the symlink headers.
+func (opts objectStorageSymlinkV1CreateOpts) ToObjectCreateParams() (io.Reader, map[string]string, string, error) {
+	content, h, q, err := opts.CreateOpts.ToObjectCreateParams()
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	h["X-Symlink-Target"] = objectStorageSymlinkV1Target(opts.TargetContainer, opts.TargetObject)
+	if opts.TargetAccount != "" {
+		h["X-Symlink-Target-Account"] = url.PathEscape(opts.TargetAccount)
+	}
+
+	return content, h, q, nil
+}
+
+// objectStorageSymlinkV1GetOpts retrieves the symlink object itself instead
+// of its target.
+type objectStorageSymlinkV1GetOpts struct{}
+
+// ToObjectGetParams formats a objectStorageSymlinkV1GetOpts into a query
+// string and map of headers.
+func (opts objectStorageSymlinkV1GetOpts) ToObjectGetParams() (map[string]string, string, error) {
+	return map[string]string{}, "?symlink=get", nil
+}
+
+// objectStorageSymlinkV1Target returns the URL encoded X-Symlink-Target
+// header of a symlink.
+func objectStorageSymlinkV1Target(containerName, objectName string) string {
+	return url.PathEscape(containerName) + "/" + (&url.URL{Path: objectName}).EscapedPath()
+}
+
+// objectStorageSymlinkV1ParseTarget returns the target container and object
+// of a X-Symlink-Target header.
+func objectStorageSymlinkV1ParseTarget(target string) (string, string, error) {
+	unescaped, err := url.PathUnescape(target)
+	if err != nil {
+		return "", "", fmt.Errorf("Invalid OpenStack symlink target %s: %s", target, err)
+	}
+
+	parts := strings.SplitN(unescaped, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Invalid OpenStack symlink target: %s", target)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,30 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObjectStorageSymlinkV1Target(t *testing.T) {
+	assert.Equal(t, "container_1/releases/latest.tar.gz", objectStorageSymlinkV1Target("container_1", "releases/latest.tar.gz"))
+	assert.Equal(t, "container%201/my%20file", objectStorageSymlinkV1Target("container 1", "my file"))
+}
+
+func TestObjectStorageSymlinkV1ParseTarget(t *testing.T) {
+	containerName, objectName, err := objectStorageSymlinkV1ParseTarget("container_1/releases/latest.tar.gz")
+	assert.NoError(t, err)
+	assert.Equal(t, "container_1", containerName)
+	assert.Equal(t, "releases/latest.tar.gz", objectName)
+
+	containerName, objectName, err = objectStorageSymlinkV1ParseTarget("container%201/my%20file")
+	assert.NoError(t, err)
+	assert.Equal(t, "container 1", containerName)
+	assert.Equal(t, "my file", objectName)
+
+	_, _, err = objectStorageSymlinkV1ParseTarget("container_1")
+	assert.Error(t, err)
+
+	_, _, err = objectStorageSymlinkV1ParseTarget("container_1/")
+	assert.Error(t, err)
+}
@@ -0,0 +1,103 @@
+package openstack
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+	"hash"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/accounts"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/containers"
+)
+
+// objectStorageTempurlV1Digests lists the supported signature digests.
+var objectStorageTempurlV1Digests = map[string]func() hash.Hash{
+	"sha1":   sha1.New,
+	"sha256": sha256.New,
+	"sha512": sha512.New,
+}
+
+// objectStorageTempurlV1Opts represents the attributes used when signing a
+// temporary URL.
+type objectStorageTempurlV1Opts struct {
+	Method    string
+	TTL       int
+	Split     string
+	Digest    string
+	Timestamp time.Time
+}
+
+// objectStorageTempurlV1Key returns the temporary URL key of the container or,
+// when the container has no key, the key of the account.
+func objectStorageTempurlV1Key(client *gophercloud.ServiceClient, containerName string) (string, error) {
+	container, err := containers.Get(client, containerName, nil).Extract()
+	if err != nil {
+		return "", err
+	}
+	if container.TempURLKey != "" {
+		return container.TempURLKey, nil
+	}
+
+	account, err := accounts.Get(client, nil).Extract()
+	if err != nil {
+		return "", err
+	}
+	if account.TempURLKey == "" {
+		return "", fmt.Errorf("Neither the container %s nor the account has a Temp-URL-Key", containerName)
+	}
+
+	return account.TempURLKey, nil
+}
+
+// objectStorageTempurlV1Sign signs the object URL with the key. It returns
+// the temporary URL and its expiration time.
+func objectStorageTempurlV1Sign(objectURL, key string, opts objectStorageTempurlV1Opts) (string, time.Time, error) {
+	digestName := opts.Digest
+	if digestName == "" {
+		digestName = "sha1"
+	}
+
+	digest, ok := objectStorageTempurlV1Digests[digestName]
+	if !ok {
+		return "", time.Time{}, fmt.Errorf("Unsupported temporary url digest: %s", digestName)
+	}
+
+	split := opts.Split
+	if split == "" {
+		split = "/v1/"
+	}
+
+	parts := strings.SplitN(objectURL, split, 2)
+	if len(parts) != 2 {
+		return "", time.Time{}, fmt.Errorf("Unable to split the object url %s at %s", objectURL, split)
+	}
+	baseURL, objectPath := parts[0], split+parts[1]
+
+	timestamp := opts.Timestamp
+	if timestamp.IsZero() {
+		timestamp = time.Now().UTC()
+	}
+	expiry := timestamp.Add(time.Duration(opts.TTL) * time.Second).Unix()
+
+	mac := hmac.New(digest, []byte(key))
+	fmt.Fprintf(mac, "%s\n%d\n%s", strings.ToUpper(opts.Method), expiry, objectPath)
+	sig := fmt.Sprintf("%x", mac.Sum(nil))
+
+	return fmt.Sprintf("%s%s?temp_url_sig=%s&temp_url_expires=%d", baseURL, objectPath, sig, expiry), time.Unix(expiry, 0).UTC(), nil
+}
+
+// objectStorageTempurlV1Create signs a temporary URL for the object with the
+// temporary URL key of its container.
+func objectStorageTempurlV1Create(client *gophercloud.ServiceClient, containerName, objectName string, opts objectStorageTempurlV1Opts) (string, time.Time, error) {
+	key, err := objectStorageTempurlV1Key(client, containerName)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	return objectStorageTempurlV1Sign(client.ServiceURL(containerName, objectName), key, opts)
+}
@@ -0,0 +1,47 @@
+package openstack
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObjectStorageTempurlV1Sign(t *testing.T) {
+	objectURL := "https://swift.example.com/v1/AUTH_test/container/object/with/slashes"
+	opts := objectStorageTempurlV1Opts{
+		Method:    "get",
+		TTL:       60,
+		Timestamp: time.Unix(1700000000, 0),
+	}
+
+	url, expiresAt, err := objectStorageTempurlV1Sign(objectURL, "testkey", opts)
+	assert.NoError(t, err)
+	assert.Equal(t, objectURL+"?temp_url_sig=76b91d80d78b469796df89ff72e29309051f900c&temp_url_expires=1700000060", url)
+	assert.Equal(t, time.Unix(1700000060, 0).UTC(), expiresAt)
+
+	opts.Digest = "sha256"
+	url, _, err = objectStorageTempurlV1Sign(objectURL, "testkey", opts)
+	assert.NoError(t, err)
+	assert.Equal(t, objectURL+"?temp_url_sig=fe1ed680a97a7e00185e1151cb15059329e330c368149defc5059e9a6f0babea&temp_url_expires=1700000060", url)
+
+	opts.Digest = "md5"
+	_, _, err = objectStorageTempurlV1Sign(objectURL, "testkey", opts)
+	assert.Error(t, err)
+}
+
+func TestObjectStorageTempurlV1SignSplit(t *testing.T) {
+	opts := objectStorageTempurlV1Opts{
+		Method:    "post",
+		TTL:       60,
+		Split:     "/swift/v1/",
+		Timestamp: time.Unix(1700000000, 0),
+	}
+
+	url, _, err := objectStorageTempurlV1Sign("https://example.com/swift/v1/container/object", "testkey", opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://example.com/swift/v1/container/object?temp_url_sig=ff5350441d2187c44b5cece2ae9af1c99716ece0&temp_url_expires=1700000060", url)
+
+	_, _, err = objectStorageTempurlV1Sign("https://example.com/object/container/object", "testkey", opts)
+	assert.Error(t, err)
+}
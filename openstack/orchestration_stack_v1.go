@@ -2,13 +2,19 @@ package openstack
 
 import (
 	"fmt"
+	"io/ioutil"
 	"log"
+	"net/url"
+	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/yaml.v2"
 
 	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/orchestration/v1/stackevents"
 	"github.com/gophercloud/gophercloud/openstack/orchestration/v1/stacks"
 )
 
@@ -61,11 +67,404 @@ func orchestrationStackV1StateRefreshFunc(client *gophercloud.ServiceClient, sta
 		}
 
 		if strings.Contains(stack.Status, "FAILED") {
-			return stack, stack.Status, fmt.Errorf("The stack is in error status. " +
-				"Please check with your cloud admin or check the orchestration " +
-				"API logs to see why this error occurred.")
+			return stack, stack.Status, fmt.Errorf("The stack is in error status: %s. "+
+				"Please check with your cloud admin or check the orchestration "+
+				"API logs to see why this error occurred.", orchestrationStackV1StatusReason(client, stack))
 		}
 
 		return stack, stack.Status, nil
 	}
 }
+
+// orchestrationStackV1CreateOpts represents the attributes used when creating
+// a new stack.
+type orchestrationStackV1CreateOpts struct {
+	stacks.CreateOpts
+	Files map[string]string
+}
+
+// ToStackCreateMap casts a orchestrationStackV1CreateOpts struct to a map.
+// It overrides stacks.ToStackCreateMap to send the files section built by
+// orchestrationStackV1Files instead of fetching the referenced files again.
+func (opts orchestrationStackV1CreateOpts) ToStackCreateMap() (map[string]interface{}, error) {
+	b, err := gophercloud.BuildRequestBody(opts.CreateOpts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := orchestrationStackV1BuildRequest(b, opts.TemplateOpts, opts.EnvironmentOpts, opts.Files, opts.Tags); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// orchestrationStackV1UpdateOpts represents the attributes used when updating
+// an existing stack.
+type orchestrationStackV1UpdateOpts struct {
+	stacks.UpdateOpts
+	Files map[string]string
+}
+
+// ToStackUpdateMap casts a orchestrationStackV1UpdateOpts struct to a map.
+// It overrides stacks.ToStackUpdateMap to send the files section built by
+// orchestrationStackV1Files instead of fetching the referenced files again.
+func (opts orchestrationStackV1UpdateOpts) ToStackUpdateMap() (map[string]interface{}, error) {
+	if opts.TemplateOpts == nil {
+		return nil, stacks.ErrTemplateRequired{}
+	}
+
+	b, err := gophercloud.BuildRequestBody(opts.UpdateOpts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := orchestrationStackV1BuildRequest(b, opts.TemplateOpts, opts.EnvironmentOpts, opts.Files, opts.Tags); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+func orchestrationStackV1BuildRequest(b map[string]interface{}, template *stacks.Template, environment *stacks.Environment, files map[string]string, tags []string) error {
+	if _, err := orchestrationStackV1LoadTE(&template.TE); err != nil {
+		return err
+	}
+	b["template"] = string(template.Bin)
+
+	if environment != nil {
+		if _, err := orchestrationStackV1LoadTE(&environment.TE); err != nil {
+			return err
+		}
+		if environment.Bin != nil {
+			b["environment"] = string(environment.Bin)
+		}
+	}
+
+	if len(files) > 0 {
+		b["files"] = files
+	}
+
+	if tags != nil {
+		b["tags"] = strings.Join(tags, ",")
+	}
+
+	return nil
+}
+
+// orchestrationStackV1LoadTE loads the content of a template or environment
+// and returns the local directory its relative references are resolved from,
+// or an empty string if it was fetched from a remote URL.
+func orchestrationStackV1LoadTE(te *stacks.TE) (string, error) {
+	if te.Bin != nil {
+		return filepath.Abs(".")
+	}
+
+	if te.URL == "" {
+		return "", nil
+	}
+
+	path, ok, err := orchestrationStackV1LocalPath("", te.URL)
+	if err != nil {
+		return "", err
+	}
+
+	if !ok {
+		// Remote templates are fetched by gophercloud.
+		if err := te.Fetch(); err != nil {
+			return "", err
+		}
+		return "", nil
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("Error reading %s: %s", te.URL, err)
+	}
+	te.Bin = content
+
+	return filepath.Dir(path), nil
+}
+
+// orchestrationStackV1LocalPath returns the local path of a file reference
+// relative to dir, and false if the reference is a remote URL.
+func orchestrationStackV1LocalPath(dir, ref string) (string, bool, error) {
+	u, err := url.Parse(ref)
+	if err != nil {
+		return "", false, err
+	}
+
+	path := ref
+	switch u.Scheme {
+	case "":
+	case "file":
+		path = u.Path
+	default:
+		return "", false, nil
+	}
+
+	path = filepath.FromSlash(path)
+	if !filepath.IsAbs(path) {
+		if dir == "" {
+			if dir, err = filepath.Abs("."); err != nil {
+				return "", false, err
+			}
+		}
+		path = filepath.Join(dir, path)
+	}
+
+	return filepath.Clean(path), true, nil
+}
+
+// orchestrationStackV1FileResolver walks the file references of templates and
+// environments like python-heatclient, and collects the content of the local
+// files into the files section of a stack.
+type orchestrationStackV1FileResolver struct {
+	// files contains the content of the resolved references.
+	files map[string]string
+
+	// paths contains the local path of the resolved references.
+	paths map[string]string
+
+	// skip contains the references which are provided by the user.
+	skip map[string]string
+}
+
+// orchestrationStackV1Files returns the content of the local files referenced
+// by the template and environment, either with get_file or as a nested
+// template type. The references which are part of userFiles are not resolved.
+func orchestrationStackV1Files(template *stacks.Template, environment *stacks.Environment, userFiles map[string]string) (map[string]string, error) {
+	r := &orchestrationStackV1FileResolver{
+		files: make(map[string]string),
+		paths: make(map[string]string),
+		skip:  userFiles,
+	}
+
+	dir, err := orchestrationStackV1LoadTE(&template.TE)
+	if err != nil {
+		return nil, err
+	}
+	if dir != "" {
+		var parsed interface{}
+		if err := yaml.Unmarshal(template.Bin, &parsed); err != nil {
+			return nil, fmt.Errorf("Error parsing the template: %s", err)
+		}
+		if err := r.template(dir, parsed); err != nil {
+			return nil, err
+		}
+	}
+
+	if environment == nil {
+		return r.files, nil
+	}
+
+	dir, err = orchestrationStackV1LoadTE(&environment.TE)
+	if err != nil {
+		return nil, err
+	}
+	if dir != "" {
+		var parsed map[string]interface{}
+		if err := yaml.Unmarshal(environment.Bin, &parsed); err != nil {
+			return nil, fmt.Errorf("Error parsing the environment: %s", err)
+		}
+		if err := r.environment(dir, parsed["resource_registry"]); err != nil {
+			return nil, err
+		}
+	}
+
+	return r.files, nil
+}
+
+// template resolves the get_file and type references of a template.
+func (r *orchestrationStackV1FileResolver) template(dir string, v interface{}) error {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		for k, value := range v {
+			key, _ := k.(string)
+			if ref, ok := value.(string); ok {
+				if key == "get_file" || (key == "type" && orchestrationStackV1IsTemplateRef(ref)) {
+					if err := r.file(dir, ref); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+			if err := r.template(dir, value); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, value := range v {
+			if err := r.template(dir, value); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// environment resolves the nested templates of a resource registry.
+func (r *orchestrationStackV1FileResolver) environment(dir string, v interface{}) error {
+	registry, ok := v.(map[interface{}]interface{})
+	if !ok {
+		return nil
+	}
+
+	// The references relative to a base_url are fetched by Heat.
+	if _, ok := registry["base_url"]; ok {
+		return nil
+	}
+
+	for _, value := range registry {
+		switch value := value.(type) {
+		case string:
+			if orchestrationStackV1IsTemplateRef(value) {
+				if err := r.file(dir, value); err != nil {
+					return err
+				}
+			}
+		case map[interface{}]interface{}:
+			if err := r.environment(dir, value); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// file resolves a file reference and the references of the nested template
+// it contains.
+func (r *orchestrationStackV1FileResolver) file(dir, ref string) error {
+	if _, ok := r.skip[ref]; ok {
+		return nil
+	}
+
+	path, ok, err := orchestrationStackV1LocalPath(dir, ref)
+	if err != nil {
+		return fmt.Errorf("Invalid file reference %s: %s", ref, err)
+	}
+	if !ok {
+		// Remote files are fetched by Heat.
+		return nil
+	}
+
+	if existing, ok := r.paths[ref]; ok {
+		if existing != path {
+			return fmt.Errorf("The file reference %s points to both %s and %s", ref, existing, path)
+		}
+		return nil
+	}
+
+	log.Printf("[DEBUG] Reading openstack_orchestration_stack_v1 file %s from %s", ref, path)
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("Error reading %s: %s", ref, err)
+	}
+
+	r.paths[ref] = path
+	r.files[ref] = string(content)
+
+	// Only the nested templates are walked recursively.
+	var parsed map[interface{}]interface{}
+	if err := yaml.Unmarshal(content, &parsed); err != nil {
+		return nil
+	}
+	if _, ok := parsed["heat_template_version"]; !ok {
+		return nil
+	}
+
+	return r.template(filepath.Dir(path), parsed)
+}
+
+// orchestrationStackV1IsTemplateRef returns true if a resource type refers to
+// a nested template.
+func orchestrationStackV1IsTemplateRef(ref string) bool {
+	return strings.HasSuffix(ref, ".yaml") || strings.HasSuffix(ref, ".template")
+}
+
+const (
+	// orchestrationStackV1MaxFailedResources is the maximum number of failed
+	// resources reported in the status reason of a failed stack.
+	orchestrationStackV1MaxFailedResources = 5
+
+	// orchestrationStackV1MaxFailedReasonLength is the maximum length of the
+	// reported status reason of a failed resource.
+	orchestrationStackV1MaxFailedReasonLength = 256
+)
+
+// orchestrationStackV1StatusReason returns the status reason of a stack. The
+// status reason of a failed stack is extended with the last failure event of
+// each failed resource, since it usually doesn't contain any detail.
+func orchestrationStackV1StatusReason(client *gophercloud.ServiceClient, stack *stacks.RetrievedStack) string {
+	if !strings.HasSuffix(stack.Status, "FAILED") {
+		return stack.StatusReason
+	}
+
+	listOpts := stackevents.ListOpts{
+		ResourceStatuses: []stackevents.ResourceStatus{stackevents.ResourceStatusFailed},
+		SortKey:          stackevents.SortCreatedAt,
+		SortDir:          stackevents.SortAsc,
+	}
+
+	allPages, err := stackevents.List(client, stack.Name, stack.ID, listOpts).AllPages()
+	if err != nil {
+		log.Printf("[DEBUG] Unable to list openstack_orchestration_stack_v1 %s events: %s", stack.ID, err)
+		return stack.StatusReason
+	}
+
+	allEvents, err := stackevents.ExtractEvents(allPages)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to extract openstack_orchestration_stack_v1 %s events: %s", stack.ID, err)
+		return stack.StatusReason
+	}
+
+	// Only the events of the last stack action are reported.
+	since := stack.CreationTime
+	if !stack.UpdatedTime.IsZero() {
+		since = stack.UpdatedTime
+	}
+
+	failures := orchestrationStackV1FailedResources(stack.Name, allEvents, since)
+	if failures == "" {
+		return stack.StatusReason
+	}
+
+	return fmt.Sprintf("%s: %s", stack.StatusReason, failures)
+}
+
+// orchestrationStackV1FailedResources formats the last failure event of each
+// failed resource which occurred after since.
+func orchestrationStackV1FailedResources(stackName string, events []stackevents.Event, since time.Time) string {
+	var names []string
+	reasons := make(map[string]string)
+
+	for _, e := range events {
+		// The events of the stack itself only contain the summary.
+		if e.ResourceName == stackName || e.Time.Before(since) {
+			continue
+		}
+
+		if _, ok := reasons[e.ResourceName]; !ok {
+			names = append(names, e.ResourceName)
+		}
+
+		reason := e.ResourceStatusReason
+		if len(reason) > orchestrationStackV1MaxFailedReasonLength {
+			reason = reason[:orchestrationStackV1MaxFailedReasonLength] + "..."
+		}
+		reasons[e.ResourceName] = reason
+	}
+
+	failures := make([]string, 0, len(names))
+	for i, name := range names {
+		if i == orchestrationStackV1MaxFailedResources {
+			failures = append(failures, fmt.Sprintf("and %d more failed resources", len(names)-i))
+			break
+		}
+		failures = append(failures, fmt.Sprintf("%s (%s)", name, reasons[name]))
+	}
+
+	return strings.Join(failures, ", ")
+}
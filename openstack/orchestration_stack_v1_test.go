@@ -0,0 +1,197 @@
+package openstack
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gophercloud/gophercloud/openstack/orchestration/v1/stackevents"
+	"github.com/gophercloud/gophercloud/openstack/orchestration/v1/stacks"
+)
+
+const testOrchestrationStackV1Template = `heat_template_version: 2016-10-14
+resources:
+  server:
+    type: nested/server.yaml
+  config:
+    type: OS::Heat::SoftwareConfig
+    properties:
+      config: {get_file: scripts/init.sh}
+  remote:
+    type: https://example.com/remote.yaml
+  provided:
+    type: OS::Heat::SoftwareConfig
+    properties:
+      config: {get_file: provided.sh}
+`
+
+const testOrchestrationStackV1NestedTemplate = `heat_template_version: 2016-10-14
+resources:
+  config:
+    type: OS::Heat::SoftwareConfig
+    properties:
+      config: {get_file: server.sh}
+`
+
+const testOrchestrationStackV1Environment = `resource_registry:
+  My::Server: nested/server.yaml
+  resources:
+    volume:
+      My::Volume: volume.template
+`
+
+func testOrchestrationStackV1WriteFiles(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "tf_test_orchestration_stack")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+func TestOrchestrationStackV1Files(t *testing.T) {
+	dir := testOrchestrationStackV1WriteFiles(t, map[string]string{
+		"template.yaml":      testOrchestrationStackV1Template,
+		"environment.yaml":   testOrchestrationStackV1Environment,
+		"nested/server.yaml": testOrchestrationStackV1NestedTemplate,
+		"nested/server.sh":   "#!/bin/sh\n",
+		"scripts/init.sh":    "#!/bin/sh\necho init\n",
+		"volume.template":    "heat_template_version: 2016-10-14\n",
+	})
+	defer os.RemoveAll(dir)
+
+	template := &stacks.Template{
+		TE: stacks.TE{
+			URL: filepath.Join(dir, "template.yaml"),
+		},
+	}
+	environment := &stacks.Environment{
+		TE: stacks.TE{
+			URL: "file://" + filepath.ToSlash(filepath.Join(dir, "environment.yaml")),
+		},
+	}
+	userFiles := map[string]string{
+		"provided.sh": "#!/bin/sh\necho provided\n",
+	}
+
+	expected := map[string]string{
+		"nested/server.yaml": testOrchestrationStackV1NestedTemplate,
+		"server.sh":          "#!/bin/sh\n",
+		"scripts/init.sh":    "#!/bin/sh\necho init\n",
+		"volume.template":    "heat_template_version: 2016-10-14\n",
+	}
+
+	actual, err := orchestrationStackV1Files(template, environment, userFiles)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+	assert.Equal(t, testOrchestrationStackV1Template, string(template.Bin))
+}
+
+func TestOrchestrationStackV1FilesMissing(t *testing.T) {
+	dir := testOrchestrationStackV1WriteFiles(t, map[string]string{
+		"template.yaml": testOrchestrationStackV1Template,
+	})
+	defer os.RemoveAll(dir)
+
+	template := &stacks.Template{
+		TE: stacks.TE{
+			URL: filepath.Join(dir, "template.yaml"),
+		},
+	}
+
+	_, err := orchestrationStackV1Files(template, nil, nil)
+	assert.Error(t, err)
+}
+
+func TestOrchestrationStackV1FilesConflict(t *testing.T) {
+	dir := testOrchestrationStackV1WriteFiles(t, map[string]string{
+		"template.yaml":  "heat_template_version: 2016-10-14\nresources:\n  a:\n    type: nested/a.yaml\n  script:\n    type: OS::Heat::SoftwareConfig\n    properties:\n      config: {get_file: init.sh}\n",
+		"init.sh":        "#!/bin/sh\n",
+		"nested/a.yaml":  "heat_template_version: 2016-10-14\nresources:\n  script:\n    type: OS::Heat::SoftwareConfig\n    properties:\n      config: {get_file: init.sh}\n",
+		"nested/init.sh": "#!/bin/sh\necho nested\n",
+	})
+	defer os.RemoveAll(dir)
+
+	template := &stacks.Template{
+		TE: stacks.TE{
+			URL: filepath.Join(dir, "template.yaml"),
+		},
+	}
+
+	_, err := orchestrationStackV1Files(template, nil, nil)
+	assert.Error(t, err)
+}
+
+func TestOrchestrationStackV1LocalPath(t *testing.T) {
+	path, ok, err := orchestrationStackV1LocalPath("/templates", "nested/server.yaml")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, filepath.FromSlash("/templates/nested/server.yaml"), path)
+
+	path, ok, err = orchestrationStackV1LocalPath("/templates", "file:///other/server.yaml")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, filepath.FromSlash("/other/server.yaml"), path)
+
+	_, ok, err = orchestrationStackV1LocalPath("/templates", "https://example.com/server.yaml")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestOrchestrationStackV1FailedResources(t *testing.T) {
+	since := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	events := []stackevents.Event{
+		{
+			ResourceName:         "server",
+			ResourceStatusReason: "old failure",
+			Time:                 since.Add(-time.Minute),
+		},
+		{
+			ResourceName:         "server",
+			ResourceStatusReason: "ResourceInError: resources.server: Went to status ERROR",
+			Time:                 since.Add(time.Minute),
+		},
+		{
+			ResourceName:         "port",
+			ResourceStatusReason: strings.Repeat("a", 300),
+			Time:                 since.Add(2 * time.Minute),
+		},
+		{
+			ResourceName:         "stack_1",
+			ResourceStatusReason: "Resource CREATE failed",
+			Time:                 since.Add(3 * time.Minute),
+		},
+	}
+
+	expected := "server (ResourceInError: resources.server: Went to status ERROR), port (" + strings.Repeat("a", 256) + "...)"
+	assert.Equal(t, expected, orchestrationStackV1FailedResources("stack_1", events, since))
+
+	assert.Equal(t, "", orchestrationStackV1FailedResources("stack_1", events[3:], since))
+
+	events = nil
+	for i := 0; i < 7; i++ {
+		events = append(events, stackevents.Event{
+			ResourceName:         string(rune('a' + i)),
+			ResourceStatusReason: "failed",
+			Time:                 since,
+		})
+	}
+
+	expected = "a (failed), b (failed), c (failed), d (failed), e (failed), and 2 more failed resources"
+	assert.Equal(t, expected, orchestrationStackV1FailedResources("stack_1", events, since))
+}
@@ -3,12 +3,16 @@ package openstack
 import (
 	"context"
 	"os"
+	"regexp"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/meta"
 
+	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/utils/terraform/auth"
 	"github.com/gophercloud/utils/terraform/mutexkv"
 )
@@ -17,6 +21,18 @@ import (
 // Config struct.
 type Config struct {
 	auth.Config
+
+	SystemScope   bool
+	AuthCachePath string
+	DefaultTags   []string
+
+	ComputeAPIMicroversion string
+
+	authOpts      *gophercloud.AuthOptions
+	authenticated bool
+	authFailed    error
+
+	lookupCache providerLookupCache
 }
 
 // Provider returns a schema.Provider for OpenStack.
@@ -110,6 +126,72 @@ func Provider() *schema.Provider {
 				Description: descriptions["token"],
 			},
 
+			"auth_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_AUTH_TYPE", ""),
+				Description: descriptions["auth_type"],
+			},
+
+			"identity_provider": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_IDENTITY_PROVIDER", ""),
+				Description: descriptions["identity_provider"],
+			},
+
+			"protocol": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_PROTOCOL", ""),
+				Description: descriptions["protocol"],
+			},
+
+			"access_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_ACCESS_TOKEN", ""),
+				Description: descriptions["access_token"],
+			},
+
+			"client_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_CLIENT_ID", ""),
+				Description: descriptions["client_id"],
+			},
+
+			"client_secret": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_CLIENT_SECRET", ""),
+				Description: descriptions["client_secret"],
+			},
+
+			"discovery_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_DISCOVERY_ENDPOINT", ""),
+				Description: descriptions["discovery_endpoint"],
+			},
+
+			"passcode": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_PASSCODE", ""),
+				Description: descriptions["passcode"],
+			},
+
+			"system_scope": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: providerSystemScopeDefaultFunc,
+				Description: descriptions["system_scope"],
+			},
+
 			"user_domain_name": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -235,6 +317,53 @@ func Provider() *schema.Provider {
 				Description: descriptions["max_retries"],
 			},
 
+			"retry_backoff_max_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  descriptions["retry_backoff_max_seconds"],
+			},
+
+			"requests_per_second": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  descriptions["requests_per_second"],
+			},
+
+			"requests_burst": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  descriptions["requests_burst"],
+			},
+
+			"auth_cache_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_AUTH_CACHE_PATH", ""),
+				Description: descriptions["auth_cache_path"],
+			},
+
+			"default_tags": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions["default_tags"],
+			},
+
+			"compute_api_microversion": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^2\.\d+$`),
+					"must be a compute API microversion, e.g. 2.79"),
+				DefaultFunc: schema.EnvDefaultFunc("OS_COMPUTE_API_VERSION", ""),
+				Description: descriptions["compute_api_microversion"],
+			},
+
 			"endpoint_overrides": {
 				Type:        schema.TypeMap,
 				Optional:    true,
@@ -267,12 +396,17 @@ func Provider() *schema.Provider {
 			"openstack_compute_availability_zones_v2":            dataSourceComputeAvailabilityZonesV2(),
 			"openstack_compute_instance_v2":                      dataSourceComputeInstanceV2(),
 			"openstack_compute_flavor_v2":                        dataSourceComputeFlavorV2(),
+			"openstack_compute_flavors_v2":                       dataSourceComputeFlavorsV2(),
 			"openstack_compute_hypervisor_v2":                    dataSourceComputeHypervisorV2(),
 			"openstack_compute_keypair_v2":                       dataSourceComputeKeypairV2(),
 			"openstack_compute_quotaset_v2":                      dataSourceComputeQuotasetV2(),
 			"openstack_containerinfra_clustertemplate_v1":        dataSourceContainerInfraClusterTemplateV1(),
 			"openstack_containerinfra_cluster_v1":                dataSourceContainerInfraCluster(),
+			"openstack_db_datastore_v1":                          dataSourceDatabaseDatastoreV1(),
+			"openstack_db_datastore_version_v1":                  dataSourceDatabaseDatastoreVersionV1(),
+			"openstack_dns_recordset_v2":                         dataSourceDNSRecordSetV2(),
 			"openstack_dns_zone_v2":                              dataSourceDNSZoneV2(),
+			"openstack_dns_zone_shares_v2":                       dataSourceDNSZoneSharesV2(),
 			"openstack_fw_policy_v1":                             dataSourceFWPolicyV1(),
 			"openstack_identity_role_v3":                         dataSourceIdentityRoleV3(),
 			"openstack_identity_project_v3":                      dataSourceIdentityProjectV3(),
@@ -281,10 +415,14 @@ func Provider() *schema.Provider {
 			"openstack_identity_endpoint_v3":                     dataSourceIdentityEndpointV3(),
 			"openstack_identity_service_v3":                      dataSourceIdentityServiceV3(),
 			"openstack_identity_group_v3":                        dataSourceIdentityGroupV3(),
+			"openstack_identity_implied_roles_v3":                dataSourceIdentityImpliedRolesV3(),
+			"openstack_identity_ec2_credential_v3":               dataSourceIdentityEc2CredentialV3(),
 			"openstack_images_image_v2":                          dataSourceImagesImageV2(),
 			"openstack_images_image_ids_v2":                      dataSourceImagesImageIDsV2(),
+			"openstack_images_images_v2":                         dataSourceImagesImagesV2(),
 			"openstack_networking_addressscope_v2":               dataSourceNetworkingAddressScopeV2(),
 			"openstack_networking_network_v2":                    dataSourceNetworkingNetworkV2(),
+			"openstack_networking_networks_v2":                   dataSourceNetworkingNetworksV2(),
 			"openstack_networking_qos_bandwidth_limit_rule_v2":   dataSourceNetworkingQoSBandwidthLimitRuleV2(),
 			"openstack_networking_qos_dscp_marking_rule_v2":      dataSourceNetworkingQoSDSCPMarkingRuleV2(),
 			"openstack_networking_qos_minimum_bandwidth_rule_v2": dataSourceNetworkingQoSMinimumBandwidthRuleV2(),
@@ -299,12 +437,21 @@ func Provider() *schema.Provider {
 			"openstack_networking_port_v2":                       dataSourceNetworkingPortV2(),
 			"openstack_networking_port_ids_v2":                   dataSourceNetworkingPortIDsV2(),
 			"openstack_networking_trunk_v2":                      dataSourceNetworkingTrunkV2(),
+			"openstack_objectstorage_object_v1":                  dataSourceObjectStorageObjectV1(),
+			"openstack_objectstorage_tempurl_v1":                 dataSourceObjectStorageTempurlV1(),
 			"openstack_sharedfilesystem_availability_zones_v2":   dataSourceSharedFilesystemAvailabilityZonesV2(),
+			"openstack_sharedfilesystem_export_locations_v2":     dataSourceSharedFilesystemExportLocationsV2(),
 			"openstack_sharedfilesystem_sharenetwork_v2":         dataSourceSharedFilesystemShareNetworkV2(),
 			"openstack_sharedfilesystem_share_v2":                dataSourceSharedFilesystemShareV2(),
 			"openstack_sharedfilesystem_snapshot_v2":             dataSourceSharedFilesystemSnapshotV2(),
 			"openstack_keymanager_secret_v1":                     dataSourceKeyManagerSecretV1(),
 			"openstack_keymanager_container_v1":                  dataSourceKeyManagerContainerV1(),
+			"openstack_lb_loadbalancer_v2":                       dataSourceLoadBalancerV2(),
+			"openstack_lb_amphorae_v2":                           dataSourceLoadBalancerAmphoraeV2(),
+			"openstack_lb_flavorprofile_v2":                      dataSourceLoadBalancerFlavorProfileV2(),
+			"openstack_lb_flavor_v2":                             dataSourceLoadBalancerFlavorV2(),
+			"openstack_lb_availabilityzone_v2":                   dataSourceLoadBalancerAvailabilityZoneV2(),
+			"openstack_vpnaas_service_v2":                        dataSourceServiceV2(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -331,16 +478,24 @@ func Provider() *schema.Provider {
 			"openstack_compute_floatingip_v2":                    resourceComputeFloatingIPV2(),
 			"openstack_compute_floatingip_associate_v2":          resourceComputeFloatingIPAssociateV2(),
 			"openstack_compute_volume_attach_v2":                 resourceComputeVolumeAttachV2(),
+			"openstack_containerinfra_certificate_v1":            resourceContainerInfraCertificateV1(),
 			"openstack_containerinfra_clustertemplate_v1":        resourceContainerInfraClusterTemplateV1(),
 			"openstack_containerinfra_cluster_v1":                resourceContainerInfraClusterV1(),
+			"openstack_containerinfra_nodegroup_v1":              resourceContainerInfraNodeGroupV1(),
+			"openstack_containerinfra_quota_v1":                  resourceContainerInfraQuotaV1(),
 			"openstack_db_instance_v1":                           resourceDatabaseInstanceV1(),
 			"openstack_db_user_v1":                               resourceDatabaseUserV1(),
 			"openstack_db_configuration_v1":                      resourceDatabaseConfigurationV1(),
 			"openstack_db_database_v1":                           resourceDatabaseDatabaseV1(),
+			"openstack_db_backup_v1":                             resourceDatabaseBackupV1(),
 			"openstack_dns_recordset_v2":                         resourceDNSRecordSetV2(),
 			"openstack_dns_zone_v2":                              resourceDNSZoneV2(),
 			"openstack_dns_transfer_request_v2":                  resourceDNSTransferRequestV2(),
 			"openstack_dns_transfer_accept_v2":                   resourceDNSTransferAcceptV2(),
+			"openstack_dns_zone_share_v2":                        resourceDNSZoneShareV2(),
+			"openstack_dns_quota_v2":                             resourceDNSQuotaV2(),
+			"openstack_dns_ptr_record_v2":                        resourceDNSPTRRecordV2(),
+			"openstack_dns_tsigkey_v2":                           resourceDNSTSIGKeyV2(),
 			"openstack_fw_firewall_v1":                           resourceFWFirewallV1(),
 			"openstack_fw_policy_v1":                             resourceFWPolicyV1(),
 			"openstack_fw_rule_v1":                               resourceFWRuleV1(),
@@ -348,6 +503,7 @@ func Provider() *schema.Provider {
 			"openstack_identity_project_v3":                      resourceIdentityProjectV3(),
 			"openstack_identity_role_v3":                         resourceIdentityRoleV3(),
 			"openstack_identity_role_assignment_v3":              resourceIdentityRoleAssignmentV3(),
+			"openstack_identity_implied_role_v3":                 resourceIdentityImpliedRoleV3(),
 			"openstack_identity_service_v3":                      resourceIdentityServiceV3(),
 			"openstack_identity_user_v3":                         resourceIdentityUserV3(),
 			"openstack_identity_user_membership_v3":              resourceIdentityUserMembershipV3(),
@@ -370,6 +526,11 @@ func Provider() *schema.Provider {
 			"openstack_lb_l7policy_v2":                           resourceL7PolicyV2(),
 			"openstack_lb_l7rule_v2":                             resourceL7RuleV2(),
 			"openstack_lb_quota_v2":                              resourceLoadBalancerQuotaV2(),
+			"openstack_lb_flavorprofile_v2":                      resourceLoadBalancerFlavorProfileV2(),
+			"openstack_lb_flavor_v2":                             resourceLoadBalancerFlavorV2(),
+			"openstack_lb_availabilityzoneprofile_v2":            resourceLoadBalancerAvailabilityZoneProfileV2(),
+			"openstack_lb_availabilityzone_v2":                   resourceLoadBalancerAvailabilityZoneV2(),
+			"openstack_lb_amphora_failover_v2":                   resourceLoadBalancerAmphoraFailoverV2(),
 			"openstack_networking_floatingip_v2":                 resourceNetworkingFloatingIPV2(),
 			"openstack_networking_floatingip_associate_v2":       resourceNetworkingFloatingIPAssociateV2(),
 			"openstack_networking_network_v2":                    resourceNetworkingNetworkV2(),
@@ -392,8 +553,11 @@ func Provider() *schema.Provider {
 			"openstack_networking_addressscope_v2":               resourceNetworkingAddressScopeV2(),
 			"openstack_networking_trunk_v2":                      resourceNetworkingTrunkV2(),
 			"openstack_networking_portforwarding_v2":             resourceNetworkingPortForwardingV2(),
+			"openstack_objectstorage_account_v1":                 resourceObjectStorageAccountV1(),
 			"openstack_objectstorage_container_v1":               resourceObjectStorageContainerV1(),
 			"openstack_objectstorage_object_v1":                  resourceObjectStorageObjectV1(),
+			"openstack_objectstorage_object_tree_v1":             resourceObjectStorageObjectTreeV1(),
+			"openstack_objectstorage_symlink_v1":                 resourceObjectStorageSymlinkV1(),
 			"openstack_objectstorage_tempurl_v1":                 resourceObjectstorageTempurlV1(),
 			"openstack_orchestration_stack_v1":                   resourceOrchestrationStackV1(),
 			"openstack_vpnaas_ipsec_policy_v2":                   resourceIPSecPolicyV2(),
@@ -404,7 +568,13 @@ func Provider() *schema.Provider {
 			"openstack_sharedfilesystem_securityservice_v2":      resourceSharedFilesystemSecurityServiceV2(),
 			"openstack_sharedfilesystem_sharenetwork_v2":         resourceSharedFilesystemShareNetworkV2(),
 			"openstack_sharedfilesystem_share_v2":                resourceSharedFilesystemShareV2(),
+			"openstack_sharedfilesystem_share_replica_v2":        resourceSharedFilesystemShareReplicaV2(),
+			"openstack_sharedfilesystem_share_group_v2":          resourceSharedFilesystemShareGroupV2(),
+			"openstack_sharedfilesystem_share_group_snapshot_v2": resourceSharedFilesystemShareGroupSnapshotV2(),
 			"openstack_sharedfilesystem_share_access_v2":         resourceSharedFilesystemShareAccessV2(),
+			"openstack_sharedfilesystem_sharetype_v2":            resourceSharedFilesystemShareTypeV2(),
+			"openstack_sharedfilesystem_sharetype_access_v2":     resourceSharedFilesystemShareTypeAccessV2(),
+			"openstack_sharedfilesystem_quota_v2":                resourceSharedFilesystemQuotaV2(),
 			"openstack_keymanager_secret_v1":                     resourceKeyManagerSecretV1(),
 			"openstack_keymanager_container_v1":                  resourceKeyManagerContainerV1(),
 			"openstack_keymanager_order_v1":                      resourceKeyManagerOrderV1(),
@@ -452,8 +622,27 @@ func init() {
 
 		"password": "Password to login with.",
 
+		"passcode": "TOTP passcode to use for multi-factor authentication along with the password.",
+
 		"token": "Authentication token to use as an alternative to username/password.",
 
+		"auth_type": "The authentication method to use. Set to `v3oidcaccesstoken` or\n" +
+			"`v3oidcpassword` to authenticate through OpenID Connect federation.",
+
+		"identity_provider": "The name of the Identity v3 identity provider to authenticate with.",
+
+		"protocol": "The name of the Identity v3 federation protocol, e.g. `openid`.",
+
+		"access_token": "The OpenID Connect access token to authenticate with.",
+
+		"client_id": "The OpenID Connect client ID.",
+
+		"client_secret": "The OpenID Connect client secret.",
+
+		"discovery_endpoint": "The OpenID Connect discovery endpoint of the identity provider.",
+
+		"system_scope": "If set to `true`, a system-scoped token is requested instead of a project-scoped one.",
+
 		"user_domain_name": "The name of the domain where the user resides (Identity v3).",
 
 		"user_domain_id": "The ID of the domain where the user resides (Identity v3).",
@@ -478,6 +667,12 @@ func init() {
 
 		"endpoint_type": "The catalog endpoint type to use.",
 
+		"auth_cache_path": "The path of a file to cache tokens in across Terraform runs.",
+
+		"default_tags": "A set of tags to add to all resources, which support tags.",
+
+		"compute_api_microversion": "The compute API microversion to use for all requests.",
+
 		"endpoint_overrides": "A map of services with an endpoint to override what was\n" +
 			"from the Keystone catalog",
 
@@ -497,6 +692,15 @@ func init() {
 
 		"max_retries": "How many times HTTP connection should be retried until giving up.",
 
+		"retry_backoff_max_seconds": "The maximum delay in seconds between retries of\n" +
+			"rate limited or unavailable API requests.",
+
+		"requests_per_second": "The maximum number of API requests per second. Defaults to `0`,\n" +
+			"which disables the rate limit.",
+
+		"requests_burst": "The maximum number of API requests, which are sent at once\n" +
+			"before requests_per_second applies.",
+
 		"enable_logging": "Outputs very verbose logs with all calls made to and responses from OpenStack",
 	}
 }
@@ -513,7 +717,7 @@ func configureProvider(d *schema.ResourceData, terraformVersion string) (interfa
 	}
 
 	config := Config{
-		auth.Config{
+		Config: auth.Config{
 			CACertFile:                  d.Get("cacert_file").(string),
 			ClientCertFile:              d.Get("cert").(string),
 			ClientKeyFile:               d.Get("key").(string),
@@ -549,6 +753,11 @@ func configureProvider(d *schema.ResourceData, terraformVersion string) (interfa
 			MutexKV:                     mutexkv.NewMutexKV(),
 			EnableLogger:                enableLogging,
 		},
+		SystemScope:   d.Get("system_scope").(bool),
+		AuthCachePath: d.Get("auth_cache_path").(string),
+		DefaultTags:   expandToStringSlice(d.Get("default_tags").(*schema.Set).List()),
+
+		ComputeAPIMicroversion: d.Get("compute_api_microversion").(string),
 	}
 
 	v, ok := d.GetOkExists("insecure")
@@ -557,9 +766,110 @@ func configureProvider(d *schema.ResourceData, terraformVersion string) (interfa
 		config.Insecure = &insecure
 	}
 
+	if authType := d.Get("auth_type").(string); providerIsOIDCAuthType(authType) {
+		client, err := providerAuthHTTPClient(config.CACertFile, config.ClientCertFile, config.ClientKeyFile, config.Insecure)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		token, err := providerOIDCToken(client, providerOIDCConfig{
+			AuthType:          authType,
+			AuthURL:           config.IdentityEndpoint,
+			IdentityProvider:  d.Get("identity_provider").(string),
+			Protocol:          d.Get("protocol").(string),
+			AccessToken:       d.Get("access_token").(string),
+			ClientID:          d.Get("client_id").(string),
+			ClientSecret:      d.Get("client_secret").(string),
+			DiscoveryEndpoint: d.Get("discovery_endpoint").(string),
+			Username:          config.Username,
+			Password:          config.Password,
+		})
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		// The unscoped federated token is rescoped by the token auth.
+		config.Token = token
+		config.Username = ""
+		config.UserID = ""
+		config.Password = ""
+	} else if passcode := d.Get("passcode").(string); passcode != "" {
+		client, err := providerAuthHTTPClient(config.CACertFile, config.ClientCertFile, config.ClientKeyFile, config.Insecure)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		totpConfig := providerTOTPConfig{
+			AuthURL:        config.IdentityEndpoint,
+			UserID:         config.UserID,
+			Username:       config.Username,
+			UserDomainID:   config.UserDomainID,
+			UserDomainName: config.UserDomainName,
+			Password:       config.Password,
+			Passcode:       passcode,
+		}
+		if totpConfig.UserDomainID == "" && totpConfig.UserDomainName == "" {
+			totpConfig.UserDomainID = config.DomainID
+			totpConfig.UserDomainName = config.DomainName
+		}
+		if totpConfig.UserDomainID == "" && totpConfig.UserDomainName == "" {
+			totpConfig.UserDomainID = config.DefaultDomain
+		}
+
+		// Passcodes expire, so the token is obtained only once and reused
+		// for the rest of the run.
+		token, err := providerTOTPToken(client, totpConfig)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.Token = token
+		config.Username = ""
+		config.UserID = ""
+		config.Password = ""
+	}
+
+	if config.SystemScope {
+		if err := config.validateSystemScope(); err != nil {
+			return nil, diag.FromErr(err)
+		}
+	}
+
+	// The provider client is authenticated by Config.Authenticate, which
+	// reuses cached tokens, so LoadAndValidate must not authenticate it.
+	delayedAuth := config.DelayedAuth
+	config.DelayedAuth = true
+
 	if err := config.LoadAndValidate(); err != nil {
 		return nil, diag.FromErr(err)
 	}
 
+	config.DelayedAuth = false
+
+	providerSetupLogging(&config.OsClient.HTTPClient)
+	providerSetupRateLimit(&config.OsClient.HTTPClient,
+		d.Get("requests_per_second").(float64), d.Get("requests_burst").(int))
+
+	if config.MaxRetries > 0 {
+		// 429 responses are retried with a backoff by the RetryFunc as well.
+		config.OsClient.RetryBackoffFunc = nil
+		config.OsClient.RetryFunc = providerRetryFunc(uint(config.MaxRetries),
+			time.Duration(d.Get("retry_backoff_max_seconds").(int))*time.Second)
+	}
+
+	if !config.Swauth {
+		ao, err := config.authOptions()
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		config.authOpts = ao
+
+		if !delayedAuth {
+			if err := config.Authenticate(); err != nil {
+				return nil, diag.FromErr(err)
+			}
+		}
+	}
+
 	return &config, nil
 }
@@ -0,0 +1,123 @@
+package openstack
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/utils/openstack/clientconfig"
+)
+
+// authOptions builds the auth options from the provider settings the same way
+// as LoadAndValidate does.
+func (c *Config) authOptions() (*gophercloud.AuthOptions, error) {
+	clientOpts := new(clientconfig.ClientOpts)
+	if c.Cloud != "" {
+		clientOpts.Cloud = c.Cloud
+		clientOpts.RegionName = c.Region
+	} else {
+		clientOpts.AuthInfo = &clientconfig.AuthInfo{
+			AuthURL:                     c.IdentityEndpoint,
+			DefaultDomain:               c.DefaultDomain,
+			DomainID:                    c.DomainID,
+			DomainName:                  c.DomainName,
+			Password:                    c.Password,
+			ProjectDomainID:             c.ProjectDomainID,
+			ProjectDomainName:           c.ProjectDomainName,
+			ProjectID:                   c.TenantID,
+			ProjectName:                 c.TenantName,
+			Token:                       c.Token,
+			UserDomainID:                c.UserDomainID,
+			UserDomainName:              c.UserDomainName,
+			Username:                    c.Username,
+			UserID:                      c.UserID,
+			ApplicationCredentialID:     c.ApplicationCredentialID,
+			ApplicationCredentialName:   c.ApplicationCredentialName,
+			ApplicationCredentialSecret: c.ApplicationCredentialSecret,
+		}
+	}
+
+	ao, err := clientconfig.AuthOptions(clientOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.SystemScope {
+		ao.Scope = &gophercloud.AuthScope{System: true}
+	}
+	ao.AllowReauth = c.AllowReauth
+
+	return ao, nil
+}
+
+// Authenticate authenticates the provider client once. It replaces the auth
+// of the embedded config to reuse cached tokens.
+func (c *Config) Authenticate() error {
+	if c.authOpts == nil {
+		return nil
+	}
+
+	c.MutexKV.Lock("auth")
+	defer c.MutexKV.Unlock("auth")
+
+	if c.authFailed != nil {
+		return c.authFailed
+	}
+
+	if !c.authenticated {
+		if err := providerAuthCacheAuthenticate(c.OsClient, *c.authOpts, c.AuthCachePath); err != nil {
+			c.authFailed = err
+			return err
+		}
+		c.authenticated = true
+	}
+
+	return nil
+}
+
+// providerAuthHTTPClient returns an HTTP client, which respects the TLS
+// settings of the provider.
+func providerAuthHTTPClient(caFile, certFile, keyFile string, insecure *bool) (*http.Client, error) {
+	config := &tls.Config{}
+
+	if caFile != "" {
+		ca, err := ioutil.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading CA cert file %s: %s", caFile, err)
+		}
+		caPool := x509.NewCertPool()
+		caPool.AppendCertsFromPEM(ca)
+		config.RootCAs = caPool
+	}
+
+	if insecure != nil {
+		config.InsecureSkipVerify = *insecure
+	}
+
+	if certFile != "" && keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("Error loading client cert %s: %s", certFile, err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	return &http.Client{
+		Transport: &http.Transport{Proxy: http.ProxyFromEnvironment, TLSClientConfig: config},
+	}, nil
+}
+
+// providerIdentityV3URL returns the Identity v3 endpoint for an auth_url,
+// which may or may not contain the version suffix.
+func providerIdentityV3URL(authURL string) string {
+	authURL = strings.TrimSuffix(authURL, "/")
+	if !strings.HasSuffix(authURL, "/v3") {
+		authURL += "/v3"
+	}
+
+	return authURL
+}
@@ -0,0 +1,351 @@
+package openstack
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+	tokens3 "github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+)
+
+// providerAuthCacheExpiryMargin is the minimum remaining lifetime of a cached
+// token to be reused.
+const providerAuthCacheExpiryMargin = 5 * time.Minute
+
+// providerAuthCacheEntry represents a cached Identity v3 token along with the
+// token body, which contains the service catalog.
+type providerAuthCacheEntry struct {
+	TokenID   string      `json:"token_id"`
+	ExpiresAt time.Time   `json:"expires_at"`
+	Body      interface{} `json:"body"`
+}
+
+func (e providerAuthCacheEntry) valid() bool {
+	return e.TokenID != "" && e.ExpiresAt.After(time.Now().Add(providerAuthCacheExpiryMargin))
+}
+
+// apply sets the cached token and the endpoint locator of the catalog on the
+// provider client.
+func (e providerAuthCacheEntry) apply(client *gophercloud.ProviderClient) error {
+	var result tokens3.CreateResult
+	result.Body = e.Body
+	result.Header = http.Header{"X-Subject-Token": []string{e.TokenID}}
+
+	catalog, err := result.ExtractServiceCatalog()
+	if err != nil {
+		return err
+	}
+
+	if err := client.SetTokenAndAuthResult(result); err != nil {
+		return err
+	}
+
+	client.EndpointLocator = func(opts gophercloud.EndpointOpts) (string, error) {
+		return openstack.V3EndpointURL(catalog, opts)
+	}
+
+	return nil
+}
+
+// providerAuthCache holds the tokens of all provider configurations of the
+// process, so provider aliases with identical credentials authenticate once.
+var providerAuthCache = struct {
+	sync.Mutex
+	entries map[string]providerAuthCacheEntry
+}{
+	entries: make(map[string]providerAuthCacheEntry),
+}
+
+// providerAuthCacheKey returns an HMAC of all auth options, which affect the
+// issued token. The secret keeps the credentials from being guessed from the
+// keys of the cache file.
+func providerAuthCacheKey(ao gophercloud.AuthOptions, secret []byte) string {
+	params := []string{
+		ao.IdentityEndpoint,
+		ao.Username,
+		ao.UserID,
+		ao.Password,
+		ao.Passcode,
+		ao.DomainID,
+		ao.DomainName,
+		ao.TenantID,
+		ao.TenantName,
+		ao.TokenID,
+		ao.ApplicationCredentialID,
+		ao.ApplicationCredentialName,
+		ao.ApplicationCredentialSecret,
+	}
+
+	if ao.Scope != nil {
+		params = append(params,
+			ao.Scope.ProjectID,
+			ao.Scope.ProjectName,
+			ao.Scope.DomainID,
+			ao.Scope.DomainName,
+			strconv.FormatBool(ao.Scope.System),
+		)
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(strings.Join(params, "\x00")))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// providerAuthCacheProcessSecret is the secret of the cache keys, when there
+// is no cache file or its secret can't be used.
+var providerAuthCacheProcessSecret struct {
+	sync.Once
+	secret []byte
+}
+
+// providerAuthCacheSecret returns the secret of the cache keys. The secret of
+// a cache file is stored next to it with permissions for its owner only, so
+// that the keys are stable across Terraform runs on the same machine.
+func providerAuthCacheSecret(path string) []byte {
+	if path != "" {
+		secret, err := providerAuthCacheFileSecret(path + ".key")
+		if err == nil {
+			return secret
+		}
+		log.Printf("[DEBUG] Unable to use OpenStack auth cache secret %s.key: %s", path, err)
+	}
+
+	providerAuthCacheProcessSecret.Do(func() {
+		providerAuthCacheProcessSecret.secret = make([]byte, 32)
+		if _, err := rand.Read(providerAuthCacheProcessSecret.secret); err != nil {
+			log.Printf("[DEBUG] Unable to generate OpenStack auth cache secret: %s", err)
+		}
+	})
+
+	return providerAuthCacheProcessSecret.secret
+}
+
+// providerAuthCacheFileSecret reads the secret file or creates it with a new
+// random secret, if it doesn't exist.
+func providerAuthCacheFileSecret(path string) ([]byte, error) {
+	secret, err := ioutil.ReadFile(path)
+	if err == nil && len(secret) >= 32 {
+		return secret, nil
+	}
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	secret = make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		// Another process may have created the secret in the meantime.
+		if os.IsExist(err) {
+			if secret, err := ioutil.ReadFile(path); err == nil && len(secret) >= 32 {
+				return secret, nil
+			}
+		}
+		return nil, err
+	}
+
+	_, err = f.Write(secret)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(path)
+		return nil, err
+	}
+
+	return secret, nil
+}
+
+func providerAuthCacheGet(key, path string) (providerAuthCacheEntry, bool) {
+	providerAuthCache.Lock()
+	defer providerAuthCache.Unlock()
+
+	if entry, ok := providerAuthCache.entries[key]; ok && entry.valid() {
+		return entry, true
+	}
+
+	if path == "" {
+		return providerAuthCacheEntry{}, false
+	}
+
+	if entry, ok := providerAuthCacheReadFile(path)[key]; ok && entry.valid() {
+		providerAuthCache.entries[key] = entry
+		return entry, true
+	}
+
+	return providerAuthCacheEntry{}, false
+}
+
+// providerAuthCachePut caches the Identity v3 token of an auth result. Other
+// auth results are not cached.
+func providerAuthCachePut(key, path string, authResult gophercloud.AuthResult) {
+	result, ok := authResult.(tokens3.CreateResult)
+	if !ok {
+		return
+	}
+
+	token, err := result.ExtractToken()
+	if err != nil {
+		log.Printf("[DEBUG] Unable to cache OpenStack token: %s", err)
+		return
+	}
+
+	entry := providerAuthCacheEntry{
+		TokenID:   token.ID,
+		ExpiresAt: token.ExpiresAt,
+		Body:      result.Body,
+	}
+
+	providerAuthCache.Lock()
+	defer providerAuthCache.Unlock()
+
+	providerAuthCache.entries[key] = entry
+
+	if path != "" {
+		entries := providerAuthCacheReadFile(path)
+		entries[key] = entry
+		providerAuthCacheWriteFile(path, entries)
+	}
+}
+
+func providerAuthCacheDelete(key, path string) {
+	providerAuthCache.Lock()
+	defer providerAuthCache.Unlock()
+
+	delete(providerAuthCache.entries, key)
+
+	if path != "" {
+		entries := providerAuthCacheReadFile(path)
+		if _, ok := entries[key]; ok {
+			delete(entries, key)
+			providerAuthCacheWriteFile(path, entries)
+		}
+	}
+}
+
+func providerAuthCacheReadFile(path string) map[string]providerAuthCacheEntry {
+	entries := make(map[string]providerAuthCacheEntry)
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Printf("[DEBUG] Unable to read OpenStack auth cache %s: %s", path, err)
+		}
+		return entries
+	}
+
+	if err := json.Unmarshal(data, &entries); err != nil {
+		log.Printf("[DEBUG] Unable to parse OpenStack auth cache %s: %s", path, err)
+		return make(map[string]providerAuthCacheEntry)
+	}
+
+	return entries
+}
+
+// providerAuthCacheWriteFile writes the unexpired entries to the cache file.
+// The file is only readable by its owner, since it contains tokens.
+func providerAuthCacheWriteFile(path string, entries map[string]providerAuthCacheEntry) {
+	for key, entry := range entries {
+		if !entry.valid() {
+			delete(entries, key)
+		}
+	}
+
+	data, err := json.Marshal(entries)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to marshal OpenStack auth cache: %s", err)
+		return
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		log.Printf("[DEBUG] Unable to write OpenStack auth cache %s: %s", path, err)
+		return
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		log.Printf("[DEBUG] Unable to write OpenStack auth cache %s: %s", path, err)
+	}
+}
+
+// providerAuthCacheAuthenticate authenticates the provider client with a
+// cached token, if there is one, and otherwise requests and caches a new one.
+// When reauth is allowed, a 401 response invalidates the cached token and
+// triggers a single reauth and retry of the request.
+func providerAuthCacheAuthenticate(client *gophercloud.ProviderClient, ao gophercloud.AuthOptions, path string) error {
+	allowReauth := ao.AllowReauth
+	ao.AllowReauth = false
+	key := providerAuthCacheKey(ao, providerAuthCacheSecret(path))
+
+	entry, ok := providerAuthCacheGet(key, path)
+	if ok {
+		if err := entry.apply(client); err != nil {
+			log.Printf("[DEBUG] Unable to use cached OpenStack token: %s", err)
+			ok = false
+		}
+	}
+
+	if !ok {
+		if err := openstack.Authenticate(client, ao); err != nil {
+			return err
+		}
+		providerAuthCachePut(key, path, client.GetAuthResult())
+	}
+
+	if allowReauth {
+		client.ReauthFunc = providerAuthCacheReauthFunc(client, ao, key, path)
+	}
+
+	return nil
+}
+
+func providerAuthCacheReauthFunc(client *gophercloud.ProviderClient, ao gophercloud.AuthOptions, key, path string) func() error {
+	return func() error {
+		providerAuthCacheDelete(key, path)
+
+		// Authenticate a throwaway client, so the reauth request itself is
+		// not reauthenticated.
+		tac, err := openstack.NewClient(ao.IdentityEndpoint)
+		if err != nil {
+			return err
+		}
+		tac.HTTPClient = client.HTTPClient
+		tac.UserAgent = client.UserAgent
+		tac.Context = client.Context
+		tac.SetThrowaway(true)
+
+		if err := openstack.Authenticate(tac, ao); err != nil {
+			return err
+		}
+
+		client.CopyTokenFrom(tac)
+		providerAuthCachePut(key, path, tac.GetAuthResult())
+
+		return nil
+	}
+}
@@ -0,0 +1,181 @@
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+)
+
+func testProviderAuthCacheReset() {
+	providerAuthCache.Lock()
+	defer providerAuthCache.Unlock()
+	providerAuthCache.entries = make(map[string]providerAuthCacheEntry)
+}
+
+type testProviderAuthCacheServer struct {
+	*httptest.Server
+	tokens     int
+	validToken string
+}
+
+func newTestProviderAuthCacheServer(t *testing.T) *testProviderAuthCacheServer {
+	s := &testProviderAuthCacheServer{}
+	mux := http.NewServeMux()
+	s.Server = httptest.NewServer(mux)
+
+	mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		s.tokens++
+		s.validToken = fmt.Sprintf("token-%d", s.tokens)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Subject-Token", s.validToken)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"token": {"expires_at": "2099-01-01T00:00:00.000000Z", "catalog": [
+			{"type": "compute", "name": "nova", "endpoints": [
+				{"interface": "public", "region": "RegionOne", "region_id": "RegionOne", "url": "%s/compute/"}
+			]}
+		]}}`, s.URL)
+	})
+
+	mux.HandleFunc("/compute/servers", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Auth-Token") != s.validToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	return s
+}
+
+func (s *testProviderAuthCacheServer) authOptions() gophercloud.AuthOptions {
+	return gophercloud.AuthOptions{
+		IdentityEndpoint: s.URL + "/v3/",
+		Username:         "admin",
+		Password:         "secret",
+		DomainID:         "default",
+		TenantName:       "admin",
+		AllowReauth:      true,
+	}
+}
+
+func testProviderAuthCacheClient(t *testing.T, s *testProviderAuthCacheServer, path string) *gophercloud.ProviderClient {
+	client, err := openstack.NewClient(s.URL + "/v3/")
+	assert.NoError(t, err)
+	assert.NoError(t, providerAuthCacheAuthenticate(client, s.authOptions(), path))
+
+	return client
+}
+
+func TestUnitProviderAuthCacheKey(t *testing.T) {
+	ao := gophercloud.AuthOptions{
+		IdentityEndpoint: "https://keystone.example.com/v3",
+		Username:         "admin",
+		Password:         "secret",
+	}
+	secret := []byte("0123456789abcdef0123456789abcdef")
+	key := providerAuthCacheKey(ao, secret)
+
+	ao.AllowReauth = true
+	assert.Equal(t, key, providerAuthCacheKey(ao, secret))
+
+	ao.Password = "other"
+	assert.NotEqual(t, key, providerAuthCacheKey(ao, secret))
+
+	ao.Password = "secret"
+	ao.Scope = &gophercloud.AuthScope{System: true}
+	assert.NotEqual(t, key, providerAuthCacheKey(ao, secret))
+
+	ao.Scope = nil
+	assert.NotEqual(t, key, providerAuthCacheKey(ao, []byte("fedcba9876543210fedcba9876543210")))
+}
+
+func TestUnitProviderAuthCacheSecret(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "auth_cache.json")
+
+	secret := providerAuthCacheSecret(path)
+	assert.Len(t, secret, 32)
+	assert.Equal(t, secret, providerAuthCacheSecret(path))
+
+	info, err := os.Stat(path + ".key")
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	// The in-memory cache uses a secret of the process.
+	assert.Len(t, providerAuthCacheSecret(""), 32)
+	assert.Equal(t, providerAuthCacheSecret(""), providerAuthCacheSecret(""))
+	assert.NotEqual(t, secret, providerAuthCacheSecret(""))
+}
+
+func TestUnitProviderAuthCacheMemory(t *testing.T) {
+	testProviderAuthCacheReset()
+	defer testProviderAuthCacheReset()
+
+	s := newTestProviderAuthCacheServer(t)
+	defer s.Close()
+
+	client1 := testProviderAuthCacheClient(t, s, "")
+	client2 := testProviderAuthCacheClient(t, s, "")
+	assert.Equal(t, 1, s.tokens)
+	assert.Equal(t, "token-1", client2.Token())
+
+	// The catalog of the cached token is used by the second client.
+	compute, err := openstack.NewComputeV2(client2, gophercloud.EndpointOpts{Region: "RegionOne"})
+	assert.NoError(t, err)
+	assert.Equal(t, s.URL+"/compute/", compute.Endpoint)
+
+	tokenID, err := client2.GetAuthResult().ExtractTokenID()
+	assert.NoError(t, err)
+	assert.Equal(t, client1.Token(), tokenID)
+}
+
+func TestUnitProviderAuthCacheFile(t *testing.T) {
+	testProviderAuthCacheReset()
+	defer testProviderAuthCacheReset()
+
+	s := newTestProviderAuthCacheServer(t)
+	defer s.Close()
+
+	path := filepath.Join(t.TempDir(), "auth_cache.json")
+	testProviderAuthCacheClient(t, s, path)
+
+	// A new process only has the file cache.
+	testProviderAuthCacheReset()
+	client := testProviderAuthCacheClient(t, s, path)
+	assert.Equal(t, 1, s.tokens)
+	assert.Equal(t, "token-1", client.Token())
+}
+
+func TestUnitProviderAuthCacheReauth(t *testing.T) {
+	testProviderAuthCacheReset()
+	defer testProviderAuthCacheReset()
+
+	s := newTestProviderAuthCacheServer(t)
+	defer s.Close()
+
+	client := testProviderAuthCacheClient(t, s, "")
+
+	// Revoke the cached token.
+	s.validToken = "revoked"
+
+	compute, err := openstack.NewComputeV2(client, gophercloud.EndpointOpts{Region: "RegionOne"})
+	assert.NoError(t, err)
+	_, err = compute.Get(compute.ServiceURL("servers"), nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, s.tokens)
+	assert.Equal(t, "token-2", client.Token())
+
+	// The cache holds the new token.
+	client = testProviderAuthCacheClient(t, s, "")
+	assert.Equal(t, 2, s.tokens)
+	assert.Equal(t, "token-2", client.Token())
+}
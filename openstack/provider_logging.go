@@ -0,0 +1,86 @@
+package openstack
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	osClient "github.com/gophercloud/utils/client"
+)
+
+// providerLogSensitiveFields lists the JSON fields, which values are masked in
+// the debug log of requests and responses. The field names are compared case
+// insensitively on any nesting level.
+var providerLogSensitiveFields = []string{
+	"adminPass",
+	"passcode",
+	"password",
+	"payload",
+	"private_key",
+	"psk",
+	"secret",
+	"user_data",
+}
+
+// providerLogSensitiveHeaders lists the headers, which are masked in the debug
+// log in addition to the gophercloud defaults, e.g. X-Auth-Token and
+// X-Subject-Token.
+var providerLogSensitiveHeaders = []string{
+	"openstack-auth-receipt",
+}
+
+// providerSetupLogging configures the logging RoundTripper of the HTTP client
+// to mask sensitive data.
+func providerSetupLogging(client *http.Client) {
+	rt, ok := client.Transport.(*osClient.RoundTripper)
+	if !ok {
+		return
+	}
+
+	rt.FormatJSON = providerLogFormatJSON(providerLogSensitiveFields...)
+	rt.SetSensitiveHeaders(append(osClient.GetDefaultSensitiveHeaders(), providerLogSensitiveHeaders...))
+}
+
+// providerLogFormatJSON returns a function to pretty-format a JSON body,
+// which masks the values of the given fields.
+func providerLogFormatJSON(fields ...string) func([]byte) (string, error) {
+	sensitiveFields := make(map[string]struct{}, len(fields))
+	for _, field := range fields {
+		sensitiveFields[strings.ToLower(field)] = struct{}{}
+	}
+
+	return func(raw []byte) (string, error) {
+		var data interface{}
+		if err := json.Unmarshal(raw, &data); err != nil {
+			return osClient.FormatJSON(raw)
+		}
+
+		providerLogMaskFields(data, sensitiveFields)
+
+		masked, err := json.Marshal(data)
+		if err != nil {
+			return osClient.FormatJSON(raw)
+		}
+
+		// The default formatter masks the auth and EC2 credentials and
+		// omits the catalog.
+		return osClient.FormatJSON(masked)
+	}
+}
+
+func providerLogMaskFields(data interface{}, sensitiveFields map[string]struct{}) {
+	switch v := data.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if _, ok := sensitiveFields[strings.ToLower(key)]; ok && value != nil {
+				v[key] = "***"
+				continue
+			}
+			providerLogMaskFields(value, sensitiveFields)
+		}
+	case []interface{}:
+		for _, value := range v {
+			providerLogMaskFields(value, sensitiveFields)
+		}
+	}
+}
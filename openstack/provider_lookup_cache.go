@@ -0,0 +1,58 @@
+package openstack
+
+import (
+	"sync"
+)
+
+// providerLookupCache memoizes the results of lookups, which are done by many
+// resources with the same arguments, e.g. the resolution of a flavor name to
+// its ID. The cache lives as long as the configured provider, which is
+// configured anew for every Terraform operation. Failed lookups aren't cached.
+type providerLookupCache struct {
+	mu      sync.Mutex
+	entries map[string]*providerLookupCacheEntry
+}
+
+type providerLookupCacheEntry struct {
+	once  sync.Once
+	value interface{}
+	err   error
+}
+
+// get returns the cached value of the key. When the key isn't cached yet, the
+// lookup is called. Concurrent callers of the same key wait for a single
+// lookup.
+func (c *providerLookupCache) get(key string, lookup func() (interface{}, error)) (interface{}, error) {
+	c.mu.Lock()
+	if c.entries == nil {
+		c.entries = make(map[string]*providerLookupCacheEntry)
+	}
+	e, ok := c.entries[key]
+	if !ok {
+		e = &providerLookupCacheEntry{}
+		c.entries[key] = e
+	}
+	c.mu.Unlock()
+
+	e.once.Do(func() {
+		e.value, e.err = lookup()
+	})
+
+	if e.err != nil {
+		c.mu.Lock()
+		if c.entries[key] == e {
+			delete(c.entries, key)
+		}
+		c.mu.Unlock()
+	}
+
+	return e.value, e.err
+}
+
+// invalidate drops all cached values, e.g. after a flavor or an image was
+// created or deleted.
+func (c *providerLookupCache) invalidate() {
+	c.mu.Lock()
+	c.entries = nil
+	c.mu.Unlock()
+}
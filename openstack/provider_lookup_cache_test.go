@@ -0,0 +1,134 @@
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestProviderLookupCacheConcurrent(t *testing.T) {
+	var cache providerLookupCache
+	var calls int32
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err := cache.get("key", func() (interface{}, error) {
+				atomic.AddInt32(&calls, 1)
+				time.Sleep(10 * time.Millisecond)
+				return "value", nil
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, "value", v)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), calls)
+}
+
+func TestProviderLookupCacheErrorsAndInvalidate(t *testing.T) {
+	var cache providerLookupCache
+	var calls int
+
+	lookup := func() (interface{}, error) {
+		calls++
+		if calls == 1 {
+			return nil, fmt.Errorf("failed")
+		}
+		return calls, nil
+	}
+
+	_, err := cache.get("key", lookup)
+	assert.EqualError(t, err, "failed")
+
+	// Failed lookups aren't cached.
+	v, err := cache.get("key", lookup)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, v)
+
+	v, err = cache.get("key", lookup)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, v)
+
+	cache.invalidate()
+
+	v, err = cache.get("key", lookup)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, v)
+}
+
+func TestComputeFlavorV2IDFromNameCached(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var calls int32
+	th.Mux.HandleFunc("/flavors/detail", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		time.Sleep(10 * time.Millisecond)
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"flavors": [{"id": "flavor-1", "name": "m1.small"}, {"id": "flavor-2", "name": "m1.large"}]}`)
+	})
+
+	config := &Config{}
+	client := thclient.ServiceClient()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			id, err := computeFlavorV2IDFromName(config, client, "m1.small")
+			assert.NoError(t, err)
+			assert.Equal(t, "flavor-1", id)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), calls)
+
+	id, err := computeFlavorV2IDFromName(config, client, "m1.large")
+	assert.NoError(t, err)
+	assert.Equal(t, "flavor-2", id)
+	assert.Equal(t, int32(2), calls)
+}
+
+func TestImagesImageV2IDFromNameCached(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var calls int32
+	th.Mux.HandleFunc("/images", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		time.Sleep(10 * time.Millisecond)
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"images": [{"id": "image-1", "name": "%s"}]}`, r.URL.Query().Get("name"))
+	})
+
+	config := &Config{}
+	client := thclient.ServiceClient()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			id, err := imagesImageV2IDFromName(config, client, "cirros")
+			assert.NoError(t, err)
+			assert.Equal(t, "image-1", id)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), calls)
+}
@@ -0,0 +1,177 @@
+package openstack
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	providerAuthTypeV3OIDCAccessToken = "v3oidcaccesstoken"
+	providerAuthTypeV3OIDCPassword    = "v3oidcpassword"
+)
+
+// providerOIDCConfig represents the settings used to obtain a Keystone token
+// through OpenID Connect federation.
+type providerOIDCConfig struct {
+	AuthType          string
+	AuthURL           string
+	IdentityProvider  string
+	Protocol          string
+	AccessToken       string
+	ClientID          string
+	ClientSecret      string
+	DiscoveryEndpoint string
+	Username          string
+	Password          string
+}
+
+func providerIsOIDCAuthType(authType string) bool {
+	return authType == providerAuthTypeV3OIDCAccessToken || authType == providerAuthTypeV3OIDCPassword
+}
+
+// validate makes sure, that all settings required by the auth type are set.
+func (c providerOIDCConfig) validate() error {
+	type setting struct {
+		name  string
+		value string
+	}
+
+	required := []setting{
+		{"auth_url", c.AuthURL},
+		{"identity_provider", c.IdentityProvider},
+		{"protocol", c.Protocol},
+	}
+
+	switch c.AuthType {
+	case providerAuthTypeV3OIDCAccessToken:
+		required = append(required, setting{"access_token", c.AccessToken})
+	case providerAuthTypeV3OIDCPassword:
+		required = append(required,
+			setting{"client_id", c.ClientID},
+			setting{"client_secret", c.ClientSecret},
+			setting{"discovery_endpoint", c.DiscoveryEndpoint},
+			setting{"user_name", c.Username},
+			setting{"password", c.Password},
+		)
+	default:
+		return fmt.Errorf("Unsupported OIDC auth_type: %s", c.AuthType)
+	}
+
+	var missing []string
+	for _, s := range required {
+		if s.value == "" {
+			missing = append(missing, s.name)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("auth_type %q requires the following arguments to be set: %s", c.AuthType, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+// providerOIDCToken obtains an unscoped Keystone token through OpenID
+// Connect federation. The token is rescoped by the regular token auth.
+func providerOIDCToken(client *http.Client, c providerOIDCConfig) (string, error) {
+	if err := c.validate(); err != nil {
+		return "", err
+	}
+
+	accessToken := c.AccessToken
+	if c.AuthType == providerAuthTypeV3OIDCPassword {
+		var err error
+		accessToken, err = providerOIDCPasswordAccessToken(client, c)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	federationURL := fmt.Sprintf("%s/OS-FEDERATION/identity_providers/%s/protocols/%s/auth",
+		providerIdentityV3URL(c.AuthURL), url.PathEscape(c.IdentityProvider), url.PathEscape(c.Protocol))
+
+	req, err := http.NewRequest("POST", federationURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("Error authenticating with identity provider %s: %s", c.IdentityProvider, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return "", fmt.Errorf("Error authenticating with identity provider %s: %s: %s", c.IdentityProvider, resp.Status, body)
+	}
+
+	token := resp.Header.Get("X-Subject-Token")
+	if token == "" {
+		return "", fmt.Errorf("Error authenticating with identity provider %s: no token returned", c.IdentityProvider)
+	}
+
+	return token, nil
+}
+
+// providerOIDCPasswordAccessToken obtains an OIDC access token from the token
+// endpoint of the OpenID Connect provider using the password grant.
+func providerOIDCPasswordAccessToken(client *http.Client, c providerOIDCConfig) (string, error) {
+	resp, err := client.Get(c.DiscoveryEndpoint)
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving OIDC discovery document %s: %s", c.DiscoveryEndpoint, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("Error retrieving OIDC discovery document %s: %s", c.DiscoveryEndpoint, resp.Status)
+	}
+
+	var discovery struct {
+		TokenEndpoint string `json:"token_endpoint"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&discovery); err != nil {
+		return "", fmt.Errorf("Error parsing OIDC discovery document %s: %s", c.DiscoveryEndpoint, err)
+	}
+	if discovery.TokenEndpoint == "" {
+		return "", fmt.Errorf("OIDC discovery document %s has no token_endpoint", c.DiscoveryEndpoint)
+	}
+
+	form := url.Values{
+		"grant_type":    {"password"},
+		"scope":         {"openid"},
+		"client_id":     {c.ClientID},
+		"client_secret": {c.ClientSecret},
+		"username":      {c.Username},
+		"password":      {c.Password},
+	}
+
+	tokenResp, err := client.PostForm(discovery.TokenEndpoint, form)
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving OIDC access token: %s", err)
+	}
+	defer tokenResp.Body.Close()
+
+	if tokenResp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(tokenResp.Body)
+		return "", fmt.Errorf("Error retrieving OIDC access token: %s: %s", tokenResp.Status, body)
+	}
+
+	var token struct {
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(tokenResp.Body).Decode(&token); err != nil {
+		return "", fmt.Errorf("Error parsing OIDC access token response: %s", err)
+	}
+	if token.AccessToken == "" {
+		return "", fmt.Errorf("OIDC token endpoint %s returned no access_token", discovery.TokenEndpoint)
+	}
+
+	return token.AccessToken, nil
+}
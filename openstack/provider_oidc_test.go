@@ -0,0 +1,103 @@
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitProviderOIDCConfigValidate(t *testing.T) {
+	c := providerOIDCConfig{
+		AuthType: providerAuthTypeV3OIDCAccessToken,
+		AuthURL:  "https://keystone.example.com/v3",
+	}
+	err := c.validate()
+	assert.EqualError(t, err, `auth_type "v3oidcaccesstoken" requires the following arguments to be set: identity_provider, protocol, access_token`)
+
+	c = providerOIDCConfig{
+		AuthType:         providerAuthTypeV3OIDCPassword,
+		AuthURL:          "https://keystone.example.com/v3",
+		IdentityProvider: "myidp",
+		Protocol:         "openid",
+		ClientID:         "client",
+		Username:         "user",
+	}
+	err = c.validate()
+	assert.EqualError(t, err, `auth_type "v3oidcpassword" requires the following arguments to be set: client_secret, discovery_endpoint, password`)
+
+	c.ClientSecret = "secret"
+	c.DiscoveryEndpoint = "https://idp.example.com/.well-known/openid-configuration"
+	c.Password = "pass"
+	assert.NoError(t, c.validate())
+}
+
+func testProviderOIDCFederationHandler(t *testing.T, accessToken string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "Bearer "+accessToken, r.Header.Get("Authorization"))
+		w.Header().Set("X-Subject-Token", "unscoped-token")
+		w.WriteHeader(http.StatusCreated)
+	}
+}
+
+func TestUnitProviderOIDCTokenAccessToken(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/v3/OS-FEDERATION/identity_providers/myidp/protocols/openid/auth",
+		testProviderOIDCFederationHandler(t, "oidc-access-token"))
+
+	token, err := providerOIDCToken(server.Client(), providerOIDCConfig{
+		AuthType:         providerAuthTypeV3OIDCAccessToken,
+		AuthURL:          server.URL + "/v3",
+		IdentityProvider: "myidp",
+		Protocol:         "openid",
+		AccessToken:      "oidc-access-token",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "unscoped-token", token)
+}
+
+func TestUnitProviderOIDCTokenPassword(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"token_endpoint": "%s/token"}`, server.URL)
+	})
+
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "password", r.PostForm.Get("grant_type"))
+		assert.Equal(t, "openid", r.PostForm.Get("scope"))
+		assert.Equal(t, "client", r.PostForm.Get("client_id"))
+		assert.Equal(t, "secret", r.PostForm.Get("client_secret"))
+		assert.Equal(t, "user", r.PostForm.Get("username"))
+		assert.Equal(t, "pass", r.PostForm.Get("password"))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"access_token": "oidc-access-token", "token_type": "Bearer"}`)
+	})
+
+	mux.HandleFunc("/v3/OS-FEDERATION/identity_providers/myidp/protocols/openid/auth",
+		testProviderOIDCFederationHandler(t, "oidc-access-token"))
+
+	token, err := providerOIDCToken(server.Client(), providerOIDCConfig{
+		AuthType:          providerAuthTypeV3OIDCPassword,
+		AuthURL:           server.URL,
+		IdentityProvider:  "myidp",
+		Protocol:          "openid",
+		ClientID:          "client",
+		ClientSecret:      "secret",
+		DiscoveryEndpoint: server.URL + "/.well-known/openid-configuration",
+		Username:          "user",
+		Password:          "pass",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "unscoped-token", token)
+}
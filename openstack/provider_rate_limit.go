@@ -0,0 +1,115 @@
+package openstack
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// providerRateLimiter is a token bucket, which allows bursts of up to burst
+// requests and refills at rate requests per second.
+type providerRateLimiter struct {
+	sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+}
+
+func newProviderRateLimiter(rate float64, burst int) *providerRateLimiter {
+	if burst < 1 {
+		burst = int(math.Max(1, math.Ceil(rate)))
+	}
+
+	return &providerRateLimiter{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		now:    time.Now,
+	}
+}
+
+// reserve takes a token from the bucket and returns the delay, after which
+// the token is available.
+func (l *providerRateLimiter) reserve() time.Duration {
+	l.Lock()
+	defer l.Unlock()
+
+	now := l.now()
+	if !l.last.IsZero() {
+		l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	}
+	l.last = now
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel returns a reserved token, which wasn't used.
+func (l *providerRateLimiter) cancel() {
+	l.Lock()
+	defer l.Unlock()
+
+	l.tokens = math.Min(l.burst, l.tokens+1)
+}
+
+// Wait blocks until a request is allowed or the context is done.
+func (l *providerRateLimiter) Wait(ctx context.Context) error {
+	delay := l.reserve()
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.cancel()
+		return ctx.Err()
+	}
+}
+
+// providerRateLimitRoundTripper paces the requests of all service clients,
+// which share the provider HTTP client. Retried requests pass the limiter
+// again, so retries don't exceed the configured rate either.
+type providerRateLimitRoundTripper struct {
+	rt      http.RoundTripper
+	limiter *providerRateLimiter
+}
+
+func (rt *providerRateLimitRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := rt.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	return rt.rt.RoundTrip(req)
+}
+
+// providerSetupRateLimit limits the requests of the HTTP client to rate
+// requests per second with bursts of up to burst requests. A rate of 0
+// disables the limiter.
+func providerSetupRateLimit(client *http.Client, rate float64, burst int) {
+	if rate <= 0 {
+		return
+	}
+
+	rt := client.Transport
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+
+	client.Transport = &providerRateLimitRoundTripper{
+		rt:      rt,
+		limiter: newProviderRateLimiter(rate, burst),
+	}
+}
@@ -0,0 +1,75 @@
+package openstack
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitProviderRateLimiterReserve(t *testing.T) {
+	now := time.Unix(0, 0)
+	l := newProviderRateLimiter(2, 3)
+	l.now = func() time.Time { return now }
+
+	// The burst is allowed at once.
+	assert.Equal(t, time.Duration(0), l.reserve())
+	assert.Equal(t, time.Duration(0), l.reserve())
+	assert.Equal(t, time.Duration(0), l.reserve())
+
+	assert.Equal(t, 500*time.Millisecond, l.reserve())
+	assert.Equal(t, time.Second, l.reserve())
+
+	// The bucket refills, but not beyond the burst.
+	now = now.Add(time.Minute)
+	assert.Equal(t, time.Duration(0), l.reserve())
+	assert.Equal(t, time.Duration(0), l.reserve())
+	assert.Equal(t, time.Duration(0), l.reserve())
+	assert.Equal(t, 500*time.Millisecond, l.reserve())
+}
+
+func TestUnitProviderRateLimiterDefaultBurst(t *testing.T) {
+	assert.Equal(t, float64(1), newProviderRateLimiter(0.5, 0).burst)
+	assert.Equal(t, float64(3), newProviderRateLimiter(2.5, 0).burst)
+}
+
+func TestUnitProviderRateLimiterWaitCanceled(t *testing.T) {
+	l := newProviderRateLimiter(0.001, 1)
+	assert.NoError(t, l.Wait(context.Background()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(t, context.Canceled, l.Wait(ctx))
+
+	// The token of the canceled request is returned.
+	assert.InDelta(t, 0, l.tokens, 0.01)
+}
+
+func TestUnitProviderSetupRateLimit(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{}
+	providerSetupRateLimit(client, 0, 0)
+	assert.Nil(t, client.Transport)
+
+	providerSetupRateLimit(client, 20, 2)
+	assert.IsType(t, &providerRateLimitRoundTripper{}, client.Transport)
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		resp, err := client.Get(server.URL)
+		assert.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	assert.Equal(t, 4, requests)
+	assert.True(t, time.Since(start) >= 90*time.Millisecond)
+}
@@ -0,0 +1,118 @@
+package openstack
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+const providerRetryBackoffBase = time.Second
+
+// providerRetryFunc returns a gophercloud.RetryFunc, which retries requests
+// that failed with a 429 or 503 response code up to maxRetries times. The
+// delay between the retries grows exponentially up to maxBackoff and is
+// randomized, unless the response contains a Retry-After header.
+func providerRetryFunc(maxRetries uint, maxBackoff time.Duration) gophercloud.RetryFunc {
+	return func(ctx context.Context, method, url string, options *gophercloud.RequestOpts, err error, failCount uint) error {
+		if failCount > maxRetries {
+			return err
+		}
+
+		var respErr gophercloud.ErrUnexpectedResponseCode
+		switch e := err.(type) {
+		case gophercloud.ErrDefault429:
+			respErr = e.ErrUnexpectedResponseCode
+		case gophercloud.ErrDefault503:
+			respErr = e.ErrUnexpectedResponseCode
+		default:
+			return err
+		}
+
+		// A POST, which already returned a created resource, must not be
+		// replayed, since this would create the resource twice.
+		if method == http.MethodPost && providerRetryResponseHasID(respErr.Body) {
+			return err
+		}
+
+		sleep := providerRetryBackoff(failCount, maxBackoff, respErr.ResponseHeader.Get("Retry-After"))
+		log.Printf("[DEBUG] Received %d response code for %s %s, retrying in %s (%d/%d)", respErr.Actual, method, url, sleep, failCount, maxRetries)
+
+		if ctx == nil {
+			ctx = context.Background()
+		}
+
+		select {
+		case <-time.After(sleep):
+		case <-ctx.Done():
+			return err
+		}
+
+		return nil
+	}
+}
+
+// providerRetryBackoff returns the delay before a retry. The Retry-After
+// header is honored, when it's set.
+func providerRetryBackoff(failCount uint, maxBackoff time.Duration, retryAfter string) time.Duration {
+	if retryAfter != "" {
+		var sleep time.Duration
+		if v, err := strconv.ParseUint(retryAfter, 10, 32); err == nil {
+			sleep = time.Duration(v) * time.Second
+		} else if v, err := time.Parse(http.TimeFormat, retryAfter); err == nil {
+			sleep = time.Until(v)
+		}
+
+		if sleep > 0 {
+			if sleep > maxBackoff {
+				return maxBackoff
+			}
+			return sleep
+		}
+	}
+
+	backoff := maxBackoff
+	if failCount < 32 {
+		if b := providerRetryBackoffBase << (failCount - 1); b < maxBackoff {
+			backoff = b
+		}
+	}
+
+	// Wait at least half of the backoff and add a random jitter.
+	half := backoff / 2
+	if half <= 0 {
+		return backoff
+	}
+
+	return half + time.Duration(rand.Int63n(int64(half)))
+}
+
+// providerRetryResponseHasID checks, whether a response body contains the ID
+// of a created resource, e.g. {"id": "..."} or {"server": {"id": "..."}}.
+func providerRetryResponseHasID(body []byte) bool {
+	var resp map[string]json.RawMessage
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return false
+	}
+
+	if _, ok := resp["id"]; ok {
+		return true
+	}
+
+	for _, v := range resp {
+		var nested map[string]json.RawMessage
+		if err := json.Unmarshal(v, &nested); err != nil {
+			continue
+		}
+		if _, ok := nested["id"]; ok {
+			return true
+		}
+	}
+
+	return false
+}
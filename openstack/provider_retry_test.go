@@ -0,0 +1,102 @@
+package openstack
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+func TestUnitProviderRetryBackoff(t *testing.T) {
+	maxBackoff := 10 * time.Second
+
+	for failCount := uint(1); failCount <= 5; failCount++ {
+		backoff := providerRetryBackoffBase << (failCount - 1)
+		if backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+
+		sleep := providerRetryBackoff(failCount, maxBackoff, "")
+		assert.True(t, sleep >= backoff/2 && sleep < backoff, "unexpected backoff %s for fail count %d", sleep, failCount)
+	}
+
+	assert.True(t, providerRetryBackoff(100, maxBackoff, "") <= maxBackoff)
+	assert.Equal(t, 3*time.Second, providerRetryBackoff(1, maxBackoff, "3"))
+	assert.Equal(t, maxBackoff, providerRetryBackoff(1, maxBackoff, "120"))
+}
+
+func TestUnitProviderRetryResponseHasID(t *testing.T) {
+	assert.True(t, providerRetryResponseHasID([]byte(`{"id": "b9b7f8f8-6a8d-4b8e-91c6-2d3c0bbf7e3a"}`)))
+	assert.True(t, providerRetryResponseHasID([]byte(`{"network": {"id": "b9b7f8f8-6a8d-4b8e-91c6-2d3c0bbf7e3a"}}`)))
+	assert.False(t, providerRetryResponseHasID([]byte(`{"overLimit": {"code": 429, "message": "Rate limit exceeded"}}`)))
+	assert.False(t, providerRetryResponseHasID([]byte(`Service Unavailable`)))
+}
+
+func testProviderRetryClient(handler http.HandlerFunc) (*gophercloud.ProviderClient, string, func()) {
+	server := httptest.NewServer(handler)
+	client := &gophercloud.ProviderClient{
+		RetryFunc: providerRetryFunc(2, 10*time.Millisecond),
+	}
+
+	return client, server.URL, server.Close
+}
+
+func TestUnitProviderRetryFunc(t *testing.T) {
+	var requests int
+	client, url, teardown := testProviderRetryClient(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch requests {
+		case 1:
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	})
+	defer teardown()
+
+	_, err := client.Request("GET", url, &gophercloud.RequestOpts{})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, requests)
+}
+
+func TestUnitProviderRetryFuncMaxRetries(t *testing.T) {
+	var requests int
+	client, url, teardown := testProviderRetryClient(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	defer teardown()
+
+	_, err := client.Request("GET", url, &gophercloud.RequestOpts{})
+	assert.IsType(t, gophercloud.ErrDefault503{}, err)
+	assert.Equal(t, 3, requests)
+}
+
+func TestUnitProviderRetryFuncNotRetried(t *testing.T) {
+	var requests int
+	client, url, teardown := testProviderRetryClient(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Method == "POST" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"port": {"id": "b9b7f8f8-6a8d-4b8e-91c6-2d3c0bbf7e3a"}}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+	defer teardown()
+
+	_, err := client.Request("POST", url, &gophercloud.RequestOpts{})
+	assert.IsType(t, gophercloud.ErrDefault503{}, err)
+	assert.Equal(t, 1, requests)
+
+	_, err = client.Request("GET", url, &gophercloud.RequestOpts{})
+	assert.IsType(t, gophercloud.ErrDefault404{}, err)
+	assert.Equal(t, 2, requests)
+}
@@ -0,0 +1,39 @@
+package openstack
+
+import (
+	"fmt"
+	"os"
+)
+
+// providerSystemScopeDefaultFunc reads the system scope from the
+// OS_SYSTEM_SCOPE environment variable, which is set to "all" by the
+// OpenStack clients.
+func providerSystemScopeDefaultFunc() (interface{}, error) {
+	return os.Getenv("OS_SYSTEM_SCOPE") == "all", nil
+}
+
+// validateSystemScope makes sure, that no project scope or application
+// credential is configured along with the system scope.
+func (c *Config) validateSystemScope() error {
+	if c.TenantID != "" || c.TenantName != "" {
+		return fmt.Errorf("system_scope is mutually exclusive with tenant_id and tenant_name")
+	}
+
+	if c.ApplicationCredentialID != "" || c.ApplicationCredentialName != "" {
+		return fmt.Errorf("system_scope cannot be used with application credentials")
+	}
+
+	return nil
+}
+
+// requireProjectScope returns an error, when a service, which only has
+// project resources and no system-scoped API, is used with a system-scoped
+// session. The other services decide on their own, which of their requests
+// can be made with a system-scoped token.
+func (c *Config) requireProjectScope(service string) error {
+	if c.SystemScope {
+		return fmt.Errorf("The %s service requires a project-scoped token, but the provider is configured with system_scope", service)
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gophercloud/utils/terraform/auth"
+)
+
+func TestUnitConfigValidateSystemScope(t *testing.T) {
+	config := Config{
+		Config: auth.Config{
+			Username:   "admin",
+			TenantName: "admin",
+		},
+		SystemScope: true,
+	}
+	assert.EqualError(t, config.validateSystemScope(), "system_scope is mutually exclusive with tenant_id and tenant_name")
+
+	config.TenantName = ""
+	config.ApplicationCredentialID = "app-cred"
+	assert.EqualError(t, config.validateSystemScope(), "system_scope cannot be used with application credentials")
+
+	config.ApplicationCredentialID = ""
+	assert.NoError(t, config.validateSystemScope())
+}
+
+func TestUnitConfigRequireProjectScope(t *testing.T) {
+	config := Config{
+		SystemScope: true,
+	}
+
+	_, err := config.ObjectStorageV1Client("")
+	assert.EqualError(t, err, "The object-store service requires a project-scoped token, but the provider is configured with system_scope")
+
+	_, err = config.OrchestrationV1Client("")
+	assert.EqualError(t, err, "The orchestration service requires a project-scoped token, but the provider is configured with system_scope")
+
+	config.SystemScope = false
+	assert.NoError(t, config.requireProjectScope("object-store"))
+}
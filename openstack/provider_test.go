@@ -11,6 +11,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-provider-openstack/terraform-provider-openstack/openstack/internal/pathorcontents"
 
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
 	"github.com/gophercloud/utils/terraform/auth"
 	"github.com/gophercloud/utils/terraform/mutexkv"
 )
@@ -46,6 +48,8 @@ var (
 	osHypervisorEnvironment      = os.Getenv("OS_HYPERVISOR_HOSTNAME")
 	osPortForwardingEnvironment  = os.Getenv("OS_PORT_FORWARDING_ENVIRONMENT")
 	osBlockStorageV2             = os.Getenv("OS_BLOCKSTORAGE_V2")
+	osSfsReplicationShareType    = os.Getenv("OS_SFS_REPLICATION_SHARE_TYPE")
+	osSfsShareGroupTypeID        = os.Getenv("OS_SFS_SHARE_GROUP_TYPE_ID")
 )
 
 var (
@@ -218,6 +222,22 @@ func testAccPreOnlineResize(t *testing.T) {
 	}
 }
 
+func testAccPreCheckSFSReplication(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if osSfsReplicationShareType == "" {
+		t.Skip("OS_SFS_REPLICATION_SHARE_TYPE must be set for Shared File Systems replication tests")
+	}
+}
+
+func testAccPreCheckSFSShareGroup(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if osSfsShareGroupTypeID == "" {
+		t.Skip("OS_SFS_SHARE_GROUP_TYPE_ID must be set for Shared File Systems share group tests")
+	}
+}
+
 func testAccPreCheckTransparentVLAN(t *testing.T) {
 	testAccPreCheckRequiredEnvVars(t)
 
@@ -260,6 +280,23 @@ func testAccPreCheckHypervisor(t *testing.T) {
 	}
 }
 
+// testAccUnitConfig returns a provider configuration for the unit tests,
+// which sends the requests of the services to the testhelper server.
+func testAccUnitConfig(services ...string) *Config {
+	endpoints := make(map[string]interface{}, len(services))
+	for _, service := range services {
+		endpoints[service] = th.Endpoint()
+	}
+
+	return &Config{
+		Config: auth.Config{
+			EndpointOverrides: endpoints,
+			OsClient:          thclient.ServiceClient().ProviderClient,
+			MutexKV:           mutexkv.NewMutexKV(),
+		},
+	}
+}
+
 func TestProvider(t *testing.T) {
 	if err := Provider().InternalValidate(); err != nil {
 		t.Fatalf("err: %s", err)
@@ -421,7 +458,7 @@ func testAccAuthFromEnv() (*Config, error) {
 	}
 
 	config := Config{
-		auth.Config{
+		Config: auth.Config{
 			CACertFile:        os.Getenv("OS_CACERT"),
 			ClientCertFile:    os.Getenv("OS_CERT"),
 			ClientKeyFile:     os.Getenv("OS_KEY"),
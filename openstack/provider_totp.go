@@ -0,0 +1,137 @@
+package openstack
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+const providerAuthReceiptHeader = "Openstack-Auth-Receipt"
+
+// providerTOTPConfig represents the settings used to obtain a Keystone token
+// through password and TOTP multi-factor authentication.
+type providerTOTPConfig struct {
+	AuthURL        string
+	UserID         string
+	Username       string
+	UserDomainID   string
+	UserDomainName string
+	Password       string
+	Passcode       string
+}
+
+// validate makes sure, that all settings required by the TOTP auth are set.
+func (c providerTOTPConfig) validate() error {
+	var missing []string
+
+	if c.AuthURL == "" {
+		missing = append(missing, "auth_url")
+	}
+	if c.UserID == "" && c.Username == "" {
+		missing = append(missing, "user_name or user_id")
+	}
+	if c.Password == "" {
+		missing = append(missing, "password")
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("passcode requires the following arguments to be set: %s", strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+func (c providerTOTPConfig) user() map[string]interface{} {
+	if c.UserID != "" {
+		return map[string]interface{}{"id": c.UserID}
+	}
+
+	user := map[string]interface{}{"name": c.Username}
+	if c.UserDomainID != "" {
+		user["domain"] = map[string]interface{}{"id": c.UserDomainID}
+	} else if c.UserDomainName != "" {
+		user["domain"] = map[string]interface{}{"name": c.UserDomainName}
+	}
+
+	return user
+}
+
+// providerTOTPToken obtains an unscoped Keystone token using the password and
+// the TOTP passcode. When Keystone answers the password auth with an auth
+// receipt, the passcode is sent along with the receipt to complete the
+// multi-factor auth. The token is rescoped by the regular token auth.
+func providerTOTPToken(client *http.Client, c providerTOTPConfig) (string, error) {
+	if err := c.validate(); err != nil {
+		return "", err
+	}
+
+	passwordUser := c.user()
+	passwordUser["password"] = c.Password
+	token, receipt, err := providerTOTPAuth(client, c.AuthURL, "", map[string]interface{}{
+		"methods":  []string{"password"},
+		"password": map[string]interface{}{"user": passwordUser},
+	})
+	if err != nil {
+		return "", err
+	}
+	if token != "" {
+		return token, nil
+	}
+
+	totpUser := c.user()
+	totpUser["passcode"] = c.Passcode
+	token, _, err = providerTOTPAuth(client, c.AuthURL, receipt, map[string]interface{}{
+		"methods": []string{"totp"},
+		"totp":    map[string]interface{}{"user": totpUser},
+	})
+
+	return token, err
+}
+
+// providerTOTPAuth performs a single Keystone auth request. It returns either
+// a token or, when Keystone requires additional auth methods, an auth receipt.
+func providerTOTPAuth(client *http.Client, authURL, receipt string, identity map[string]interface{}) (string, string, error) {
+	body, err := json.Marshal(map[string]interface{}{
+		"auth": map[string]interface{}{"identity": identity},
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	req, err := http.NewRequest("POST", providerIdentityV3URL(authURL)+"/auth/tokens", bytes.NewReader(body))
+	if err != nil {
+		return "", "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	if receipt != "" {
+		req.Header.Set(providerAuthReceiptHeader, receipt)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", "", fmt.Errorf("Error authenticating with passcode: %s", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusCreated:
+		token := resp.Header.Get("X-Subject-Token")
+		if token == "" {
+			return "", "", fmt.Errorf("Error authenticating with passcode: no token returned")
+		}
+		return token, "", nil
+	case http.StatusUnauthorized:
+		// Only the password auth is expected to return a receipt. A second
+		// receipt means, that the auth rules require methods other than totp.
+		if r := resp.Header.Get(providerAuthReceiptHeader); r != "" && receipt == "" {
+			return "", r, nil
+		}
+	}
+
+	respBody, _ := ioutil.ReadAll(resp.Body)
+	return "", "", fmt.Errorf("Error authenticating with passcode: %s: %s", resp.Status, respBody)
+}
@@ -0,0 +1,100 @@
+package openstack
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitProviderTOTPConfigValidate(t *testing.T) {
+	c := providerTOTPConfig{
+		Passcode: "123456",
+	}
+	err := c.validate()
+	assert.EqualError(t, err, "passcode requires the following arguments to be set: auth_url, user_name or user_id, password")
+
+	c.AuthURL = "https://keystone.example.com/v3"
+	c.Username = "admin"
+	c.Password = "secret"
+	assert.NoError(t, c.validate())
+}
+
+func TestUnitProviderTOTPTokenReceipt(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	var requests int
+	mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		var body struct {
+			Auth struct {
+				Identity map[string]interface{} `json:"identity"`
+			} `json:"auth"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		identity := body.Auth.Identity
+
+		if r.Header.Get(providerAuthReceiptHeader) == "" {
+			assert.Equal(t, []interface{}{"password"}, identity["methods"])
+			assert.Equal(t, map[string]interface{}{
+				"user": map[string]interface{}{
+					"name":     "admin",
+					"domain":   map[string]interface{}{"id": "default"},
+					"password": "secret",
+				},
+			}, identity["password"])
+
+			w.Header().Set(providerAuthReceiptHeader, "receipt")
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		assert.Equal(t, "receipt", r.Header.Get(providerAuthReceiptHeader))
+		assert.Equal(t, []interface{}{"totp"}, identity["methods"])
+		assert.Equal(t, map[string]interface{}{
+			"user": map[string]interface{}{
+				"name":     "admin",
+				"domain":   map[string]interface{}{"id": "default"},
+				"passcode": "123456",
+			},
+		}, identity["totp"])
+
+		w.Header().Set("X-Subject-Token", "unscoped-token")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	token, err := providerTOTPToken(server.Client(), providerTOTPConfig{
+		AuthURL:      server.URL + "/v3/",
+		Username:     "admin",
+		UserDomainID: "default",
+		Password:     "secret",
+		Passcode:     "123456",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "unscoped-token", token)
+	assert.Equal(t, 2, requests)
+}
+
+func TestUnitProviderTOTPTokenInvalidPasscode(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(providerAuthReceiptHeader, "receipt")
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	_, err := providerTOTPToken(server.Client(), providerTOTPConfig{
+		AuthURL:  server.URL,
+		UserID:   "user-id",
+		Password: "secret",
+		Passcode: "000000",
+	})
+	assert.Error(t, err)
+}
@@ -49,6 +49,11 @@ func resourceBlockStorageVolumeV3() *schema.Resource {
 				Optional: true,
 			},
 
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
 			"name": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -348,6 +353,10 @@ func resourceBlockStorageVolumeV3Update(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceBlockStorageVolumeV3Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.Get("deletion_protection").(bool) {
+		return diag.Errorf("Error deleting openstack_blockstorage_volume_v3 %s: deletion_protection is enabled", d.Id())
+	}
+
 	config := meta.(*Config)
 	blockStorageClient, err := config.BlockStorageV3Client(GetRegion(d, config))
 	if err != nil {
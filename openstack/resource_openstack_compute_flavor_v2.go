@@ -124,6 +124,7 @@ func resourceComputeFlavorV2Create(ctx context.Context, d *schema.ResourceData,
 	}
 
 	d.SetId(fl.ID)
+	config.lookupCache.invalidate()
 
 	extraSpecsRaw := d.Get("extra_specs").(map[string]interface{})
 	if len(extraSpecsRaw) > 0 {
@@ -218,6 +219,7 @@ func resourceComputeFlavorV2Delete(_ context.Context, d *schema.ResourceData, me
 	if err != nil {
 		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_compute_flavor_v2"))
 	}
+	config.lookupCache.invalidate()
 
 	return nil
 }
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -30,8 +31,6 @@ import (
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/images"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
-	flavorsutils "github.com/gophercloud/utils/openstack/compute/v2/flavors"
-	imagesutils "github.com/gophercloud/utils/openstack/imageservice/v2/images"
 	"github.com/gophercloud/utils/terraform/hashcode"
 )
 
@@ -97,7 +96,9 @@ func resourceComputeInstanceV2() *schema.Resource {
 			"user_data": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
+				// ForceNew unless user_data_update_strategy is set otherwise,
+				// see computeInstanceV2UserDataCustomizeDiff.
+				DiffSuppressFunc: computeInstanceV2UserDataDiffSuppressFunc,
 				// just stash the hash for state & diff comparisons
 				StateFunc: func(v interface{}) string {
 					switch v.(type) {
@@ -109,6 +110,15 @@ func resourceComputeInstanceV2() *schema.Resource {
 					}
 				},
 			},
+			"user_data_update_strategy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					computeV2InstanceUserDataUpdateReplace,
+					computeV2InstanceUserDataUpdateRebuild,
+					computeV2InstanceUserDataUpdateIgnoreAfterCreate,
+				}, false),
+			},
 			"security_groups": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -392,6 +402,19 @@ func resourceComputeInstanceV2() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"preserve_ports_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"preserved_port_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"all_metadata": {
 				Type:     schema.TypeMap,
 				Computed: true,
@@ -437,6 +460,12 @@ func resourceComputeInstanceV2() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: customdiff.Sequence(
+			func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return computeInstanceV2UserDataCustomizeDiff(diff)
+			},
+		),
 	}
 }
 
@@ -459,7 +488,7 @@ func resourceComputeInstanceV2Create(ctx context.Context, d *schema.ResourceData
 	// If a bootable block_device was specified, ignore the image altogether.
 	// If an image_id was specified, use it.
 	// If an image_name was specified, look up the image ID, report if error.
-	imageID, err := getImageIDFromConfig(imageClient, d)
+	imageID, err := getImageIDFromConfig(config, imageClient, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -467,7 +496,7 @@ func resourceComputeInstanceV2Create(ctx context.Context, d *schema.ResourceData
 	// Determines the Flavor ID using the following rules:
 	// If a flavor_id was specified, use it.
 	// If a flavor_name was specified, lookup the flavor ID, report if error.
-	flavorID, err := getFlavorID(computeClient, d)
+	flavorID, err := getFlavorID(config, computeClient, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -478,9 +507,10 @@ func resourceComputeInstanceV2Create(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 
-	if networkMode := d.Get("network_mode").(string); networkMode == "auto" || networkMode == "none" {
+	var diags diag.Diagnostics
+	if networkMode := strings.ToLower(d.Get("network_mode").(string)); networkMode == "auto" || networkMode == "none" {
 		// Use special string for network option
-		computeClient.Microversion = computeV2InstanceCreateServerWithNetworkModeMicroversion
+		diags = append(diags, computeV2SetMicroversion(computeClient, computeV2InstanceCreateServerWithNetworkModeMicroversion, "network_mode")...)
 		networks = networkMode
 		log.Printf("[DEBUG] Create with network options %s", networks)
 	} else {
@@ -498,10 +528,19 @@ func resourceComputeInstanceV2Create(ctx context.Context, d *schema.ResourceData
 
 	configDrive := d.Get("config_drive").(bool)
 
-	// Retrieve tags and set microversion if they're provided.
-	instanceTags := computeV2InstanceTags(d)
+	// Retrieve tags and set microversion if they're provided. The default
+	// tags of the provider alone don't require the microversion, so they are
+	// skipped when the compute API doesn't support it.
+	instanceTags := computeV2InstanceTags(d, config.DefaultTags)
+	if len(expandObjectTags(d)) == 0 && len(instanceTags) > 0 {
+		microversion := computeV2NegotiateMicroversion(computeClient, computeV2InstanceCreateServerWithTagsMicroversion)
+		if supported, err := compatibleMicroversion("min", computeV2InstanceCreateServerWithTagsMicroversion, microversion); err == nil && !supported {
+			log.Printf("[DEBUG] Skipping default tags %s, compute microversion %s is not supported", instanceTags, computeV2InstanceCreateServerWithTagsMicroversion)
+			instanceTags = nil
+		}
+	}
 	if len(instanceTags) > 0 {
-		computeClient.Microversion = computeV2InstanceCreateServerWithTagsMicroversion
+		diags = append(diags, computeV2SetMicroversion(computeClient, computeV2InstanceCreateServerWithTagsMicroversion, "tags")...)
 	}
 
 	if v, ok := d.GetOkExists("availability_zone"); ok {
@@ -542,7 +581,8 @@ func resourceComputeInstanceV2Create(ctx context.Context, d *schema.ResourceData
 		// If so, set the client's microversion appropriately.
 		for _, bd := range blockDevices {
 			if bd.VolumeType != "" {
-				computeClient.Microversion = computeV2InstanceBlockDeviceVolumeTypeMicroversion
+				diags = append(diags, computeV2SetMicroversion(computeClient, computeV2InstanceBlockDeviceVolumeTypeMicroversion, "block_device.volume_type")...)
+				break
 			}
 		}
 
@@ -633,7 +673,7 @@ func resourceComputeInstanceV2Create(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
-	return resourceComputeInstanceV2Read(ctx, d, meta)
+	return append(diags, resourceComputeInstanceV2Read(ctx, d, meta)...)
 }
 
 func resourceComputeInstanceV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -643,21 +683,80 @@ func resourceComputeInstanceV2Read(_ context.Context, d *schema.ResourceData, me
 		return diag.Errorf("Error creating OpenStack compute client: %s", err)
 	}
 
-	server, err := servers.Get(computeClient, d.Id()).Extract()
+	// Build a custom struct for the availability zone extension
+	var serverWithAZ struct {
+		servers.Server
+		availabilityzones.ServerAvailabilityZoneExt
+	}
+
+	err = servers.Get(computeClient, d.Id()).ExtractInto(&serverWithAZ)
 	if err != nil {
 		return diag.FromErr(CheckDeleted(d, err, "server"))
 	}
+	server := &serverWithAZ.Server
 
 	log.Printf("[DEBUG] Retrieved Server %s: %+v", d.Id(), server)
 
-	d.Set("name", server.Name)
+	flavorID, ok := server.Flavor["id"].(string)
+	if !ok {
+		return diag.Errorf("Error setting OpenStack server's flavor: %v", server.Flavor)
+	}
 
-	// Get the instance network and address information
-	networks, err := flattenInstanceNetworks(d, meta)
+	// The tags are retrieved with a copy of the compute client, because the
+	// microversion must not change for the concurrent lookups.
+	tagsClient := *computeClient
+	computeV2SetMicroversion(&tagsClient, computeV2TagsExtensionMicroversion, "tags")
+
+	// The secondary lookups don't depend on each other, so they are done
+	// concurrently. Only the network lookup may access the resource data,
+	// because it isn't safe for concurrent use.
+	needImage := computeV2InstanceNeedsImage(d)
+	var (
+		networks       []map[string]interface{}
+		flavor         *flavors.Flavor
+		imageInfo      map[string]string
+		instanceTags   []string
+		tagsErr        error
+		preservedPorts []string
+	)
+	tasks := []func() error{
+		func() (err error) {
+			// Get the instance network and address information
+			networks, err = flattenInstanceNetworks(d, meta, server)
+			return err
+		},
+		func() (err error) {
+			flavor, err = flavors.Get(computeClient, flavorID).Extract()
+			return err
+		},
+		func() (err error) {
+			imageInfo, err = getImageInformation(computeClient, server, needImage)
+			return err
+		},
+		func() error {
+			// Unsupported tags are reported on create and update.
+			instanceTags, tagsErr = tags.List(&tagsClient, server.ID).Extract()
+			return nil
+		},
+	}
+	if d.Get("preserve_ports_on_destroy").(bool) {
+		networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+		if err != nil {
+			return diag.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+		configuredPorts := computeInstanceV2ConfiguredPorts(d)
+		tasks = append(tasks, func() (err error) {
+			preservedPorts, err = computeInstanceV2AutoCreatedPorts(networkingClient, server.ID, configuredPorts)
+			return err
+		})
+	}
+	err = runTasks(tasks, computeV2InstanceReadConcurrency)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	d.Set("name", server.Name)
+
 	// Determine the best IPv4 and IPv6 addresses to access the instance with
 	hostv4, hostv6 := getInstanceAccessAddresses(d, networks)
 
@@ -671,7 +770,14 @@ func resourceComputeInstanceV2Read(_ context.Context, d *schema.ResourceData, me
 		hostv6 = server.AccessIPv6
 	}
 
-	d.Set("network", networks)
+	// The networks of an instance created with network_mode are picked by
+	// Nova, so they aren't stored as network blocks. The access addresses are
+	// still derived from them.
+	if d.Get("network_mode").(string) != "" {
+		d.Set("network", nil)
+	} else {
+		d.Set("network", networks)
+	}
 	d.Set("access_ip_v4", hostv4)
 	d.Set("access_ip_v6", hostv6)
 
@@ -700,35 +806,15 @@ func resourceComputeInstanceV2Read(_ context.Context, d *schema.ResourceData, me
 	}
 	d.Set("security_groups", secGrpNames)
 
-	flavorID, ok := server.Flavor["id"].(string)
-	if !ok {
-		return diag.Errorf("Error setting OpenStack server's flavor: %v", server.Flavor)
-	}
 	d.Set("flavor_id", flavorID)
-
 	d.Set("key_pair", server.KeyName)
-	flavor, err := flavors.Get(computeClient, flavorID).Extract()
-	if err != nil {
-		return diag.FromErr(err)
-	}
 	d.Set("flavor_name", flavor.Name)
 
 	// Set the instance's image information appropriately
-	if err := setImageInformation(computeClient, server, d); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// Build a custom struct for the availability zone extension
-	var serverWithAZ struct {
-		servers.Server
-		availabilityzones.ServerAvailabilityZoneExt
+	for k, v := range imageInfo {
+		d.Set(k, v)
 	}
 
-	// Do another Get so the above work is not disturbed.
-	err = servers.Get(computeClient, d.Id()).ExtractInto(&serverWithAZ)
-	if err != nil {
-		return diag.FromErr(CheckDeleted(d, err, "server"))
-	}
 	// Set the availability zone
 	d.Set("availability_zone", serverWithAZ.AvailabilityZone)
 
@@ -745,14 +831,14 @@ func resourceComputeInstanceV2Read(_ context.Context, d *schema.ResourceData, me
 	}
 
 	// Populate tags.
-	computeClient.Microversion = computeV2TagsExtensionMicroversion
-	instanceTags, err := tags.List(computeClient, server.ID).Extract()
-	if err != nil {
-		log.Printf("[DEBUG] Unable to get tags for openstack_compute_instance_v2: %s", err)
+	if tagsErr != nil {
+		log.Printf("[DEBUG] Unable to get tags for openstack_compute_instance_v2: %s", tagsErr)
 	} else {
 		computeV2InstanceReadTags(d, instanceTags)
 	}
 
+	d.Set("preserved_port_ids", preservedPorts)
+
 	return nil
 }
 
@@ -945,7 +1031,7 @@ func resourceComputeInstanceV2Update(ctx context.Context, d *schema.ResourceData
 			newFlavorID = d.Get("flavor_id").(string)
 		} else {
 			newFlavorName := d.Get("flavor_name").(string)
-			newFlavorID, err = flavorsutils.IDFromName(computeClient, newFlavorName)
+			newFlavorID, err = computeFlavorV2IDFromName(config, computeClient, newFlavorName)
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -1016,11 +1102,59 @@ func resourceComputeInstanceV2Update(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
+	// Only the rebuild strategy updates user_data, see
+	// computeInstanceV2UserDataCustomizeDiff.
+	if d.HasChange("user_data") {
+		imageID := d.Get("image_id").(string)
+		if imageID == "" {
+			return diag.Errorf("Error rebuilding OpenStack server (%s): a boot-from-volume instance can't be rebuilt", d.Id())
+		}
+
+		rebuildClient := *computeClient
+		if diags := computeV2SetMicroversion(&rebuildClient, computeV2InstanceRebuildWithUserDataMicroversion, "user_data"); len(diags) > 0 {
+			return diag.Errorf("Error rebuilding OpenStack server (%s): %s", d.Id(), diags[0].Summary)
+		}
+
+		rebuildOpts := ServerRebuildOpts{
+			RebuildOpts: servers.RebuildOpts{
+				ImageRef: imageID,
+			},
+		}
+
+		log.Printf("[DEBUG] Rebuild configuration: %#v", rebuildOpts)
+
+		// Add admin password and user data here so they wouldn't go in the above log entry.
+		rebuildOpts.AdminPass = d.Get("admin_pass").(string)
+		rebuildOpts.UserData = []byte(d.Get("user_data").(string))
+
+		_, err = servers.Rebuild(&rebuildClient, d.Id(), rebuildOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error rebuilding OpenStack server (%s): %s", d.Id(), err)
+		}
+
+		log.Printf("[DEBUG] Waiting for instance (%s) to finish rebuilding", d.Id())
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"REBUILD"},
+			Target:     []string{"ACTIVE", "SHUTOFF"},
+			Refresh:    ServerV2StateRefreshFunc(computeClient, d.Id()),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			Delay:      5 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		_, err = stateConf.WaitForStateContext(ctx)
+		if err != nil {
+			return diag.Errorf("Error waiting for instance (%s) to rebuild: %s", d.Id(), err)
+		}
+	}
+
 	// Perform any required updates to the tags.
+	var diags diag.Diagnostics
 	if d.HasChange("tags") {
-		instanceTags := computeV2InstanceUpdateTags(d)
+		instanceTags := computeV2InstanceUpdateTags(d, config.DefaultTags)
 		instanceTagsOpts := tags.ReplaceAllOpts{Tags: instanceTags}
-		computeClient.Microversion = computeV2TagsExtensionMicroversion
+		diags = append(diags, computeV2SetMicroversion(computeClient, computeV2TagsExtensionMicroversion, "tags")...)
 		instanceTags, err := tags.ReplaceAll(computeClient, d.Id(), instanceTagsOpts).Extract()
 		if err != nil {
 			return diag.Errorf("Error setting tags on openstack_compute_instance_v2 %s: %s", d.Id(), err)
@@ -1028,10 +1162,14 @@ func resourceComputeInstanceV2Update(ctx context.Context, d *schema.ResourceData
 		log.Printf("[DEBUG] Set tags %s on openstack_compute_instance_v2 %s", instanceTags, d.Id())
 	}
 
-	return resourceComputeInstanceV2Read(ctx, d, meta)
+	return append(diags, resourceComputeInstanceV2Read(ctx, d, meta)...)
 }
 
 func resourceComputeInstanceV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.Get("deletion_protection").(bool) {
+		return diag.Errorf("Error deleting openstack_compute_instance_v2 %s: deletion_protection is enabled", d.Id())
+	}
+
 	config := meta.(*Config)
 	computeClient, err := config.ComputeV2Client(GetRegion(d, config))
 	if err != nil {
@@ -1058,6 +1196,22 @@ func resourceComputeInstanceV2Delete(ctx context.Context, d *schema.ResourceData
 			}
 		}
 	}
+	if d.Get("preserve_ports_on_destroy").(bool) {
+		networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+		if err != nil {
+			return diag.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		portIDs, err := computeInstanceV2AutoCreatedPorts(networkingClient, d.Id(), computeInstanceV2ConfiguredPorts(d))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := computeInstanceV2PreservePorts(networkingClient, d.Id(), portIDs); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	vendorOptionsRaw := d.Get("vendor_options").(*schema.Set)
 	var detachPortBeforeDestroy bool
 	if vendorOptionsRaw.Len() > 0 {
@@ -1356,7 +1510,7 @@ func resourceInstanceSchedulerHintsV2(_ *schema.ResourceData, schedulerHintsRaw
 	return schedulerHints
 }
 
-func getImageIDFromConfig(imageClient *gophercloud.ServiceClient, d *schema.ResourceData) (string, error) {
+func getImageIDFromConfig(config *Config, imageClient *gophercloud.ServiceClient, d *schema.ResourceData) (string, error) {
 	// If block_device was used, an Image does not need to be specified, unless an image/local
 	// combination was used. This emulates normal boot behavior. Otherwise, ignore the image altogether.
 	if vL, ok := d.GetOk("block_device"); ok {
@@ -1389,7 +1543,7 @@ func getImageIDFromConfig(imageClient *gophercloud.ServiceClient, d *schema.Reso
 	}
 
 	if imageName != "" {
-		imageID, err := imagesutils.IDFromName(imageClient, imageName)
+		imageID, err := imagesImageV2IDFromName(config, imageClient, imageName)
 		if err != nil {
 			return "", err
 		}
@@ -1400,45 +1554,69 @@ func getImageIDFromConfig(imageClient *gophercloud.ServiceClient, d *schema.Reso
 }
 
 func setImageInformation(computeClient *gophercloud.ServiceClient, server *servers.Server, d *schema.ResourceData) error {
+	imageInfo, err := getImageInformation(computeClient, server, computeV2InstanceNeedsImage(d))
+	if err != nil {
+		return err
+	}
+
+	for k, v := range imageInfo {
+		d.Set(k, v)
+	}
+
+	return nil
+}
+
+// computeV2InstanceNeedsImage returns false, if the instance boots from a
+// volume without an image/local block device.
+func computeV2InstanceNeedsImage(d *schema.ResourceData) bool {
 	// If block_device was used, an Image does not need to be specified, unless an image/local
 	// combination was used. This emulates normal boot behavior. Otherwise, ignore the image altogether.
 	if vL, ok := d.GetOk("block_device"); ok {
-		needImage := false
 		for _, v := range vL.([]interface{}) {
 			vM := v.(map[string]interface{})
 			if vM["source_type"] == "image" && vM["destination_type"] == "local" {
-				needImage = true
+				return true
 			}
 		}
-		if !needImage {
-			d.Set("image_id", "Attempt to boot from volume - no image supplied")
-			return nil
-		}
+		return false
 	}
 
+	return true
+}
+
+// getImageInformation returns the image_id and image_name attributes of the
+// instance. Attributes which should not be changed are omitted.
+func getImageInformation(computeClient *gophercloud.ServiceClient, server *servers.Server, needImage bool) (map[string]string, error) {
+	if !needImage {
+		return map[string]string{
+			"image_id": "Attempt to boot from volume - no image supplied",
+		}, nil
+	}
+
+	imageInfo := map[string]string{}
 	if server.Image["id"] != nil {
 		imageID := server.Image["id"].(string)
 		if imageID != "" {
-			d.Set("image_id", imageID)
+			imageInfo["image_id"] = imageID
 			image, err := images.Get(computeClient, imageID).Extract()
 			if err != nil {
 				if _, ok := err.(gophercloud.ErrDefault404); ok {
 					// If the image name can't be found, set the value to "Image not found".
 					// The most likely scenario is that the image no longer exists in the Image Service
 					// but the instance still has a record from when it existed.
-					d.Set("image_name", "Image not found")
-					return nil
+					imageInfo["image_name"] = "Image not found"
+					return imageInfo, nil
 				}
-				return err
+				return nil, err
 			}
-			d.Set("image_name", image.Name)
+			imageInfo["image_name"] = image.Name
 		}
 	}
 
-	return nil
+	return imageInfo, nil
 }
 
-func getFlavorID(computeClient *gophercloud.ServiceClient, d *schema.ResourceData) (string, error) {
+func getFlavorID(config *Config, computeClient *gophercloud.ServiceClient, d *schema.ResourceData) (string, error) {
 	if flavorID := d.Get("flavor_id").(string); flavorID != "" {
 		return flavorID, nil
 	}
@@ -1456,7 +1634,7 @@ func getFlavorID(computeClient *gophercloud.ServiceClient, d *schema.ResourceDat
 	}
 
 	if flavorName != "" {
-		flavorID, err := flavorsutils.IDFromName(computeClient, flavorName)
+		flavorID, err := computeFlavorV2IDFromName(config, computeClient, flavorName)
 		if err != nil {
 			return "", err
 		}
@@ -45,6 +45,36 @@ func TestAccComputeV2Instance_basic(t *testing.T) {
 	})
 }
 
+func TestAccComputeV2Instance_userDataRebuild(t *testing.T) {
+	var instance servers.Server
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2InstanceUserDataRebuild("instance_1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+				),
+			},
+			{
+				Config: testAccComputeV2InstanceUserDataRebuild("instance_2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(
+						"openstack_compute_instance_v2.instance_1", "id", &instance.ID),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "power_state", "active"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccComputeV2Instance_initialStateActive(t *testing.T) {
 	var instance servers.Server
 
@@ -588,6 +618,10 @@ func TestAccComputeV2Instance_networkModeAuto(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
 					testAccCheckComputeV2InstanceNetworkExists("openstack_compute_instance_v2.instance_1", &instance),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "network.#", "0"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_compute_instance_v2.instance_1", "access_ip_v4"),
 				),
 			},
 		},
@@ -609,6 +643,8 @@ func TestAccComputeV2Instance_networkModeNone(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
 					testAccCheckComputeV2InstanceNetworkDoesNotExist("openstack_compute_instance_v2.instance_1", &instance),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "network.#", "0"),
 				),
 			},
 		},
@@ -1011,6 +1047,20 @@ resource "openstack_compute_instance_v2" "instance_1" {
 `, osNetworkID)
 }
 
+func testAccComputeV2InstanceUserDataRebuild(hostname string) string {
+	return fmt.Sprintf(`
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  user_data = "#cloud-config\nhostname: %s"
+  user_data_update_strategy = "rebuild"
+  network {
+    uuid = "%s"
+  }
+}
+`, hostname, osNetworkID)
+}
+
 func testAccComputeV2InstanceSecgroupMulti() string {
 	return fmt.Sprintf(`
 resource "openstack_compute_secgroup_v2" "secgroup_1" {
@@ -74,9 +74,10 @@ func resourceComputeKeypairV2Create(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("Error creating OpenStack compute client: %s", err)
 	}
 
+	var diags diag.Diagnostics
 	userID := d.Get("user_id").(string)
 	if userID != "" {
-		computeClient.Microversion = computeKeyPairV2UserIDMicroversion
+		diags = computeV2SetMicroversion(computeClient, computeKeyPairV2UserIDMicroversion, "user_id")
 	}
 
 	name := d.Get("name").(string)
@@ -102,7 +103,7 @@ func resourceComputeKeypairV2Create(ctx context.Context, d *schema.ResourceData,
 	// Private Key is only available in the response to a create.
 	d.Set("private_key", kp.PrivateKey)
 
-	return resourceComputeKeypairV2Read(ctx, d, meta)
+	return append(diags, resourceComputeKeypairV2Read(ctx, d, meta)...)
 }
 
 func resourceComputeKeypairV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -114,7 +115,7 @@ func resourceComputeKeypairV2Read(_ context.Context, d *schema.ResourceData, met
 
 	userID := d.Get("user_id").(string)
 	if userID != "" {
-		computeClient.Microversion = computeKeyPairV2UserIDMicroversion
+		computeV2SetMicroversion(computeClient, computeKeyPairV2UserIDMicroversion, "user_id")
 	}
 
 	log.Printf("[DEBUG] Microversion %s", computeClient.Microversion)
@@ -150,7 +151,7 @@ func resourceComputeKeypairV2Delete(_ context.Context, d *schema.ResourceData, m
 
 	userID := d.Get("user_id").(string)
 	if userID != "" {
-		computeClient.Microversion = computeKeyPairV2UserIDMicroversion
+		computeV2SetMicroversion(computeClient, computeKeyPairV2UserIDMicroversion, "user_id")
 	}
 
 	log.Printf("[DEBUG] User ID %s", userID)
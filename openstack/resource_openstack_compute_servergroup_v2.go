@@ -66,7 +66,7 @@ func resourceComputeServerGroupV2Create(ctx context.Context, d *schema.ResourceD
 	name := d.Get("name").(string)
 
 	rawPolicies := d.Get("policies").([]interface{})
-	policies := expandComputeServerGroupV2Policies(computeClient, rawPolicies)
+	policies, diags := expandComputeServerGroupV2Policies(computeClient, rawPolicies)
 
 	createOpts := ComputeServerGroupV2CreateOpts{
 		servergroups.CreateOpts{
@@ -84,7 +84,7 @@ func resourceComputeServerGroupV2Create(ctx context.Context, d *schema.ResourceD
 
 	d.SetId(newSG.ID)
 
-	return resourceComputeServerGroupV2Read(ctx, d, meta)
+	return append(diags, resourceComputeServerGroupV2Read(ctx, d, meta)...)
 }
 
 func resourceComputeServerGroupV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
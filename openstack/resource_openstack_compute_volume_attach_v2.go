@@ -120,9 +120,10 @@ func resourceComputeVolumeAttachV2Create(ctx context.Context, d *schema.Resource
 
 	log.Printf("[DEBUG] openstack_compute_volume_attach_v2 attach options %s: %#v", instanceID, attachOpts)
 
+	var diags diag.Diagnostics
 	multiattach := d.Get("multiattach").(bool)
 	if multiattach {
-		computeClient.Microversion = "2.60"
+		diags = computeV2SetMicroversion(computeClient, computeVolumeAttachV2MultiattachMicroversion, "multiattach")
 	}
 
 	var attachment *volumeattach.VolumeAttachment
@@ -163,7 +164,7 @@ func resourceComputeVolumeAttachV2Create(ctx context.Context, d *schema.Resource
 
 	d.SetId(id)
 
-	return resourceComputeVolumeAttachV2Read(ctx, d, meta)
+	return append(diags, resourceComputeVolumeAttachV2Read(ctx, d, meta)...)
 }
 
 func resourceComputeVolumeAttachV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -0,0 +1,114 @@
+package openstack
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud/openstack/containerinfra/v1/certificates"
+)
+
+func resourceContainerInfraCertificateV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceContainerInfraCertificateV1Create,
+		ReadContext:   resourceContainerInfraCertificateV1Read,
+		Delete:        schema.RemoveFromState,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"csr": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"pem": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"ca_pem": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceContainerInfraCertificateV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	containerInfraClient, err := config.ContainerInfraV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack container infra client: %s", err)
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	createOpts := certificates.CreateOpts{
+		ClusterUUID: clusterID,
+		CSR:         d.Get("csr").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_containerinfra_certificate_v1 create options: %#v", createOpts)
+
+	certificate, err := certificates.Create(containerInfraClient, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating openstack_containerinfra_certificate_v1: %s", err)
+	}
+
+	certificateAuthority, err := certificates.Get(containerInfraClient, clusterID).Extract()
+	if err != nil {
+		return diag.Errorf("Error retrieving openstack_containerinfra_certificate_v1 CA: %s", err)
+	}
+
+	// The signed certificate has no ID, therefore the ID is derived from it.
+	hasher := md5.New()
+	hasher.Write([]byte(certificate.PEM))
+	d.SetId(hex.EncodeToString(hasher.Sum(nil)))
+
+	d.Set("pem", certificate.PEM)
+	d.Set("ca_pem", certificateAuthority.PEM)
+
+	log.Printf("[DEBUG] Created openstack_containerinfra_certificate_v1 %s", d.Id())
+
+	return resourceContainerInfraCertificateV1Read(ctx, d, meta)
+}
+
+func resourceContainerInfraCertificateV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	containerInfraClient, err := config.ContainerInfraV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack container infra client: %s", err)
+	}
+
+	certificateAuthority, err := certificates.Get(containerInfraClient, d.Get("cluster_id").(string)).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_containerinfra_certificate_v1 CA"))
+	}
+
+	// Sign the CSR again, when the cluster CA was rotated.
+	if certificateAuthority.PEM != d.Get("ca_pem").(string) {
+		log.Printf("[DEBUG] openstack_containerinfra_certificate_v1 %s CA was rotated, signing a new certificate", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package openstack
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccContainerInfraV1Certificate_basic(t *testing.T) {
+	resourceName := "openstack_containerinfra_certificate_v1.certificate_1"
+	clusterName := acctest.RandomWithPrefix("tf-acc-cluster")
+	imageName := acctest.RandomWithPrefix("tf-acc-image")
+	keypairName := acctest.RandomWithPrefix("tf-acc-keypair")
+	clusterTemplateName := acctest.RandomWithPrefix("tf-acc-clustertemplate")
+
+	csr, err := testAccContainerInfraV1CertificateCSR()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckContainerInfra(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckContainerInfraV1ClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerInfraV1CertificateBasic(imageName, keypairName, clusterTemplateName, clusterName, csr),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_id", "openstack_containerinfra_cluster_v1.cluster_1", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "pem"),
+					resource.TestCheckResourceAttrPair(resourceName, "ca_pem", "openstack_containerinfra_cluster_v1.cluster_1", "kubeconfig.cluster_ca_certificate"),
+				),
+			},
+		},
+	})
+}
+
+func testAccContainerInfraV1CertificateCSR() (string, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return "", err
+	}
+
+	csrTemplate := x509.CertificateRequest{
+		SignatureAlgorithm: x509.SHA256WithRSA,
+		Subject: pkix.Name{
+			CommonName: "tf-acc-user",
+		},
+	}
+
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &csrTemplate, key)
+	if err != nil {
+		return "", err
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{
+		Type:  certificateRequestBlockType,
+		Bytes: csr,
+	})), nil
+}
+
+func testAccContainerInfraV1CertificateBasic(imageName, keypairName, clusterTemplateName, clusterName, csr string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_containerinfra_certificate_v1" "certificate_1" {
+  cluster_id = "${openstack_containerinfra_cluster_v1.cluster_1.id}"
+  csr        = <<EOT
+%sEOT
+}
+`, testAccContainerInfraV1ClusterBasic(imageName, keypairName, clusterTemplateName, clusterName), csr)
+}
@@ -3,14 +3,15 @@ package openstack
 import (
 	"context"
 	"log"
-	"strconv"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/gophercloud/gophercloud/openstack/containerinfra/v1/certificates"
 	"github.com/gophercloud/gophercloud/openstack/containerinfra/v1/clusters"
 )
 
@@ -84,10 +85,21 @@ func resourceContainerInfraClusterV1() *schema.Resource {
 			"cluster_template_id": {
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
 				DefaultFunc: schema.EnvDefaultFunc("OS_MAGNUM_CLUSTER_TEMPLATE", nil),
 			},
 
+			"upgrade_on_template_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"max_batch_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
 			"container_version": {
 				Type:     schema.TypeString,
 				ForceNew: false,
@@ -163,6 +175,12 @@ func resourceContainerInfraClusterV1() *schema.Resource {
 				Computed: true,
 			},
 
+			"nodes_to_remove": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
 			"master_addresses": {
 				Type:     schema.TypeList,
 				ForceNew: false,
@@ -204,6 +222,11 @@ func resourceContainerInfraClusterV1() *schema.Resource {
 				Computed: true,
 			},
 
+			"ca_rotation_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
 			"kubeconfig": {
 				Type:      schema.TypeMap,
 				Computed:  true,
@@ -211,6 +234,15 @@ func resourceContainerInfraClusterV1() *schema.Resource {
 				Elem:      &schema.Schema{Type: schema.TypeString},
 			},
 		},
+
+		CustomizeDiff: customdiff.Sequence(
+			func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				return containerInfraClusterV1NodeCountCustomizeDiff(diff, meta)
+			},
+			func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				return containerInfraClusterV1TemplateCustomizeDiff(diff)
+			},
+		),
 	}
 }
 
@@ -371,25 +403,56 @@ func resourceContainerInfraClusterV1Update(ctx context.Context, d *schema.Resour
 		return diag.Errorf("Error creating OpenStack container infra client: %s", err)
 	}
 
-	updateOpts := []clusters.UpdateOptsBuilder{}
+	if d.HasChange("cluster_template_id") {
+		containerInfraClient.Microversion = containerInfraV1ClusterUpgradeMicroversion
 
-	if d.HasChange("node_count") {
-		v := d.Get("node_count").(int)
-		nodeCount := strconv.Itoa(v)
-		updateOpts = append(updateOpts, clusters.UpdateOpts{
-			Op:    clusters.ReplaceOp,
-			Path:  strings.Join([]string{"/", "node_count"}, ""),
-			Value: nodeCount,
-		})
+		upgradeOpts := clusters.UpgradeOpts{
+			ClusterTemplate: d.Get("cluster_template_id").(string),
+		}
+
+		if v, ok := d.GetOk("max_batch_size"); ok {
+			maxBatchSize := v.(int)
+			upgradeOpts.MaxBatchSize = &maxBatchSize
+		}
+
+		log.Printf(
+			"[DEBUG] Upgrading openstack_containerinfra_cluster_v1 %s with options: %#v", d.Id(), upgradeOpts)
+
+		_, err = clusters.Upgrade(containerInfraClient, d.Id(), upgradeOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error upgrading openstack_containerinfra_cluster_v1 %s: %s", d.Id(), err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:      []string{"UPDATE_IN_PROGRESS"},
+			Target:       []string{"UPDATE_COMPLETE"},
+			Refresh:      containerInfraClusterV1StateRefreshFunc(containerInfraClient, d.Id()),
+			Timeout:      d.Timeout(schema.TimeoutUpdate),
+			Delay:        1 * time.Minute,
+			PollInterval: 20 * time.Second,
+		}
+		_, err = stateConf.WaitForStateContext(ctx)
+		if err != nil {
+			return diag.Errorf(
+				"Error waiting for openstack_containerinfra_cluster_v1 %s to become upgraded: %s", d.Id(), err)
+		}
 	}
 
-	if len(updateOpts) > 0 {
+	if d.HasChange("node_count") {
+		containerInfraClient.Microversion = containerInfraV1ClusterResizeMicroversion
+
+		nodeCount := d.Get("node_count").(int)
+		resizeOpts := clusters.ResizeOpts{
+			NodeCount:     &nodeCount,
+			NodesToRemove: expandToStringSlice(d.Get("nodes_to_remove").([]interface{})),
+		}
+
 		log.Printf(
-			"[DEBUG] Updating openstack_containerinfra_cluster_v1 %s with options: %#v", d.Id(), updateOpts)
+			"[DEBUG] Resizing openstack_containerinfra_cluster_v1 %s with options: %#v", d.Id(), resizeOpts)
 
-		_, err = clusters.Update(containerInfraClient, d.Id(), updateOpts).Extract()
+		_, err = clusters.Resize(containerInfraClient, d.Id(), resizeOpts).Extract()
 		if err != nil {
-			return diag.Errorf("Error updating openstack_containerinfra_cluster_v1 %s: %s", d.Id(), err)
+			return diag.Errorf("Error resizing openstack_containerinfra_cluster_v1 %s: %s", d.Id(), err)
 		}
 
 		stateConf := &resource.StateChangeConf{
@@ -403,9 +466,35 @@ func resourceContainerInfraClusterV1Update(ctx context.Context, d *schema.Resour
 		_, err = stateConf.WaitForStateContext(ctx)
 		if err != nil {
 			return diag.Errorf(
-				"Error waiting for openstack_containerinfra_cluster_v1 %s to become updated: %s", d.Id(), err)
+				"Error waiting for openstack_containerinfra_cluster_v1 %s to become resized: %s", d.Id(), err)
 		}
 	}
+
+	// The CA rotation is triggered by any change of ca_rotation_trigger.
+	if d.HasChange("ca_rotation_trigger") {
+		log.Printf("[DEBUG] Rotating openstack_containerinfra_cluster_v1 %s CA", d.Id())
+
+		err = certificates.Update(containerInfraClient, d.Id()).ExtractErr()
+		if err != nil {
+			return diag.Errorf("Error rotating openstack_containerinfra_cluster_v1 %s CA: %s", d.Id(), err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:      []string{"UPDATE_IN_PROGRESS"},
+			Target:       []string{"UPDATE_COMPLETE"},
+			Refresh:      containerInfraClusterV1StateRefreshFunc(containerInfraClient, d.Id()),
+			Timeout:      d.Timeout(schema.TimeoutUpdate),
+			Delay:        1 * time.Minute,
+			PollInterval: 20 * time.Second,
+		}
+		_, err = stateConf.WaitForStateContext(ctx)
+		if err != nil {
+			return diag.Errorf(
+				"Error waiting for openstack_containerinfra_cluster_v1 %s CA to become rotated: %s", d.Id(), err)
+		}
+	}
+
+	// The kubeconfig is regenerated on read, when the CA was rotated.
 	return resourceContainerInfraClusterV1Read(ctx, d, meta)
 }
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -228,6 +229,25 @@ func resourceContainerInfraClusterTemplateV1() *schema.Resource {
 				Optional: true,
 				ForceNew: false,
 			},
+
+			"hidden": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: false,
+			},
+
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: false,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"validate_labels": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: false,
+			},
 		},
 	}
 }
@@ -253,31 +273,39 @@ func resourceContainerInfraClusterTemplateV1Create(ctx context.Context, d *schem
 		return diag.FromErr(err)
 	}
 
-	createOpts := clustertemplates.CreateOpts{
-		COE:                 d.Get("coe").(string),
-		DNSNameServer:       d.Get("dns_nameserver").(string),
-		DockerStorageDriver: d.Get("docker_storage_driver").(string),
-		ExternalNetworkID:   d.Get("external_network_id").(string),
-		FixedNetwork:        d.Get("fixed_network").(string),
-		FixedSubnet:         d.Get("fixed_subnet").(string),
-		FlavorID:            d.Get("flavor").(string),
-		MasterFlavorID:      d.Get("master_flavor").(string),
-		FloatingIPEnabled:   &floatingIPEnabled,
-		HTTPProxy:           d.Get("http_proxy").(string),
-		HTTPSProxy:          d.Get("https_proxy").(string),
-		ImageID:             d.Get("image").(string),
-		InsecureRegistry:    d.Get("insecure_registry").(string),
-		KeyPairID:           d.Get("keypair_id").(string),
-		Labels:              labels,
-		MasterLBEnabled:     &masterLBEnabled,
-		Name:                d.Get("name").(string),
-		NetworkDriver:       d.Get("network_driver").(string),
-		NoProxy:             d.Get("no_proxy").(string),
-		Public:              &public,
-		RegistryEnabled:     &registryEnabled,
-		ServerType:          d.Get("server_type").(string),
-		TLSDisabled:         &tlsDisabled,
-		VolumeDriver:        d.Get("volume_driver").(string),
+	createOpts := containerInfraClusterTemplateV1CreateOpts{
+		CreateOpts: clustertemplates.CreateOpts{
+			COE:                 d.Get("coe").(string),
+			DNSNameServer:       d.Get("dns_nameserver").(string),
+			DockerStorageDriver: d.Get("docker_storage_driver").(string),
+			ExternalNetworkID:   d.Get("external_network_id").(string),
+			FixedNetwork:        d.Get("fixed_network").(string),
+			FixedSubnet:         d.Get("fixed_subnet").(string),
+			FlavorID:            d.Get("flavor").(string),
+			MasterFlavorID:      d.Get("master_flavor").(string),
+			FloatingIPEnabled:   &floatingIPEnabled,
+			HTTPProxy:           d.Get("http_proxy").(string),
+			HTTPSProxy:          d.Get("https_proxy").(string),
+			ImageID:             d.Get("image").(string),
+			InsecureRegistry:    d.Get("insecure_registry").(string),
+			KeyPairID:           d.Get("keypair_id").(string),
+			Labels:              labels,
+			MasterLBEnabled:     &masterLBEnabled,
+			Name:                d.Get("name").(string),
+			NetworkDriver:       d.Get("network_driver").(string),
+			NoProxy:             d.Get("no_proxy").(string),
+			Public:              &public,
+			RegistryEnabled:     &registryEnabled,
+			ServerType:          d.Get("server_type").(string),
+			TLSDisabled:         &tlsDisabled,
+			VolumeDriver:        d.Get("volume_driver").(string),
+		},
+		Tags: strings.Join(expandToStringSlice(d.Get("tags").(*schema.Set).List()), ","),
+	}
+
+	hidden := d.Get("hidden").(bool)
+	if hidden {
+		createOpts.Hidden = &hidden
 	}
 
 	// Set int parameters that will be passed by reference.
@@ -300,7 +328,13 @@ func resourceContainerInfraClusterTemplateV1Create(ctx context.Context, d *schem
 	d.SetId(s.UUID)
 
 	log.Printf("[DEBUG] Created openstack_containerinfra_clustertemplate_v1 %s: %#v", s.UUID, s)
-	return resourceContainerInfraClusterTemplateV1Read(ctx, d, meta)
+
+	diags := resourceContainerInfraClusterTemplateV1Read(ctx, d, meta)
+	if d.Get("validate_labels").(bool) {
+		diags = append(diags, containerInfraClusterTemplateV1LabelsWarnings(d.Get("coe").(string), rawLabels)...)
+	}
+
+	return diags
 }
 
 func resourceContainerInfraClusterTemplateV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -310,7 +344,7 @@ func resourceContainerInfraClusterTemplateV1Read(_ context.Context, d *schema.Re
 		return diag.Errorf("Error creating OpenStack container infra client: %s", err)
 	}
 
-	s, err := clustertemplates.Get(containerInfraClient, d.Id()).Extract()
+	s, ext, err := containerInfraClusterTemplateV1Get(containerInfraClient, d.Id())
 	if err != nil {
 		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_containerinfra_clustertemplate_v1"))
 	}
@@ -346,6 +380,8 @@ func resourceContainerInfraClusterTemplateV1Read(_ context.Context, d *schema.Re
 	d.Set("server_type", s.ServerType)
 	d.Set("tls_disabled", s.TLSDisabled)
 	d.Set("volume_driver", s.VolumeDriver)
+	d.Set("hidden", ext.Hidden)
+	d.Set("tags", flattenContainerInfraClusterTemplateV1Tags(ext.Tags))
 	d.Set("region", GetRegion(d, config))
 	d.Set("name", s.Name)
 	d.Set("project_id", s.ProjectID)
@@ -521,15 +557,33 @@ func resourceContainerInfraClusterTemplateV1Update(ctx context.Context, d *schem
 		updateOpts = containerInfraClusterTemplateV1AppendUpdateOpts(updateOpts, "volume_driver", v)
 	}
 
-	log.Printf(
-		"[DEBUG] Updating openstack_containerinfra_clustertemplate_v1 %s with options: %#v", d.Id(), updateOpts)
+	if d.HasChange("hidden") {
+		v := d.Get("hidden").(bool)
+		hidden := strconv.FormatBool(v)
+		updateOpts = containerInfraClusterTemplateV1AppendUpdateOpts(updateOpts, "hidden", hidden)
+	}
 
-	_, err = clustertemplates.Update(containerInfraClient, d.Id(), updateOpts).Extract()
-	if err != nil {
-		return diag.Errorf("Error updating openstack_containerinfra_clustertemplate_v1 %s: %s", d.Id(), err)
+	if d.HasChange("tags") {
+		v := strings.Join(expandToStringSlice(d.Get("tags").(*schema.Set).List()), ",")
+		updateOpts = containerInfraClusterTemplateV1AppendUpdateOpts(updateOpts, "tags", v)
+	}
+
+	if len(updateOpts) > 0 {
+		log.Printf(
+			"[DEBUG] Updating openstack_containerinfra_clustertemplate_v1 %s with options: %#v", d.Id(), updateOpts)
+
+		_, err = clustertemplates.Update(containerInfraClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error updating openstack_containerinfra_clustertemplate_v1 %s: %s", d.Id(), err)
+		}
+	}
+
+	diags := resourceContainerInfraClusterTemplateV1Read(ctx, d, meta)
+	if d.Get("validate_labels").(bool) {
+		diags = append(diags, containerInfraClusterTemplateV1LabelsWarnings(d.Get("coe").(string), d.Get("labels").(map[string]interface{}))...)
 	}
 
-	return resourceContainerInfraClusterTemplateV1Read(ctx, d, meta)
+	return diags
 }
 
 func resourceContainerInfraClusterTemplateV1Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
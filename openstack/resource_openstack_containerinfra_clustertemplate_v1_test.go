@@ -46,6 +46,8 @@ func TestAccContainerInfraV1ClusterTemplate_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "http_proxy", ""),
 					resource.TestCheckResourceAttr(resourceName, "docker_storage_driver", "devicemapper"),
 					resource.TestCheckResourceAttr(resourceName, "docker_volume_size", strconv.Itoa(dockerVolumeSize)),
+					resource.TestCheckResourceAttr(resourceName, "hidden", "true"),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "2"),
 				),
 			},
 		},
@@ -175,6 +177,8 @@ resource "openstack_containerinfra_clustertemplate_v1" "clustertemplate_1" {
   coe = "kubernetes"
   docker_storage_driver = "devicemapper"
   docker_volume_size = %d
+  hidden = true
+  tags = ["foo", "bar"]
 }
 `, imageName, clusterTemplateName, dockerVolumeSize)
 }
@@ -0,0 +1,387 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/gophercloud/gophercloud/openstack/containerinfra/v1/clusters"
+	"github.com/gophercloud/gophercloud/openstack/containerinfra/v1/nodegroups"
+)
+
+func resourceContainerInfraNodeGroupV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceContainerInfraNodeGroupV1Create,
+		ReadContext:   resourceContainerInfraNodeGroupV1Read,
+		UpdateContext: resourceContainerInfraNodeGroupV1Update,
+		DeleteContext: resourceContainerInfraNodeGroupV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"docker_volume_size": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"merge_labels": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"role": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"node_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"min_node_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"max_node_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"image_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"flavor_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceContainerInfraNodeGroupV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	containerInfraClient, err := config.ContainerInfraV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack container infra client: %s", err)
+	}
+
+	containerInfraClient.Microversion = containerInfraV1NodeGroupMicroversion
+
+	// Get and check labels map.
+	rawLabels := d.Get("labels").(map[string]interface{})
+	labels, err := expandContainerInfraV1LabelsMap(rawLabels)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	createOpts := containerInfraNodeGroupV1CreateOpts{
+		CreateOpts: nodegroups.CreateOpts{
+			Name:         d.Get("name").(string),
+			Labels:       labels,
+			MinNodeCount: d.Get("min_node_count").(int),
+			Role:         d.Get("role").(string),
+			ImageID:      d.Get("image_id").(string),
+			FlavorID:     d.Get("flavor_id").(string),
+		},
+	}
+
+	// Set int parameters that will be passed by reference.
+	dockerVolumeSize := d.Get("docker_volume_size").(int)
+	if dockerVolumeSize > 0 {
+		createOpts.DockerVolumeSize = &dockerVolumeSize
+	}
+
+	if v, ok := d.GetOkExists("node_count"); ok {
+		nodeCount := v.(int)
+		createOpts.NodeCount = &nodeCount
+	}
+
+	if v, ok := d.GetOk("max_node_count"); ok {
+		maxNodeCount := v.(int)
+		createOpts.MaxNodeCount = &maxNodeCount
+	}
+
+	mergeLabels := d.Get("merge_labels").(bool)
+	if mergeLabels {
+		createOpts.MergeLabels = &mergeLabels
+	}
+
+	log.Printf("[DEBUG] openstack_containerinfra_nodegroup_v1 create options: %#v", createOpts)
+
+	ng, err := nodegroups.Create(containerInfraClient, clusterID, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating openstack_containerinfra_nodegroup_v1: %s", err)
+	}
+
+	id := clusterID + "/" + ng.UUID
+	d.SetId(id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"CREATE_IN_PROGRESS"},
+		Target:       []string{"CREATE_COMPLETE"},
+		Refresh:      containerInfraNodeGroupV1StateRefreshFunc(containerInfraClient, clusterID, ng.UUID),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        1 * time.Minute,
+		PollInterval: 20 * time.Second,
+	}
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf(
+			"Error waiting for openstack_containerinfra_nodegroup_v1 %s to become ready: %s", id, err)
+	}
+
+	log.Printf("[DEBUG] Created openstack_containerinfra_nodegroup_v1 %s", id)
+
+	return resourceContainerInfraNodeGroupV1Read(ctx, d, meta)
+}
+
+func resourceContainerInfraNodeGroupV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	containerInfraClient, err := config.ContainerInfraV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack container infra client: %s", err)
+	}
+
+	containerInfraClient.Microversion = containerInfraV1NodeGroupMicroversion
+
+	clusterID, nodeGroupID, err := containerInfraNodeGroupV1ParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ng, err := nodegroups.Get(containerInfraClient, clusterID, nodeGroupID).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_containerinfra_nodegroup_v1"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_containerinfra_nodegroup_v1 %s: %#v", d.Id(), ng)
+
+	if err := d.Set("labels", containerInfraNodeGroupV1Labels(d, ng.Labels)); err != nil {
+		return diag.Errorf("Unable to set openstack_containerinfra_nodegroup_v1 labels: %s", err)
+	}
+
+	d.Set("cluster_id", clusterID)
+	d.Set("name", ng.Name)
+	d.Set("project_id", ng.ProjectID)
+	d.Set("role", ng.Role)
+	d.Set("node_count", ng.NodeCount)
+	d.Set("min_node_count", ng.MinNodeCount)
+	d.Set("image_id", ng.ImageID)
+	d.Set("flavor_id", ng.FlavorID)
+	d.Set("region", GetRegion(d, config))
+
+	if ng.DockerVolumeSize != nil {
+		d.Set("docker_volume_size", *ng.DockerVolumeSize)
+	}
+
+	if ng.MaxNodeCount != nil {
+		d.Set("max_node_count", *ng.MaxNodeCount)
+	} else {
+		d.Set("max_node_count", nil)
+	}
+
+	if err := d.Set("created_at", ng.CreatedAt.Format(time.RFC3339)); err != nil {
+		log.Printf("[DEBUG] Unable to set openstack_containerinfra_nodegroup_v1 created_at: %s", err)
+	}
+	if err := d.Set("updated_at", ng.UpdatedAt.Format(time.RFC3339)); err != nil {
+		log.Printf("[DEBUG] Unable to set openstack_containerinfra_nodegroup_v1 updated_at: %s", err)
+	}
+
+	return nil
+}
+
+func resourceContainerInfraNodeGroupV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	containerInfraClient, err := config.ContainerInfraV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack container infra client: %s", err)
+	}
+
+	containerInfraClient.Microversion = containerInfraV1NodeGroupMicroversion
+
+	clusterID, nodeGroupID, err := containerInfraNodeGroupV1ParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"UPDATE_IN_PROGRESS"},
+		Target:       []string{"UPDATE_COMPLETE"},
+		Refresh:      containerInfraNodeGroupV1StateRefreshFunc(containerInfraClient, clusterID, nodeGroupID),
+		Timeout:      d.Timeout(schema.TimeoutUpdate),
+		Delay:        1 * time.Minute,
+		PollInterval: 20 * time.Second,
+	}
+
+	updateOpts := []nodegroups.UpdateOptsBuilder{}
+
+	if d.HasChange("min_node_count") {
+		updateOpts = append(updateOpts, nodegroups.UpdateOpts{
+			Op:    nodegroups.ReplaceOp,
+			Path:  "/min_node_count",
+			Value: d.Get("min_node_count").(int),
+		})
+	}
+
+	if d.HasChange("max_node_count") {
+		if v, ok := d.GetOk("max_node_count"); ok {
+			updateOpts = append(updateOpts, nodegroups.UpdateOpts{
+				Op:    nodegroups.ReplaceOp,
+				Path:  "/max_node_count",
+				Value: v.(int),
+			})
+		} else {
+			updateOpts = append(updateOpts, nodegroups.UpdateOpts{
+				Op:   nodegroups.RemoveOp,
+				Path: "/max_node_count",
+			})
+		}
+	}
+
+	if len(updateOpts) > 0 {
+		log.Printf(
+			"[DEBUG] Updating openstack_containerinfra_nodegroup_v1 %s with options: %#v", d.Id(), updateOpts)
+
+		_, err = nodegroups.Update(containerInfraClient, clusterID, nodeGroupID, updateOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error updating openstack_containerinfra_nodegroup_v1 %s: %s", d.Id(), err)
+		}
+
+		_, err = stateConf.WaitForStateContext(ctx)
+		if err != nil {
+			return diag.Errorf(
+				"Error waiting for openstack_containerinfra_nodegroup_v1 %s to become updated: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("node_count") {
+		nodeCount := d.Get("node_count").(int)
+		resizeOpts := clusters.ResizeOpts{
+			NodeCount: &nodeCount,
+			NodeGroup: nodeGroupID,
+		}
+
+		log.Printf(
+			"[DEBUG] Resizing openstack_containerinfra_nodegroup_v1 %s with options: %#v", d.Id(), resizeOpts)
+
+		_, err = clusters.Resize(containerInfraClient, clusterID, resizeOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error resizing openstack_containerinfra_nodegroup_v1 %s: %s", d.Id(), err)
+		}
+
+		_, err = stateConf.WaitForStateContext(ctx)
+		if err != nil {
+			return diag.Errorf(
+				"Error waiting for openstack_containerinfra_nodegroup_v1 %s to become resized: %s", d.Id(), err)
+		}
+	}
+
+	return resourceContainerInfraNodeGroupV1Read(ctx, d, meta)
+}
+
+func resourceContainerInfraNodeGroupV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	containerInfraClient, err := config.ContainerInfraV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack container infra client: %s", err)
+	}
+
+	containerInfraClient.Microversion = containerInfraV1NodeGroupMicroversion
+
+	clusterID, nodeGroupID, err := containerInfraNodeGroupV1ParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := nodegroups.Delete(containerInfraClient, clusterID, nodeGroupID).ExtractErr(); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_containerinfra_nodegroup_v1"))
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"DELETE_IN_PROGRESS"},
+		Target:       []string{"DELETE_COMPLETE"},
+		Refresh:      containerInfraNodeGroupV1StateRefreshFunc(containerInfraClient, clusterID, nodeGroupID),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        30 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf(
+			"Error waiting for openstack_containerinfra_nodegroup_v1 %s to become deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/gophercloud/gophercloud/openstack/containerinfra/v1/nodegroups"
+)
+
+func TestAccContainerInfraV1NodeGroup_basic(t *testing.T) {
+	var nodeGroup nodegroups.NodeGroup
+
+	resourceName := "openstack_containerinfra_nodegroup_v1.nodegroup_1"
+	clusterName := acctest.RandomWithPrefix("tf-acc-cluster")
+	imageName := acctest.RandomWithPrefix("tf-acc-image")
+	keypairName := acctest.RandomWithPrefix("tf-acc-keypair")
+	clusterTemplateName := acctest.RandomWithPrefix("tf-acc-clustertemplate")
+	nodeGroupName := acctest.RandomWithPrefix("tf-acc-nodegroup")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckContainerInfra(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckContainerInfraV1NodeGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerInfraV1NodeGroupBasic(imageName, keypairName, clusterTemplateName, clusterName, nodeGroupName, 1, 1, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerInfraV1NodeGroupExists(resourceName, &nodeGroup),
+					resource.TestCheckResourceAttr(resourceName, "name", nodeGroupName),
+					resource.TestCheckResourceAttr(resourceName, "node_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "min_node_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "max_node_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "labels.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "labels.kubescheduler_options", "log-flush-frequency=2m"),
+				),
+			},
+			{
+				Config: testAccContainerInfraV1NodeGroupBasic(imageName, keypairName, clusterTemplateName, clusterName, nodeGroupName, 2, 0, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerInfraV1NodeGroupExists(resourceName, &nodeGroup),
+					resource.TestCheckResourceAttr(resourceName, "name", nodeGroupName),
+					resource.TestCheckResourceAttr(resourceName, "node_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "min_node_count", "0"),
+					resource.TestCheckResourceAttr(resourceName, "max_node_count", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckContainerInfraV1NodeGroupExists(n string, nodeGroup *nodegroups.NodeGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		containerInfraClient, err := config.ContainerInfraV1Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack container infra client: %s", err)
+		}
+
+		containerInfraClient.Microversion = containerInfraV1NodeGroupMicroversion
+
+		clusterID, nodeGroupID, err := containerInfraNodeGroupV1ParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		found, err := nodegroups.Get(containerInfraClient, clusterID, nodeGroupID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.UUID != nodeGroupID {
+			return fmt.Errorf("Node group not found")
+		}
+
+		*nodeGroup = *found
+
+		return nil
+	}
+}
+
+func testAccCheckContainerInfraV1NodeGroupDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	containerInfraClient, err := config.ContainerInfraV1Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack container infra client: %s", err)
+	}
+
+	containerInfraClient.Microversion = containerInfraV1NodeGroupMicroversion
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_containerinfra_nodegroup_v1" {
+			continue
+		}
+
+		clusterID, nodeGroupID, err := containerInfraNodeGroupV1ParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = nodegroups.Get(containerInfraClient, clusterID, nodeGroupID).Extract()
+		if err == nil {
+			return fmt.Errorf("Node group still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccContainerInfraV1NodeGroupBasic(imageName, keypairName, clusterTemplateName, clusterName, nodeGroupName string, nodeCount, minNodeCount, maxNodeCount int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_containerinfra_nodegroup_v1" "nodegroup_1" {
+  name           = "%s"
+  cluster_id     = "${openstack_containerinfra_cluster_v1.cluster_1.id}"
+  node_count     = %d
+  min_node_count = %d
+  max_node_count = %d
+  merge_labels   = true
+  labels = {
+    kubescheduler_options = "log-flush-frequency=2m"
+  }
+}
+`, testAccContainerInfraV1ClusterBasic(imageName, keypairName, clusterTemplateName, clusterName), nodeGroupName, nodeCount, minNodeCount, maxNodeCount)
+}
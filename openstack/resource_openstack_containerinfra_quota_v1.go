@@ -0,0 +1,170 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/containerinfra/v1/quotas"
+)
+
+func resourceContainerInfraQuotaV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceContainerInfraQuotaV1Create,
+		ReadContext:   resourceContainerInfraQuotaV1Read,
+		UpdateContext: resourceContainerInfraQuotaV1Update,
+		DeleteContext: resourceContainerInfraQuotaV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "Cluster",
+				ValidateFunc: validation.StringInSlice([]string{
+					"Cluster",
+				}, false),
+			},
+
+			"hard_limit": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceContainerInfraQuotaV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	containerInfraClient, err := config.ContainerInfraV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack container infra client: %s", err)
+	}
+
+	createOpts := quotas.CreateOpts{
+		ProjectID: d.Get("project_id").(string),
+		Resource:  d.Get("resource").(string),
+		HardLimit: d.Get("hard_limit").(int),
+	}
+
+	log.Printf("[DEBUG] openstack_containerinfra_quota_v1 create options: %#v", createOpts)
+
+	q, err := quotas.Create(containerInfraClient, createOpts).Extract()
+	if _, ok := err.(gophercloud.ErrDefault409); ok {
+		// The quota already exists, update it instead.
+		updateOpts := containerInfraQuotaV1UpdateOpts{
+			ProjectID: createOpts.ProjectID,
+			Resource:  createOpts.Resource,
+			HardLimit: createOpts.HardLimit,
+		}
+		q, err = containerInfraQuotaV1Update(containerInfraClient, updateOpts).Extract()
+	}
+	if err != nil {
+		return diag.Errorf("Error creating openstack_containerinfra_quota_v1: %s", err)
+	}
+
+	id := createOpts.ProjectID + "/" + createOpts.Resource
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Created openstack_containerinfra_quota_v1 %#v", q)
+
+	return resourceContainerInfraQuotaV1Read(ctx, d, meta)
+}
+
+func resourceContainerInfraQuotaV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	containerInfraClient, err := config.ContainerInfraV1Client(region)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack container infra client: %s", err)
+	}
+
+	projectID, resource, err := containerInfraQuotaV1ParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	q, err := containerInfraQuotaV1Get(containerInfraClient, projectID, resource).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_containerinfra_quota_v1"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_containerinfra_quota_v1 %s: %#v", d.Id(), q)
+
+	d.Set("project_id", projectID)
+	d.Set("resource", resource)
+	d.Set("hard_limit", q.HardLimit)
+	d.Set("region", region)
+
+	return nil
+}
+
+func resourceContainerInfraQuotaV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	containerInfraClient, err := config.ContainerInfraV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack container infra client: %s", err)
+	}
+
+	projectID, resource, err := containerInfraQuotaV1ParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("hard_limit") {
+		updateOpts := containerInfraQuotaV1UpdateOpts{
+			ProjectID: projectID,
+			Resource:  resource,
+			HardLimit: d.Get("hard_limit").(int),
+		}
+
+		log.Printf("[DEBUG] openstack_containerinfra_quota_v1 %s update options: %#v", d.Id(), updateOpts)
+
+		_, err = containerInfraQuotaV1Update(containerInfraClient, updateOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error updating openstack_containerinfra_quota_v1: %s", err)
+		}
+	}
+
+	return resourceContainerInfraQuotaV1Read(ctx, d, meta)
+}
+
+func resourceContainerInfraQuotaV1Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	containerInfraClient, err := config.ContainerInfraV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack container infra client: %s", err)
+	}
+
+	projectID, resource, err := containerInfraQuotaV1ParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Deleting the quota resets it to the default value.
+	if err := containerInfraQuotaV1Delete(containerInfraClient, projectID, resource).ExtractErr(); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_containerinfra_quota_v1"))
+	}
+
+	return nil
+}
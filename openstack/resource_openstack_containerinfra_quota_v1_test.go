@@ -0,0 +1,79 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/projects"
+)
+
+func TestAccContainerInfraV1Quota_basic(t *testing.T) {
+	var project projects.Project
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckContainerInfra(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckIdentityV3ProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerInfraV1QuotaBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3ProjectExists("openstack_identity_project_v3.project_1", &project),
+					resource.TestCheckResourceAttr(
+						"openstack_containerinfra_quota_v1.quota_1", "resource", "Cluster"),
+					resource.TestCheckResourceAttr(
+						"openstack_containerinfra_quota_v1.quota_1", "hard_limit", "5"),
+				),
+			},
+			{
+				Config: testAccContainerInfraV1QuotaUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3ProjectExists("openstack_identity_project_v3.project_1", &project),
+					resource.TestCheckResourceAttr(
+						"openstack_containerinfra_quota_v1.quota_1", "hard_limit", "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestContainerInfraQuotaV1ParseID(t *testing.T) {
+	projectID, resource, err := containerInfraQuotaV1ParseID("0b4d6e2a3e6d48f4b3b3e5f3c8a1b7d2/Cluster")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if projectID != "0b4d6e2a3e6d48f4b3b3e5f3c8a1b7d2" || resource != "Cluster" {
+		t.Fatalf("unexpected result: %s, %s", projectID, resource)
+	}
+
+	if _, _, err := containerInfraQuotaV1ParseID("0b4d6e2a3e6d48f4b3b3e5f3c8a1b7d2"); err == nil {
+		t.Fatal("expected an error for an ID without a resource")
+	}
+}
+
+const testAccContainerInfraV1QuotaBasic = `
+resource "openstack_identity_project_v3" "project_1" {
+  name = "project_1"
+}
+
+resource "openstack_containerinfra_quota_v1" "quota_1" {
+  project_id = "${openstack_identity_project_v3.project_1.id}"
+  hard_limit = 5
+}
+`
+
+const testAccContainerInfraV1QuotaUpdate = `
+resource "openstack_identity_project_v3" "project_1" {
+  name = "project_1"
+}
+
+resource "openstack_containerinfra_quota_v1" "quota_1" {
+  project_id = "${openstack_identity_project_v3.project_1.id}"
+  hard_limit = 10
+}
+`
@@ -16,6 +16,7 @@ func resourceDatabaseConfigurationV1() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceDatabaseConfigurationV1Create,
 		ReadContext:   resourceDatabaseConfigurationV1Read,
+		UpdateContext: resourceDatabaseConfigurationV1Update,
 		DeleteContext: resourceDatabaseConfigurationV1Delete,
 
 		Timeouts: &schema.ResourceTimeout{
@@ -34,13 +35,11 @@ func resourceDatabaseConfigurationV1() *schema.Resource {
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 
 			"description": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 
 			"datastore": {
@@ -67,18 +66,15 @@ func resourceDatabaseConfigurationV1() *schema.Resource {
 			"configuration": {
 				Type:     schema.TypeList,
 				Optional: true,
-				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 						"value": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 					},
 				},
@@ -159,6 +155,47 @@ func resourceDatabaseConfigurationV1Read(_ context.Context, d *schema.ResourceDa
 	return nil
 }
 
+func resourceDatabaseConfigurationV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	DatabaseV1Client, err := config.DatabaseV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack database client: %s", err)
+	}
+
+	var updateOpts configurations.UpdateOpts
+
+	if d.HasChange("name") {
+		updateOpts.Name = d.Get("name").(string)
+	}
+
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	// PATCH only sets the given values, removed values require a PUT of all
+	// values.
+	var replace bool
+	if d.HasChange("configuration") {
+		o, n := d.GetChange("configuration")
+		updateOpts.Values = expandDatabaseConfigurationV1Values(n.([]interface{}))
+		replace = databaseConfigurationV1ValuesRemoved(expandDatabaseConfigurationV1Values(o.([]interface{})), updateOpts.Values)
+	}
+
+	log.Printf("[DEBUG] openstack_db_configuration_v1 %s update options: %#v", d.Id(), updateOpts)
+
+	if replace {
+		err = configurations.Replace(DatabaseV1Client, d.Id(), updateOpts).ExtractErr()
+	} else {
+		err = configurations.Update(DatabaseV1Client, d.Id(), updateOpts).ExtractErr()
+	}
+	if err != nil {
+		return diag.Errorf("Error updating openstack_db_configuration_v1 %s: %s", d.Id(), err)
+	}
+
+	return resourceDatabaseConfigurationV1Read(ctx, d, meta)
+}
+
 func resourceDatabaseConfigurationV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	DatabaseV1Client, err := config.DatabaseV1Client(GetRegion(d, config))
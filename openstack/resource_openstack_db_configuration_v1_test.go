@@ -35,6 +35,21 @@ func TestAccDatabaseV1Configuration_basic(t *testing.T) {
 						"openstack_db_configuration_v1.basic", "configuration.2.value", "200"),
 				),
 			},
+			{
+				Config: testAccDatabaseV1ConfigurationUpdate(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseV1ConfigurationExists(
+						"openstack_db_configuration_v1.basic", &configuration),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_db_configuration_v1.basic", "id", &configuration.ID),
+					resource.TestCheckResourceAttr(
+						"openstack_db_configuration_v1.basic", "description", "updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_db_configuration_v1.basic", "configuration.#", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_db_configuration_v1.basic", "configuration.1.value", "300"),
+				),
+			},
 		},
 	})
 }
@@ -121,3 +136,27 @@ resource "openstack_db_configuration_v1" "basic" {
 }
 `, osDBDatastoreVersion, osDBDatastoreType)
 }
+
+func testAccDatabaseV1ConfigurationUpdate() string {
+	return fmt.Sprintf(`
+resource "openstack_db_configuration_v1" "basic" {
+  name        = "basic"
+  description = "updated"
+
+  datastore {
+    version = "%s"
+    type    = "%s"
+  }
+
+  configuration {
+    name  = "collation_server"
+    value = "latin1_swedish_ci"
+  }
+
+  configuration {
+    name  = "max_connections"
+    value = 300
+  }
+}
+`, osDBDatastoreVersion, osDBDatastoreType)
+}
@@ -2,13 +2,17 @@ package openstack
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/db/v1/databases"
 	"github.com/gophercloud/gophercloud/openstack/db/v1/instances"
 	"github.com/gophercloud/gophercloud/openstack/db/v1/users"
@@ -21,8 +25,18 @@ func resourceDatabaseInstanceV1() *schema.Resource {
 		DeleteContext: resourceDatabaseInstanceV1Delete,
 		UpdateContext: resourceDatabaseInstanceUpdate,
 
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceDatabaseInstanceV1V0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceDatabaseInstanceV1StateUpgradeV0,
+				Version: 0,
+			},
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
@@ -42,7 +56,6 @@ func resourceDatabaseInstanceV1() *schema.Resource {
 			"flavor_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				ForceNew:    true,
 				Computed:    true,
 				DefaultFunc: schema.EnvDefaultFunc("OS_FLAVOR_ID", nil),
 			},
@@ -50,7 +63,6 @@ func resourceDatabaseInstanceV1() *schema.Resource {
 			"size": {
 				Type:     schema.TypeInt,
 				Required: true,
-				ForceNew: true,
 			},
 
 			"datastore": {
@@ -168,16 +180,102 @@ func resourceDatabaseInstanceV1() *schema.Resource {
 				ForceNew: false,
 			},
 
+			"restore_point": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backup_ref": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
+			"replica_of": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				// A promoted replica no longer reports its former source.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Get("promote_to_replica_source").(bool)
+				},
+			},
+
+			"replica_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"replica_of"},
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"promote_to_replica_source": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"replicas": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"access": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"is_public": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"allowed_cidrs": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"restart_required": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
 			"addresses": {
 				Type:     schema.TypeList,
-				Optional: false,
 				Computed: true,
-				ForceNew: false,
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
 				},
 			},
 		},
+
+		CustomizeDiff: customdiff.Sequence(
+			func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceDatabaseInstanceV1SizeCustomizeDiff(diff)
+			},
+		),
 	}
 }
 
@@ -188,10 +286,16 @@ func resourceDatabaseInstanceV1Create(ctx context.Context, d *schema.ResourceDat
 		return diag.Errorf("Error creating OpenStack database client: %s", err)
 	}
 
-	createOpts := &instances.CreateOpts{
-		FlavorRef: d.Get("flavor_id").(string),
-		Name:      d.Get("name").(string),
-		Size:      d.Get("size").(int),
+	createOpts := &databaseInstanceV1CreateOpts{
+		CreateOpts: instances.CreateOpts{
+			FlavorRef: d.Get("flavor_id").(string),
+			Name:      d.Get("name").(string),
+			Size:      d.Get("size").(int),
+		},
+		ReplicaOf:    d.Get("replica_of").(string),
+		ReplicaCount: d.Get("replica_count").(int),
+		RestorePoint: expandDatabaseInstanceV1RestorePoint(d.Get("restore_point").([]interface{})),
+		Access:       expandDatabaseInstanceV1Access(d.Get("access").([]interface{})),
 	}
 
 	// datastore
@@ -258,6 +362,13 @@ func resourceDatabaseInstanceV1Create(ctx context.Context, d *schema.ResourceDat
 	// Store the ID now
 	d.SetId(instance.ID)
 
+	if d.Get("replica_of").(string) != "" && d.Get("promote_to_replica_source").(bool) {
+		err = databaseInstanceV1Promote(ctx, d, DatabaseV1Client, schema.TimeoutCreate)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceDatabaseInstanceV1Read(ctx, d, meta)
 }
 
@@ -268,7 +379,8 @@ func resourceDatabaseInstanceV1Read(_ context.Context, d *schema.ResourceData, m
 		return diag.Errorf("Error creating OpenStack database client: %s", err)
 	}
 
-	instance, err := instances.Get(DatabaseV1Client, d.Id()).Extract()
+	r := instances.Get(DatabaseV1Client, d.Id())
+	instance, err := r.Extract()
 	if err != nil {
 		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_db_instance_v1"))
 	}
@@ -276,10 +388,30 @@ func resourceDatabaseInstanceV1Read(_ context.Context, d *schema.ResourceData, m
 	log.Printf("[DEBUG] Retrieved openstack_db_instance_v1 %s: %#v", d.Id(), instance)
 
 	d.Set("name", instance.Name)
-	d.Set("flavor_id", instance.Flavor)
+	d.Set("flavor_id", instance.Flavor.ID)
+	d.Set("size", instance.Volume.Size)
 	d.Set("datastore", instance.Datastore)
 	d.Set("region", GetRegion(d, config))
-	d.Set("addresses", instance.IP)
+	d.Set("restart_required", instance.Status == "RESTART_REQUIRED")
+	d.Set("addresses", flattenDatabaseInstanceV1Addresses(instance))
+
+	access, err := databaseInstanceV1ExtractAccess(r)
+	if err != nil {
+		return diag.Errorf("Error retrieving openstack_db_instance_v1 %s access: %s", d.Id(), err)
+	}
+	d.Set("access", flattenDatabaseInstanceV1Access(access))
+
+	replication, err := databaseInstanceV1ExtractReplication(r)
+	if err != nil {
+		return diag.Errorf("Error retrieving openstack_db_instance_v1 %s replication status: %s", d.Id(), err)
+	}
+
+	if replication.ReplicaOf != nil {
+		d.Set("replica_of", replication.ReplicaOf.ID)
+	} else {
+		d.Set("replica_of", "")
+	}
+	d.Set("replicas", flattenDatabaseInstanceV1Replicas(replication.Replicas))
 
 	return nil
 }
@@ -291,14 +423,74 @@ func resourceDatabaseInstanceUpdate(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("Error creating OpenStack database client: %s", err)
 	}
 
-	if d.HasChange("configuration_id") {
-		o, n := d.GetChange("configuration_id")
+	if d.HasChange("flavor_id") {
+		flavorID := d.Get("flavor_id").(string)
+		log.Printf("[DEBUG] Resizing openstack_db_instance_v1 %s to flavor %s", d.Id(), flavorID)
+
+		err := instances.Resize(DatabaseV1Client, d.Id(), flavorID).ExtractErr()
+		if err != nil {
+			return diag.Errorf("Error resizing openstack_db_instance_v1 %s flavor: %s", d.Id(), err)
+		}
+
+		err = databaseInstanceV1WaitForResize(ctx, d, DatabaseV1Client)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("size") {
+		size := d.Get("size").(int)
+		log.Printf("[DEBUG] Resizing openstack_db_instance_v1 %s volume to %d GB", d.Id(), size)
+
+		err := instances.ResizeVolume(DatabaseV1Client, d.Id(), size).ExtractErr()
+		if err != nil {
+			return diag.Errorf("Error resizing openstack_db_instance_v1 %s volume: %s", d.Id(), err)
+		}
 
-		err := instances.DetachConfigurationGroup(DatabaseV1Client, d.Id()).ExtractErr()
+		err = databaseInstanceV1WaitForResize(ctx, d, DatabaseV1Client)
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		log.Printf("Detaching configuration %s from openstack_db_instance_v1 %s", o, d.Id())
+	}
+
+	if d.HasChange("access") {
+		access := expandDatabaseInstanceV1Access(d.Get("access").([]interface{}))
+		if access == nil {
+			access = &databaseInstanceV1AccessOpts{}
+		}
+
+		log.Printf("[DEBUG] Updating openstack_db_instance_v1 %s access: %#v", d.Id(), access)
+
+		err := databaseInstanceV1UpdateAccess(DatabaseV1Client, d.Id(), *access).ExtractErr()
+		if err != nil {
+			return diag.Errorf("Error updating openstack_db_instance_v1 %s access: %s", d.Id(), err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"BUILD", "UPDATE"},
+			Target:     []string{"ACTIVE", "HEALTHY", "RESTART_REQUIRED"},
+			Refresh:    databaseInstanceV1StateRefreshFunc(DatabaseV1Client, d.Id()),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			Delay:      10 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		_, err = stateConf.WaitForStateContext(ctx)
+		if err != nil {
+			return diag.Errorf("Error waiting for openstack_db_instance_v1 %s access to be updated: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("configuration_id") {
+		o, n := d.GetChange("configuration_id")
+
+		if o != "" {
+			err := instances.DetachConfigurationGroup(DatabaseV1Client, d.Id()).ExtractErr()
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			log.Printf("Detaching configuration %s from openstack_db_instance_v1 %s", o, d.Id())
+		}
 
 		if n != "" {
 			err := instances.AttachConfigurationGroup(DatabaseV1Client, d.Id(), n.(string)).ExtractErr()
@@ -309,9 +501,62 @@ func resourceDatabaseInstanceUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	if d.HasChange("promote_to_replica_source") && d.Get("promote_to_replica_source").(bool) {
+		o, _ := d.GetChange("replica_of")
+		if o.(string) != "" {
+			err = databaseInstanceV1Promote(ctx, d, DatabaseV1Client, schema.TimeoutUpdate)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
 	return resourceDatabaseInstanceV1Read(ctx, d, meta)
 }
 
+func databaseInstanceV1WaitForResize(ctx context.Context, d *schema.ResourceData, client *gophercloud.ServiceClient) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"RESIZE"},
+		Target:     []string{"ACTIVE", "HEALTHY", "RESTART_REQUIRED"},
+		Refresh:    databaseInstanceV1StateRefreshFunc(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("Error waiting for openstack_db_instance_v1 %s to be resized: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func databaseInstanceV1Promote(ctx context.Context, d *schema.ResourceData, client *gophercloud.ServiceClient, timeout string) error {
+	log.Printf("[DEBUG] Promoting openstack_db_instance_v1 %s to replica source", d.Id())
+
+	err := databaseInstanceV1PromoteToReplicaSource(client, d.Id()).ExtractErr()
+	if err != nil {
+		return fmt.Errorf("Error promoting openstack_db_instance_v1 %s to replica source: %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PROMOTE"},
+		Target:     []string{"ACTIVE", "HEALTHY", "RESTART_REQUIRED"},
+		Refresh:    databaseInstanceV1StateRefreshFunc(client, d.Id()),
+		Timeout:    d.Timeout(timeout),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("Error waiting for openstack_db_instance_v1 %s to be promoted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
 func resourceDatabaseInstanceV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	DatabaseV1Client, err := config.DatabaseV1Client(GetRegion(d, config))
@@ -325,7 +570,7 @@ func resourceDatabaseInstanceV1Delete(ctx context.Context, d *schema.ResourceDat
 	}
 
 	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"ACTIVE", "SHUTDOWN"},
+		Pending:    []string{"ACTIVE", "SHUTDOWN", "RESTART_REQUIRED"},
 		Target:     []string{"DELETED"},
 		Refresh:    databaseInstanceV1StateRefreshFunc(DatabaseV1Client, d.Id()),
 		Timeout:    d.Timeout(schema.TimeoutDelete),
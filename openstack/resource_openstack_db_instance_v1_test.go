@@ -2,6 +2,7 @@ package openstack
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -57,6 +58,132 @@ func TestAccDatabaseV1Instance_basic(t *testing.T) {
 	})
 }
 
+func TestAccDatabaseV1Instance_resize(t *testing.T) {
+	var instance instances.Instance
+	var resized instances.Instance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDatabase(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDatabaseV1InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseV1InstanceResize(10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseV1InstanceExists(
+						"openstack_db_instance_v1.resize", &instance),
+					resource.TestCheckResourceAttr(
+						"openstack_db_instance_v1.resize", "size", "10"),
+				),
+			},
+			{
+				Config: testAccDatabaseV1InstanceResize(20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseV1InstanceExists(
+						"openstack_db_instance_v1.resize", &resized),
+					resource.TestCheckResourceAttr(
+						"openstack_db_instance_v1.resize", "size", "20"),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_db_instance_v1.resize", "id", &instance.ID),
+				),
+			},
+			{
+				Config:      testAccDatabaseV1InstanceResize(15),
+				ExpectError: regexp.MustCompile("volume size can't be decreased"),
+			},
+		},
+	})
+}
+
+func TestAccDatabaseV1Instance_access(t *testing.T) {
+	var instance instances.Instance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDatabase(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDatabaseV1InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseV1InstanceAccess(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseV1InstanceExists(
+						"openstack_db_instance_v1.access", &instance),
+					resource.TestCheckResourceAttr(
+						"openstack_db_instance_v1.access", "access.0.is_public", "false"),
+					resource.TestCheckResourceAttr(
+						"openstack_db_instance_v1.access", "addresses.0.type", "private"),
+				),
+			},
+			{
+				Config: testAccDatabaseV1InstanceAccess(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseV1InstanceExists(
+						"openstack_db_instance_v1.access", &instance),
+					resource.TestCheckResourceAttr(
+						"openstack_db_instance_v1.access", "access.0.is_public", "true"),
+					resource.TestCheckResourceAttr(
+						"openstack_db_instance_v1.access", "access.0.allowed_cidrs.#", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_db_instance_v1.access", "access.0.allowed_cidrs.0", "10.0.0.0/8"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatabaseV1Instance_replica(t *testing.T) {
+	var primary instances.Instance
+	var replica instances.Instance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDatabase(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDatabaseV1InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseV1InstanceReplica(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseV1InstanceExists(
+						"openstack_db_instance_v1.primary", &primary),
+					testAccCheckDatabaseV1InstanceExists(
+						"openstack_db_instance_v1.replica", &replica),
+					resource.TestCheckResourceAttrPair(
+						"openstack_db_instance_v1.replica", "replica_of",
+						"openstack_db_instance_v1.primary", "id"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_db_instance_v1.primary", "replicas.0",
+						"openstack_db_instance_v1.replica", "id"),
+				),
+			},
+			{
+				Config: testAccDatabaseV1InstanceReplica(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_db_instance_v1.replica", "replica_of", ""),
+					resource.TestCheckResourceAttr(
+						"openstack_db_instance_v1.replica", "promote_to_replica_source", "true"),
+				),
+			},
+			{
+				Config:   testAccDatabaseV1InstanceReplica(true),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccCheckDatabaseV1InstanceExists(n string, instance *instances.Instance) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -175,3 +302,79 @@ resource "openstack_db_configuration_v1" "basic" {
 }
 `, osDBDatastoreVersion, osDBDatastoreType, osNetworkID)
 }
+
+func testAccDatabaseV1InstanceReplica(promote bool) string {
+	return fmt.Sprintf(`
+resource "openstack_db_instance_v1" "primary" {
+  name = "primary"
+  size = 10
+
+  datastore {
+    version = "%[1]s"
+    type    = "%[2]s"
+  }
+
+  network {
+    uuid = "%[3]s"
+  }
+}
+
+resource "openstack_db_instance_v1" "replica" {
+  name       = "replica"
+  size       = 10
+  replica_of = "${openstack_db_instance_v1.primary.id}"
+
+  promote_to_replica_source = %[4]t
+
+  datastore {
+    version = "%[1]s"
+    type    = "%[2]s"
+  }
+
+  network {
+    uuid = "%[3]s"
+  }
+}
+`, osDBDatastoreVersion, osDBDatastoreType, osNetworkID, promote)
+}
+
+func testAccDatabaseV1InstanceResize(size int) string {
+	return fmt.Sprintf(`
+resource "openstack_db_instance_v1" "resize" {
+  name = "resize"
+  size = %[4]d
+
+  datastore {
+    version = "%[1]s"
+    type    = "%[2]s"
+  }
+
+  network {
+    uuid = "%[3]s"
+  }
+}
+`, osDBDatastoreVersion, osDBDatastoreType, osNetworkID, size)
+}
+
+func testAccDatabaseV1InstanceAccess(public bool) string {
+	return fmt.Sprintf(`
+resource "openstack_db_instance_v1" "access" {
+  name = "access"
+  size = 10
+
+  access {
+    is_public     = %[4]t
+    allowed_cidrs = ["10.0.0.0/8"]
+  }
+
+  datastore {
+    version = "%[1]s"
+    type    = "%[2]s"
+  }
+
+  network {
+    uuid = "%[3]s"
+  }
+}
+`, osDBDatastoreVersion, osDBDatastoreType, osNetworkID, public)
+}
@@ -0,0 +1,188 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDNSPTRRecordV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDNSPTRRecordV2Create,
+		ReadContext:   resourceDNSPTRRecordV2Read,
+		UpdateContext: resourceDNSPTRRecordV2Update,
+		DeleteContext: resourceDNSPTRRecordV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"floatingip_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"ptrdname": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"ttl": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDNSPTRRecordV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	dnsClient, err := config.DNSV2Client(region)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	id := dnsPTRRecordV2ID(region, d.Get("floatingip_id").(string))
+	opts := map[string]interface{}{
+		"ptrdname":    d.Get("ptrdname").(string),
+		"description": d.Get("description").(string),
+	}
+	if v, ok := d.GetOk("ttl"); ok {
+		opts["ttl"] = v.(int)
+	}
+
+	log.Printf("[DEBUG] openstack_dns_ptr_record_v2 %s create options: %#v", id, opts)
+
+	if _, err := dnsPTRRecordV2Set(dnsClient, id, opts).Extract(); err != nil {
+		return diag.Errorf("Error creating openstack_dns_ptr_record_v2 %s: %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if err := waitForDNSPTRRecordV2(ctx, dnsClient, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Created openstack_dns_ptr_record_v2 %s", id)
+
+	return resourceDNSPTRRecordV2Read(ctx, d, meta)
+}
+
+func resourceDNSPTRRecordV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	region, floatingIPID, err := dnsPTRRecordV2ParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dnsClient, err := config.DNSV2Client(region)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	ptr, err := dnsPTRRecordV2Get(dnsClient, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_dns_ptr_record_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_dns_ptr_record_v2 %s: %#v", d.Id(), ptr)
+
+	// The PTR record is unset when the floating IP is released.
+	if ptr.PTRDName == "" {
+		log.Printf("[DEBUG] openstack_dns_ptr_record_v2 %s is unset, removing it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("region", region)
+	d.Set("floatingip_id", floatingIPID)
+	d.Set("ptrdname", ptr.PTRDName)
+	d.Set("description", ptr.Description)
+	d.Set("ttl", ptr.TTL)
+	d.Set("address", ptr.Address)
+
+	return nil
+}
+
+func resourceDNSPTRRecordV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	dnsClient, err := config.DNSV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	opts := make(map[string]interface{})
+	if d.HasChange("ptrdname") {
+		opts["ptrdname"] = d.Get("ptrdname").(string)
+	}
+	if d.HasChange("description") {
+		opts["description"] = d.Get("description").(string)
+	}
+	if d.HasChange("ttl") {
+		opts["ttl"] = d.Get("ttl").(int)
+	}
+
+	if len(opts) == 0 {
+		return resourceDNSPTRRecordV2Read(ctx, d, meta)
+	}
+
+	log.Printf("[DEBUG] Updating openstack_dns_ptr_record_v2 %s with options: %#v", d.Id(), opts)
+
+	if _, err := dnsPTRRecordV2Set(dnsClient, d.Id(), opts).Extract(); err != nil {
+		return diag.Errorf("Error updating openstack_dns_ptr_record_v2 %s: %s", d.Id(), err)
+	}
+
+	if err := waitForDNSPTRRecordV2(ctx, dnsClient, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDNSPTRRecordV2Read(ctx, d, meta)
+}
+
+func resourceDNSPTRRecordV2Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	dnsClient, err := config.DNSV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	// A null ptrdname unsets the PTR record.
+	opts := map[string]interface{}{
+		"ptrdname": nil,
+	}
+
+	if _, err := dnsPTRRecordV2Set(dnsClient, d.Id(), opts).Extract(); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_dns_ptr_record_v2"))
+	}
+
+	return nil
+}
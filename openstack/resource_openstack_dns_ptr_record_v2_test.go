@@ -0,0 +1,120 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDNSV2PTRRecord_basic(t *testing.T) {
+	ptrName := randomZoneName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDNS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDNSV2PTRRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2PTRRecordConfig(ptrName, "a ptr record", 3000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2PTRRecordExists("openstack_dns_ptr_record_v2.ptr_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_ptr_record_v2.ptr_1", "ptrdname", ptrName),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_ptr_record_v2.ptr_1", "description", "a ptr record"),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_ptr_record_v2.ptr_1", "ttl", "3000"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_dns_ptr_record_v2.ptr_1", "address",
+						"openstack_networking_floatingip_v2.fip_1", "address"),
+				),
+			},
+			{
+				Config: testAccDNSV2PTRRecordConfig(ptrName, "an updated ptr record", 6000),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_dns_ptr_record_v2.ptr_1", "description", "an updated ptr record"),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_ptr_record_v2.ptr_1", "ttl", "6000"),
+				),
+			},
+			{
+				ResourceName:      "openstack_dns_ptr_record_v2.ptr_1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDNSV2PTRRecordExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		dnsClient, err := config.DNSV2Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+		}
+
+		found, err := dnsPTRRecordV2Get(dnsClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.PTRDName == "" {
+			return fmt.Errorf("PTR record not found")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDNSV2PTRRecordDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	dnsClient, err := config.DNSV2Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_dns_ptr_record_v2" {
+			continue
+		}
+
+		found, err := dnsPTRRecordV2Get(dnsClient, rs.Primary.ID).Extract()
+		if err == nil && found.PTRDName != "" && found.Action != "DELETE" {
+			return fmt.Errorf("PTR record still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccDNSV2PTRRecordConfig(ptrName, description string, ttl int) string {
+	return fmt.Sprintf(`
+resource "openstack_networking_floatingip_v2" "fip_1" {
+  pool = "%s"
+}
+
+resource "openstack_dns_ptr_record_v2" "ptr_1" {
+  floatingip_id = "${openstack_networking_floatingip_v2.fip_1.id}"
+  ptrdname      = "%s"
+  description   = "%s"
+  ttl           = %d
+}
+`, osPoolName, ptrName, description, ttl)
+}
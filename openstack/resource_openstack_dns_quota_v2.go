@@ -0,0 +1,252 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDNSQuotaV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDNSQuotaV2Create,
+		ReadContext:   resourceDNSQuotaV2Read,
+		UpdateContext: resourceDNSQuotaV2Update,
+		DeleteContext: resourceDNSQuotaV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDNSQuotaV2Import,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"zones": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+
+			"zone_recordsets": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+
+			"zone_records": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+
+			"recordset_records": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+
+			"api_export_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+		},
+	}
+}
+
+func resourceDNSQuotaV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	dnsClient, err := config.DNSV2Client(region)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	projectID := d.Get("project_id").(string)
+	if err := dnsClientSetAuthHeader(d, dnsClient); err != nil {
+		return diag.Errorf("Error setting dns client auth headers: %s", err)
+	}
+
+	var updateOpts dnsQuotaV2UpdateOpts
+
+	if v, ok := d.GetOkExists("zones"); ok {
+		zones := v.(int)
+		updateOpts.Zones = &zones
+	}
+
+	if v, ok := d.GetOkExists("zone_recordsets"); ok {
+		zoneRecordsets := v.(int)
+		updateOpts.ZoneRecordsets = &zoneRecordsets
+	}
+
+	if v, ok := d.GetOkExists("zone_records"); ok {
+		zoneRecords := v.(int)
+		updateOpts.ZoneRecords = &zoneRecords
+	}
+
+	if v, ok := d.GetOkExists("recordset_records"); ok {
+		recordsetRecords := v.(int)
+		updateOpts.RecordsetRecords = &recordsetRecords
+	}
+
+	if v, ok := d.GetOkExists("api_export_size"); ok {
+		apiExportSize := v.(int)
+		updateOpts.APIExportSize = &apiExportSize
+	}
+
+	log.Printf("[DEBUG] openstack_dns_quota_v2 create options: %#v", updateOpts)
+
+	q, err := dnsQuotaV2Update(dnsClient, projectID, updateOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating openstack_dns_quota_v2: %s", err)
+	}
+
+	id := fmt.Sprintf("%s/%s", projectID, region)
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Created openstack_dns_quota_v2 %#v", q)
+
+	return resourceDNSQuotaV2Read(ctx, d, meta)
+}
+
+func resourceDNSQuotaV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	dnsClient, err := config.DNSV2Client(region)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	// Parse projectID from resource id that is <project_id>/<region>
+	projectID := strings.Split(d.Id(), "/")[0]
+	d.Set("project_id", projectID)
+
+	if err := dnsClientSetAuthHeader(d, dnsClient); err != nil {
+		return diag.Errorf("Error setting dns client auth headers: %s", err)
+	}
+
+	q, err := dnsQuotaV2Get(dnsClient, projectID).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_dns_quota_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_dns_quota_v2 %s: %#v", d.Id(), q)
+
+	d.Set("project_id", projectID)
+	d.Set("region", region)
+	d.Set("zones", q.Zones)
+	d.Set("zone_recordsets", q.ZoneRecordsets)
+	d.Set("zone_records", q.ZoneRecords)
+	d.Set("recordset_records", q.RecordsetRecords)
+	d.Set("api_export_size", q.APIExportSize)
+
+	return nil
+}
+
+func resourceDNSQuotaV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	dnsClient, err := config.DNSV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	var (
+		hasChange  bool
+		updateOpts dnsQuotaV2UpdateOpts
+	)
+
+	if d.HasChange("zones") {
+		hasChange = true
+		zones := d.Get("zones").(int)
+		updateOpts.Zones = &zones
+	}
+
+	if d.HasChange("zone_recordsets") {
+		hasChange = true
+		zoneRecordsets := d.Get("zone_recordsets").(int)
+		updateOpts.ZoneRecordsets = &zoneRecordsets
+	}
+
+	if d.HasChange("zone_records") {
+		hasChange = true
+		zoneRecords := d.Get("zone_records").(int)
+		updateOpts.ZoneRecords = &zoneRecords
+	}
+
+	if d.HasChange("recordset_records") {
+		hasChange = true
+		recordsetRecords := d.Get("recordset_records").(int)
+		updateOpts.RecordsetRecords = &recordsetRecords
+	}
+
+	if d.HasChange("api_export_size") {
+		hasChange = true
+		apiExportSize := d.Get("api_export_size").(int)
+		updateOpts.APIExportSize = &apiExportSize
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] openstack_dns_quota_v2 %s update options: %#v", d.Id(), updateOpts)
+		projectID := d.Get("project_id").(string)
+		if err := dnsClientSetAuthHeader(d, dnsClient); err != nil {
+			return diag.Errorf("Error setting dns client auth headers: %s", err)
+		}
+
+		_, err = dnsQuotaV2Update(dnsClient, projectID, updateOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error updating openstack_dns_quota_v2: %s", err)
+		}
+	}
+
+	return resourceDNSQuotaV2Read(ctx, d, meta)
+}
+
+func resourceDNSQuotaV2Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	dnsClient, err := config.DNSV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	projectID := d.Get("project_id").(string)
+	if err := dnsClientSetAuthHeader(d, dnsClient); err != nil {
+		return diag.Errorf("Error setting dns client auth headers: %s", err)
+	}
+
+	// Deleting the quota resets it to the default values.
+	if err := dnsQuotaV2Delete(dnsClient, projectID).ExtractErr(); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_dns_quota_v2"))
+	}
+
+	return nil
+}
+
+func resourceDNSQuotaV2Import(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+
+	// Allow to import the quota using the project ID only.
+	if !strings.Contains(d.Id(), "/") {
+		d.SetId(fmt.Sprintf("%s/%s", d.Id(), GetRegion(d, config)))
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
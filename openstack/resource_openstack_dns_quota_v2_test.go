@@ -0,0 +1,87 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/projects"
+)
+
+func TestAccDNSQuotaV2_basic(t *testing.T) {
+	var project projects.Project
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckDNS(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckIdentityV3ProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSQuotaV2Basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3ProjectExists("openstack_identity_project_v3.project_1", &project),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_quota_v2.quota_1", "zones", "10"),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_quota_v2.quota_1", "zone_recordsets", "100"),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_quota_v2.quota_1", "zone_records", "1000"),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_quota_v2.quota_1", "recordset_records", "20"),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_quota_v2.quota_1", "api_export_size", "500"),
+				),
+			},
+			{
+				Config: testAccDNSQuotaV2Update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3ProjectExists("openstack_identity_project_v3.project_1", &project),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_quota_v2.quota_1", "zones", "20"),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_quota_v2.quota_1", "zone_recordsets", "200"),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_quota_v2.quota_1", "zone_records", "2000"),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_quota_v2.quota_1", "recordset_records", "-1"),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_quota_v2.quota_1", "api_export_size", "1000"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDNSQuotaV2Basic = `
+resource "openstack_identity_project_v3" "project_1" {
+  name = "project_1"
+}
+
+resource "openstack_dns_quota_v2" "quota_1" {
+  project_id        = "${openstack_identity_project_v3.project_1.id}"
+  zones             = 10
+  zone_recordsets   = 100
+  zone_records      = 1000
+  recordset_records = 20
+  api_export_size   = 500
+}
+`
+
+const testAccDNSQuotaV2Update = `
+resource "openstack_identity_project_v3" "project_1" {
+  name = "project_1"
+}
+
+resource "openstack_dns_quota_v2" "quota_1" {
+  project_id        = "${openstack_identity_project_v3.project_1.id}"
+  zones             = 20
+  zone_recordsets   = 200
+  zone_records      = 2000
+  recordset_records = -1
+  api_export_size   = 1000
+}
+`
@@ -23,6 +23,15 @@ func resourceDNSRecordSetV2() *schema.Resource {
 			StateContext: resourceDNSRecordSetV2Import,
 		},
 
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceDNSRecordSetV2V0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceDNSRecordSetV2StateUpgradeV0,
+				Version: 0,
+			},
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -56,13 +65,15 @@ func resourceDNSRecordSetV2() *schema.Resource {
 			},
 
 			"records": {
-				Type:     schema.TypeList,
+				Type:     schema.TypeSet,
 				Optional: true,
 				ForceNew: false,
 				Elem: &schema.Schema{
-					Type:      schema.TypeString,
-					StateFunc: dnsRecordSetV2RecordsStateFunc,
+					Type:             schema.TypeString,
+					DiffSuppressFunc: dnsRecordSetV2RecordDiffSuppressFunc,
 				},
+				Set:              dnsRecordSetV2RecordsHash,
+				DiffSuppressFunc: dnsRecordSetV2RecordsDiffSuppressFunc,
 			},
 
 			"ttl": {
@@ -91,6 +102,13 @@ func resourceDNSRecordSetV2() *schema.Resource {
 				Default:  false,
 			},
 
+			"zone_share": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"project_id"},
+			},
+
 			"project_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -112,7 +130,7 @@ func resourceDNSRecordSetV2Create(ctx context.Context, d *schema.ResourceData, m
 		return diag.Errorf("Error setting dns client auth headers: %s", err)
 	}
 
-	records := expandDNSRecordSetV2Records(d.Get("records").([]interface{}))
+	records := expandDNSRecordSetV2Records(d.Get("records").(*schema.Set).List())
 
 	createOpts := RecordSetCreateOpts{
 		recordsets.CreateOpts{
@@ -152,12 +170,6 @@ func resourceDNSRecordSetV2Create(ctx context.Context, d *schema.ResourceData, m
 	id := fmt.Sprintf("%s/%s", zoneID, n.ID)
 	d.SetId(id)
 
-	// This is a workaround to store the modified IP addresses in the state
-	// because we don't want to make records computed or change it to TypeSet
-	// in order to retain backwards compatibility.
-	// Because of the StateFunc, this will not cause issues.
-	d.Set("records", records)
-
 	log.Printf("[DEBUG] Created openstack_dns_recordset_v2 %s: %#v", n.ID, n)
 	return resourceDNSRecordSetV2Read(ctx, d, meta)
 }
@@ -186,6 +198,9 @@ func resourceDNSRecordSetV2Read(_ context.Context, d *schema.ResourceData, meta
 
 	log.Printf("[DEBUG] Retrieved openstack_dns_recordset_v2 %s: %#v", recordsetID, n)
 
+	// The records diff is suppressed, when the records only differ in their
+	// formatting for the type of the record set.
+	d.Set("records", n.Records)
 	d.Set("name", n.Name)
 	d.Set("description", n.Description)
 	d.Set("ttl", n.TTL)
@@ -217,7 +232,7 @@ func resourceDNSRecordSetV2Update(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	if d.HasChange("records") {
-		records := expandDNSRecordSetV2Records(d.Get("records").([]interface{}))
+		records := expandDNSRecordSetV2Records(d.Get("records").(*schema.Set).List())
 		updateOpts.Records = records
 		changed = true
 	}
@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/gophercloud/gophercloud/openstack/dns/v2/transfer/accept"
-	"github.com/gophercloud/gophercloud/openstack/dns/v2/transfer/request"
 )
 
 func resourceDNSTransferAcceptV2() *schema.Resource {
@@ -24,7 +23,6 @@ func resourceDNSTransferAcceptV2() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
-			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -42,9 +40,10 @@ func resourceDNSTransferAcceptV2() *schema.Resource {
 			},
 
 			"key": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
 			},
 
 			"value_specs": {
@@ -134,36 +133,11 @@ func resourceDNSTransferAcceptV2Read(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
+// resourceDNSTransferAcceptV2Delete only removes the transfer accept from
+// the state, since Designate doesn't allow to delete a transfer accept and the
+// transferred zone belongs to the accepting project anyway.
 func resourceDNSTransferAcceptV2Delete(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*Config)
-	dnsClient, err := config.DNSV2Client(GetRegion(d, config))
-	if err != nil {
-		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
-	}
-
-	if d.Get("disable_status_check").(bool) {
-		return nil
-	}
-
-	err = request.Delete(dnsClient, d.Get("zone_transfer_request_id").(string)).ExtractErr()
-	if err != nil {
-		return CheckDeleted(d, err, "Error deleting openstack_dns_transfer_request_v2")
-	}
-
-	stateConf := &resource.StateChangeConf{
-		Target:     []string{"DELETED"},
-		Pending:    []string{"ACTIVE"},
-		Refresh:    dnsTransferAcceptV2RefreshFunc(dnsClient, d.Id()),
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		Delay:      5 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
-
-	_, err = stateConf.WaitForState()
-	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for openstack_dns_transfer_accept_v2 %s to become deleted: %s", d.Id(), err)
-	}
+	log.Printf("[DEBUG] openstack_dns_transfer_accept_v2 %s can't be deleted, removing it from the state", d.Id())
 
 	return nil
 }
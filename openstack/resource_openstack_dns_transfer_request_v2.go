@@ -49,10 +49,11 @@ func resourceDNSTransferRequestV2() *schema.Resource {
 			},
 
 			"key": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-				ForceNew: true,
+				Type:      schema.TypeString,
+				Optional:  true,
+				Computed:  true,
+				ForceNew:  true,
+				Sensitive: true,
 			},
 
 			"description": {
@@ -141,11 +142,21 @@ func resourceDNSTransferRequestV2Read(d *schema.ResourceData, meta interface{})
 
 	log.Printf("[DEBUG] Retrieved openstack_dns_transfer_request_v2 %s: %#v", d.Id(), n)
 
+	// A completed transfer request is kept in the state as it was applied,
+	// since it can't be recreated once the zone has been moved to the target
+	// project.
+	if n.Status == "COMPLETE" {
+		log.Printf("[DEBUG] openstack_dns_transfer_request_v2 %s has already been accepted, keeping its state", d.Id())
+		return nil
+	}
+
 	d.Set("region", GetRegion(d, config))
 	d.Set("zone_id", n.ZoneID)
 	d.Set("target_project_id", n.TargetProjectID)
 	d.Set("description", n.Description)
-	d.Set("key", n.Key)
+	if n.Key != "" {
+		d.Set("key", n.Key)
+	}
 
 	return nil
 }
@@ -209,6 +220,17 @@ func resourceDNSTransferRequestV2Delete(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
 	}
 
+	n, err := request.Get(dnsClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_dns_transfer_request_v2")
+	}
+
+	// There is nothing to delete once the transfer has been accepted.
+	if n.Status == "COMPLETE" {
+		log.Printf("[DEBUG] openstack_dns_transfer_request_v2 %s has already been accepted, removing it from the state", d.Id())
+		return nil
+	}
+
 	err = request.Delete(dnsClient, d.Id()).ExtractErr()
 	if err != nil {
 		return CheckDeleted(d, err, "Error deleting openstack_dns_transfer_request_v2")
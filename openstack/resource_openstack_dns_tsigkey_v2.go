@@ -0,0 +1,175 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDNSTSIGKeyV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDNSTSIGKeyV2Create,
+		ReadContext:   resourceDNSTSIGKeyV2Read,
+		UpdateContext: resourceDNSTSIGKeyV2Update,
+		DeleteContext: resourceDNSTSIGKeyV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"algorithm": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(dnsTSIGKeyV2Algorithms, false),
+			},
+
+			"secret": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+
+			"scope": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"POOL", "ZONE",
+				}, false),
+			},
+
+			"resource_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceDNSTSIGKeyV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	dnsClient, err := config.DNSV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	createOpts := dnsTSIGKeyV2CreateOpts{
+		Name:       d.Get("name").(string),
+		Algorithm:  d.Get("algorithm").(string),
+		Secret:     d.Get("secret").(string),
+		Scope:      d.Get("scope").(string),
+		ResourceID: d.Get("resource_id").(string),
+	}
+
+	// Don't log the create options, they contain the secret.
+	log.Printf("[DEBUG] Creating openstack_dns_tsigkey_v2 %s", createOpts.Name)
+
+	key, err := dnsTSIGKeyV2Create(dnsClient, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating openstack_dns_tsigkey_v2: %s", err)
+	}
+
+	d.SetId(key.ID)
+
+	log.Printf("[DEBUG] Created openstack_dns_tsigkey_v2 %s", key.ID)
+
+	return resourceDNSTSIGKeyV2Read(ctx, d, meta)
+}
+
+func resourceDNSTSIGKeyV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	dnsClient, err := config.DNSV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	key, err := dnsTSIGKeyV2Get(dnsClient, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_dns_tsigkey_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_dns_tsigkey_v2 %s: %s", d.Id(), key.Name)
+
+	d.Set("region", GetRegion(d, config))
+	d.Set("name", key.Name)
+	d.Set("algorithm", key.Algorithm)
+	d.Set("scope", key.Scope)
+	d.Set("resource_id", key.ResourceID)
+
+	// The secret may be hidden depending on the Designate policy.
+	if key.Secret != "" {
+		d.Set("secret", key.Secret)
+	}
+
+	return nil
+}
+
+func resourceDNSTSIGKeyV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	dnsClient, err := config.DNSV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	var hasChange bool
+	var updateOpts dnsTSIGKeyV2UpdateOpts
+
+	if d.HasChange("name") {
+		hasChange = true
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+
+	if d.HasChange("algorithm") {
+		hasChange = true
+		algorithm := d.Get("algorithm").(string)
+		updateOpts.Algorithm = &algorithm
+	}
+
+	if d.HasChange("secret") {
+		hasChange = true
+		secret := d.Get("secret").(string)
+		updateOpts.Secret = &secret
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] Updating openstack_dns_tsigkey_v2 %s", d.Id())
+
+		_, err = dnsTSIGKeyV2Update(dnsClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error updating openstack_dns_tsigkey_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDNSTSIGKeyV2Read(ctx, d, meta)
+}
+
+func resourceDNSTSIGKeyV2Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	dnsClient, err := config.DNSV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	if err := dnsTSIGKeyV2Delete(dnsClient, d.Id()).ExtractErr(); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_dns_tsigkey_v2"))
+	}
+
+	return nil
+}
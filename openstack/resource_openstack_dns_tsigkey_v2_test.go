@@ -0,0 +1,119 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDNSV2TSIGKey_basic(t *testing.T) {
+	zoneName := randomZoneName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckDNS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDNSV2TSIGKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2TSIGKeyBasic(zoneName, "hmac-sha256", "c2VjcmV0LTE="),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2TSIGKeyExists("openstack_dns_tsigkey_v2.tsigkey_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_tsigkey_v2.tsigkey_1", "algorithm", "hmac-sha256"),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_tsigkey_v2.tsigkey_1", "scope", "ZONE"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_dns_tsigkey_v2.tsigkey_1", "resource_id",
+						"openstack_dns_zone_v2.zone_1", "id"),
+				),
+			},
+			{
+				Config: testAccDNSV2TSIGKeyBasic(zoneName, "hmac-sha512", "c2VjcmV0LTI="),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2TSIGKeyExists("openstack_dns_tsigkey_v2.tsigkey_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_tsigkey_v2.tsigkey_1", "algorithm", "hmac-sha512"),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_tsigkey_v2.tsigkey_1", "secret", "c2VjcmV0LTI="),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDNSV2TSIGKeyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		dnsClient, err := config.DNSV2Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+		}
+
+		found, err := dnsTSIGKeyV2Get(dnsClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("TSIG key not found")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDNSV2TSIGKeyDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	dnsClient, err := config.DNSV2Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_dns_tsigkey_v2" {
+			continue
+		}
+
+		_, err := dnsTSIGKeyV2Get(dnsClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("TSIG key still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccDNSV2TSIGKeyBasic(zoneName, algorithm, secret string) string {
+	return fmt.Sprintf(`
+resource "openstack_dns_zone_v2" "zone_1" {
+  name        = "%s"
+  email       = "email1@example.com"
+  description = "a zone"
+  ttl         = 3000
+  type        = "PRIMARY"
+}
+
+resource "openstack_dns_tsigkey_v2" "tsigkey_1" {
+  name        = "tsigkey_1"
+  algorithm   = "%s"
+  secret      = "%s"
+  scope       = "ZONE"
+  resource_id = "${openstack_dns_zone_v2.zone_1.id}"
+}
+`, zoneName, algorithm, secret)
+}
@@ -0,0 +1,133 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDNSZoneShareV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDNSZoneShareV2Create,
+		ReadContext:   resourceDNSZoneShareV2Read,
+		DeleteContext: resourceDNSZoneShareV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"target_project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceDNSZoneShareV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	dnsClient, err := config.DNSV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	if err := dnsClientSetAuthHeader(d, dnsClient); err != nil {
+		return diag.Errorf("Error setting dns client auth headers: %s", err)
+	}
+
+	zoneID := d.Get("zone_id").(string)
+	createOpts := dnsZoneShareV2CreateOpts{
+		TargetProjectID: d.Get("target_project_id").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_dns_zone_share_v2 create options: %#v", createOpts)
+
+	share, err := dnsZoneShareV2Create(dnsClient, zoneID, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating openstack_dns_zone_share_v2: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", zoneID, share.ID))
+
+	log.Printf("[DEBUG] Created openstack_dns_zone_share_v2 %s: %#v", d.Id(), share)
+
+	return resourceDNSZoneShareV2Read(ctx, d, meta)
+}
+
+func resourceDNSZoneShareV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	dnsClient, err := config.DNSV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	if err := dnsClientSetAuthHeader(d, dnsClient); err != nil {
+		return diag.Errorf("Error setting dns client auth headers: %s", err)
+	}
+
+	zoneID, shareID, err := dnsZoneShareV2ParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	share, err := dnsZoneShareV2Get(dnsClient, zoneID, shareID).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_dns_zone_share_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_dns_zone_share_v2 %s: %#v", d.Id(), share)
+
+	d.Set("region", GetRegion(d, config))
+	d.Set("zone_id", zoneID)
+	d.Set("target_project_id", share.TargetProjectID)
+	d.Set("project_id", share.ProjectID)
+
+	return nil
+}
+
+func resourceDNSZoneShareV2Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	dnsClient, err := config.DNSV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	if err := dnsClientSetAuthHeader(d, dnsClient); err != nil {
+		return diag.Errorf("Error setting dns client auth headers: %s", err)
+	}
+
+	zoneID, shareID, err := dnsZoneShareV2ParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := dnsZoneShareV2Delete(dnsClient, zoneID, shareID).ExtractErr(); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_dns_zone_share_v2"))
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDNSV2ZoneShare_basic(t *testing.T) {
+	zoneName := randomZoneName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckDNS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDNSV2ZoneShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2ZoneShareBasic(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2ZoneShareExists("openstack_dns_zone_share_v2.share_1"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_dns_zone_share_v2.share_1", "target_project_id",
+						"openstack_identity_project_v3.project_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_dns_zone_share_v2.share_1", "project_id",
+						"openstack_dns_zone_v2.zone_1", "project_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDNSV2ZoneShareExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		dnsClient, err := config.DNSV2Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+		}
+
+		zoneID, shareID, err := dnsZoneShareV2ParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		found, err := dnsZoneShareV2Get(dnsClient, zoneID, shareID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != shareID {
+			return fmt.Errorf("Zone share not found")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDNSV2ZoneShareDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	dnsClient, err := config.DNSV2Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_dns_zone_share_v2" {
+			continue
+		}
+
+		zoneID, shareID, err := dnsZoneShareV2ParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = dnsZoneShareV2Get(dnsClient, zoneID, shareID).Extract()
+		if err == nil {
+			return fmt.Errorf("Zone share still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccDNSV2ZoneShareBasic(zoneName string) string {
+	return fmt.Sprintf(`
+resource "openstack_identity_project_v3" "project_1" {
+  name = "project_1"
+}
+
+resource "openstack_dns_zone_v2" "zone_1" {
+  name        = "%s"
+  email       = "email1@example.com"
+  description = "a zone"
+  ttl         = 3000
+  type        = "PRIMARY"
+}
+
+resource "openstack_dns_zone_share_v2" "share_1" {
+  zone_id           = "${openstack_dns_zone_v2.zone_1.id}"
+  target_project_id = "${openstack_identity_project_v3.project_1.id}"
+}
+`, zoneName)
+}
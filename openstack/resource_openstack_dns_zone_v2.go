@@ -105,6 +105,21 @@ func resourceDNSZoneV2() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"xfr_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"serial": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"transferred_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"value_specs": {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -214,6 +229,12 @@ func resourceDNSZoneV2Read(_ context.Context, d *schema.ResourceData, meta inter
 	d.Set("masters", n.Masters)
 	d.Set("region", GetRegion(d, config))
 	d.Set("project_id", n.ProjectID)
+	d.Set("serial", n.Serial)
+	if !n.TransferredAt.IsZero() {
+		d.Set("transferred_at", n.TransferredAt.Format(time.RFC3339))
+	} else {
+		d.Set("transferred_at", "")
+	}
 
 	return nil
 }
@@ -248,7 +269,9 @@ func resourceDNSZoneV2Update(ctx context.Context, d *schema.ResourceData, meta i
 		changed = true
 	}
 
-	if !changed {
+	xfr := d.HasChange("xfr_trigger") && d.Get("xfr_trigger").(string) != ""
+
+	if !changed && !xfr {
 		// Nothing in OpenStack fields really changed, so just return zone from OpenStack
 		return resourceDNSZoneV2Read(ctx, d, meta)
 	}
@@ -257,11 +280,26 @@ func resourceDNSZoneV2Update(ctx context.Context, d *schema.ResourceData, meta i
 		return diag.Errorf("Error setting dns client auth headers: %s", err)
 	}
 
-	log.Printf("[DEBUG] Updating openstack_dns_zone_v2 %s with options: %#v", d.Id(), updateOpts)
+	if changed {
+		log.Printf("[DEBUG] Updating openstack_dns_zone_v2 %s with options: %#v", d.Id(), updateOpts)
 
-	_, err = zones.Update(dnsClient, d.Id(), updateOpts).Extract()
-	if err != nil {
-		return diag.Errorf("Error updating openstack_dns_zone_v2 %s: %s", d.Id(), err)
+		_, err = zones.Update(dnsClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error updating openstack_dns_zone_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	if xfr {
+		if d.Get("type").(string) != "SECONDARY" {
+			return diag.Errorf("Error triggering openstack_dns_zone_v2 %s XFR: only available for SECONDARY zones", d.Id())
+		}
+
+		log.Printf("[DEBUG] Triggering openstack_dns_zone_v2 %s XFR", d.Id())
+
+		err = dnsZoneV2TriggerXFR(dnsClient, d.Id()).ExtractErr()
+		if err != nil {
+			return diag.Errorf("Error triggering openstack_dns_zone_v2 %s XFR: %s", d.Id(), err)
+		}
 	}
 
 	if d.Get("disable_status_check").(bool) {
@@ -29,6 +29,7 @@ func TestAccDNSV2Zone_basic(t *testing.T) {
 				Config: testAccDNSV2ZoneBasic(zoneName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDNSV2ZoneExists("openstack_dns_zone_v2.zone_1", &zone),
+					resource.TestCheckResourceAttrSet("openstack_dns_zone_v2.zone_1", "serial"),
 					resource.TestCheckResourceAttr(
 						"openstack_dns_zone_v2.zone_1", "description", "a zone"),
 				),
@@ -148,27 +148,45 @@ func resourceFWPolicyV1Update(ctx context.Context, d *schema.ResourceData, meta
 		return diag.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
+	if d.HasChange("rules") {
+		oldRules, newRules := d.GetChange("rules")
+		err = fwPolicyV1UpdateRules(networkingClient, d.Id(),
+			expandToStringSlice(oldRules.([]interface{})), expandToStringSlice(newRules.([]interface{})))
+		if err != nil {
+			return diag.Errorf("Error updating openstack_fw_policy_v1 %s rules: %s", d.Id(), err)
+		}
+	}
+
+	var hasChange bool
 	opts := policies.UpdateOpts{}
 
 	if d.HasChange("name") {
+		hasChange = true
 		name := d.Get("name").(string)
 		opts.Name = &name
 	}
 
 	if d.HasChange("description") {
+		hasChange = true
 		description := d.Get("description").(string)
 		opts.Description = &description
 	}
 
-	if d.HasChange("rules") {
-		opts.Rules = expandToStringSlice(d.Get("rules").([]interface{}))
+	// Neutron resets audited on every change of the policy or its rules,
+	// so an audited policy is marked as audited again.
+	audited := d.Get("audited").(bool)
+	if d.HasChange("audited") || (audited && d.HasChanges("name", "description", "rules")) {
+		hasChange = true
+		opts.Audited = &audited
 	}
 
-	log.Printf("[DEBUG] openstack_fw_policy_v1 %s update options: %#v", d.Id(), opts)
+	if hasChange {
+		log.Printf("[DEBUG] openstack_fw_policy_v1 %s update options: %#v", d.Id(), opts)
 
-	err = policies.Update(networkingClient, d.Id(), opts).Err
-	if err != nil {
-		return diag.Errorf("Error updating openstack_fw_policy_v1 %s: %s", d.Id(), err)
+		err = policies.Update(networkingClient, d.Id(), opts).Err
+		if err != nil {
+			return diag.Errorf("Error updating openstack_fw_policy_v1 %s: %s", d.Id(), err)
+		}
 	}
 
 	return resourceFWPolicyV1Read(ctx, d, meta)
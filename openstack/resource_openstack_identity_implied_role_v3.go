@@ -0,0 +1,106 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIdentityImpliedRoleV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIdentityImpliedRoleV3Create,
+		ReadContext:   resourceIdentityImpliedRoleV3Read,
+		DeleteContext: resourceIdentityImpliedRoleV3Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"prior_role_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"implied_role_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceIdentityImpliedRoleV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	identityClient, err := config.IdentityV3Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	priorRoleID := d.Get("prior_role_id").(string)
+	impliedRoleID := d.Get("implied_role_id").(string)
+
+	log.Printf("[DEBUG] openstack_identity_implied_role_v3 create: %s implies %s", priorRoleID, impliedRoleID)
+	if _, err := identityImpliedRoleV3Create(identityClient, priorRoleID, impliedRoleID).Extract(); err != nil {
+		return diag.Errorf("Error creating openstack_identity_implied_role_v3: %s", err)
+	}
+
+	d.SetId(identityImpliedRoleV3ID(priorRoleID, impliedRoleID))
+
+	return resourceIdentityImpliedRoleV3Read(ctx, d, meta)
+}
+
+func resourceIdentityImpliedRoleV3Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	identityClient, err := config.IdentityV3Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	priorRoleID, impliedRoleID, err := identityImpliedRoleV3ParseID(d.Id())
+	if err != nil {
+		return diag.Errorf("Error parsing ID of openstack_identity_implied_role_v3: %s", err)
+	}
+
+	impliedRole, err := identityImpliedRoleV3Get(identityClient, priorRoleID, impliedRoleID).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_identity_implied_role_v3"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_identity_implied_role_v3 %s: %#v", d.Id(), impliedRole)
+
+	d.Set("region", GetRegion(d, config))
+	d.Set("prior_role_id", impliedRole.PriorRole.ID)
+	d.Set("implied_role_id", impliedRole.Implies.ID)
+
+	return nil
+}
+
+func resourceIdentityImpliedRoleV3Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	identityClient, err := config.IdentityV3Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	priorRoleID, impliedRoleID, err := identityImpliedRoleV3ParseID(d.Id())
+	if err != nil {
+		return diag.Errorf("Error parsing ID of openstack_identity_implied_role_v3: %s", err)
+	}
+
+	if err := identityImpliedRoleV3Delete(identityClient, priorRoleID, impliedRoleID).ExtractErr(); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_identity_implied_role_v3"))
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/roles"
+)
+
+func TestAccIdentityV3ImpliedRole_basic(t *testing.T) {
+	var priorRole, impliedRole roles.Role
+	priorRoleName := fmt.Sprintf("ACCPTTEST-%s", acctest.RandString(5))
+	impliedRoleName := fmt.Sprintf("ACCPTTEST-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckIdentityV3ImpliedRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityV3ImpliedRoleBasic(priorRoleName, impliedRoleName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3RoleExists("openstack_identity_role_v3.prior", &priorRole),
+					testAccCheckIdentityV3RoleExists("openstack_identity_role_v3.implied", &impliedRole),
+					testAccCheckIdentityV3ImpliedRoleExists("openstack_identity_implied_role_v3.implied_role_1"),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_identity_implied_role_v3.implied_role_1", "prior_role_id", &priorRole.ID),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_identity_implied_role_v3.implied_role_1", "implied_role_id", &impliedRole.ID),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityV3ImpliedRoleDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	identityClient, err := config.IdentityV3Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_identity_implied_role_v3" {
+			continue
+		}
+
+		priorRoleID, impliedRoleID, err := identityImpliedRoleV3ParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = identityImpliedRoleV3Get(identityClient, priorRoleID, impliedRoleID).Extract()
+		if err == nil {
+			return fmt.Errorf("Implied role still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIdentityV3ImpliedRoleExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		identityClient, err := config.IdentityV3Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+		}
+
+		priorRoleID, impliedRoleID, err := identityImpliedRoleV3ParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = identityImpliedRoleV3Get(identityClient, priorRoleID, impliedRoleID).Extract()
+		if err != nil {
+			return fmt.Errorf("Implied role not found: %s", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccIdentityV3ImpliedRoleBasic(priorRoleName, impliedRoleName string) string {
+	return fmt.Sprintf(`
+resource "openstack_identity_role_v3" "prior" {
+  name = "%s"
+}
+
+resource "openstack_identity_role_v3" "implied" {
+  name = "%s"
+}
+
+resource "openstack_identity_implied_role_v3" "implied_role_1" {
+  prior_role_id   = "${openstack_identity_role_v3.prior.id}"
+  implied_role_id = "${openstack_identity_role_v3.implied.id}"
+}
+`, priorRoleName, impliedRoleName)
+}
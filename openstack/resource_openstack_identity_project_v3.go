@@ -71,6 +71,18 @@ func resourceIdentityProjectV3() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
+
+			"immutable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -98,6 +110,12 @@ func resourceIdentityProjectV3Create(ctx context.Context, d *schema.ResourceData
 		createOpts.Tags = expandToStringSlice(tags)
 	}
 
+	if d.Get("immutable").(bool) {
+		createOpts.Options = map[projects.Option]interface{}{
+			projects.Immutable: true,
+		}
+	}
+
 	log.Printf("[DEBUG] openstack_identity_project_v3 create options: %#v", createOpts)
 	project, err := projects.Create(identityClient, createOpts).Extract()
 	if err != nil {
@@ -131,6 +149,7 @@ func resourceIdentityProjectV3Read(ctx context.Context, d *schema.ResourceData,
 	d.Set("parent_id", project.ParentID)
 	d.Set("region", GetRegion(d, config))
 	d.Set("tags", project.Tags)
+	d.Set("immutable", identityProjectV3IsImmutable(project.Options))
 
 	return nil
 }
@@ -189,6 +208,23 @@ func resourceIdentityProjectV3Update(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
+	// Keystone rejects updates of an immutable project unless the same
+	// request clears the immutable option, so clear it first and restore
+	// it afterwards.
+	oldImmutable, newImmutable := d.GetChange("immutable")
+	restoreImmutable := false
+	if hasChange && oldImmutable.(bool) {
+		updateOpts.Options = map[projects.Option]interface{}{
+			projects.Immutable: false,
+		}
+		restoreImmutable = newImmutable.(bool)
+	} else if d.HasChange("immutable") {
+		hasChange = true
+		updateOpts.Options = map[projects.Option]interface{}{
+			projects.Immutable: newImmutable.(bool),
+		}
+	}
+
 	if hasChange {
 		_, err := projects.Update(identityClient, d.Id(), updateOpts).Extract()
 		if err != nil {
@@ -196,6 +232,18 @@ func resourceIdentityProjectV3Update(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
+	if restoreImmutable {
+		immutableOpts := projects.UpdateOpts{
+			Options: map[projects.Option]interface{}{
+				projects.Immutable: true,
+			},
+		}
+		_, err := projects.Update(identityClient, d.Id(), immutableOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error restoring immutable option of openstack_identity_project_v3 %s: %s", d.Id(), err)
+		}
+	}
+
 	return resourceIdentityProjectV3Read(ctx, d, meta)
 }
 
@@ -206,6 +254,24 @@ func resourceIdentityProjectV3Delete(ctx context.Context, d *schema.ResourceData
 		return diag.Errorf("Error creating OpenStack identity client: %s", err)
 	}
 
+	if d.Get("immutable").(bool) {
+		if !d.Get("force_destroy").(bool) {
+			return diag.Errorf("Error deleting openstack_identity_project_v3 %s: the project is immutable, "+
+				"set immutable to false or force_destroy to true to delete it", d.Id())
+		}
+
+		log.Printf("[DEBUG] Clearing immutable option of openstack_identity_project_v3 %s before deletion", d.Id())
+		updateOpts := projects.UpdateOpts{
+			Options: map[projects.Option]interface{}{
+				projects.Immutable: false,
+			},
+		}
+		_, err = projects.Update(identityClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return diag.FromErr(CheckDeleted(d, err, "Error clearing immutable option of openstack_identity_project_v3"))
+		}
+	}
+
 	err = projects.Delete(identityClient, d.Id()).ExtractErr()
 	if err != nil {
 		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_identity_project_v3"))
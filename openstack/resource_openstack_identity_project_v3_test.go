@@ -62,6 +62,41 @@ func TestAccIdentityV3Project_basic(t *testing.T) {
 	})
 }
 
+func TestAccIdentityV3Project_immutable(t *testing.T) {
+	var project projects.Project
+	var projectName = fmt.Sprintf("ACCPTTEST-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckIdentityV3ProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityV3ProjectImmutable(projectName, "tag1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3ProjectExists("openstack_identity_project_v3.project_1", &project),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_project_v3.project_1", "immutable", "true"),
+					testAccCheckIdentityV3ProjectHasTag("openstack_identity_project_v3.project_1", "tag1"),
+				),
+			},
+			{
+				Config: testAccIdentityV3ProjectImmutable(projectName, "tag2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3ProjectExists("openstack_identity_project_v3.project_1", &project),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_project_v3.project_1", "immutable", "true"),
+					testAccCheckIdentityV3ProjectHasTag("openstack_identity_project_v3.project_1", "tag2"),
+					testAccCheckIdentityV3ProjectTagCount("openstack_identity_project_v3.project_1", 1),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIdentityV3ProjectDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	identityClient, err := config.IdentityV3Client(osRegionName)
@@ -204,3 +239,15 @@ func testAccIdentityV3ProjectUpdate(projectName string) string {
     }
   `, projectName)
 }
+
+func testAccIdentityV3ProjectImmutable(projectName, tag string) string {
+	return fmt.Sprintf(`
+    resource "openstack_identity_project_v3" "project_1" {
+      name          = "%s"
+      description   = "An immutable project"
+      tags          = ["%s"]
+      immutable     = true
+      force_destroy = true
+    }
+  `, projectName, tag)
+}
@@ -72,21 +72,31 @@ func resourceIdentityUserV3() *schema.Resource {
 			"ignore_change_password_upon_first_use": {
 				Type:     schema.TypeBool,
 				Optional: true,
+				Computed: true,
 			},
 
 			"ignore_password_expiry": {
 				Type:     schema.TypeBool,
 				Optional: true,
+				Computed: true,
 			},
 
 			"ignore_lockout_failure_attempts": {
 				Type:     schema.TypeBool,
 				Optional: true,
+				Computed: true,
 			},
 
 			"multi_factor_auth_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
+				Computed: true,
+			},
+
+			"lock_password": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 
 			"multi_factor_auth_rule": {
@@ -127,8 +137,8 @@ func resourceIdentityUserV3Create(ctx context.Context, d *schema.ResourceData, m
 	// Build the user options
 	options := map[users.Option]interface{}{}
 	for _, option := range getUserOptions() {
-		if v, ok := d.GetOk(string(option)); ok {
-			options[option] = v.(bool)
+		if identityUserV3OptionConfigured(d, option) {
+			options[option] = d.Get(string(option)).(bool)
 		}
 	}
 
@@ -178,11 +188,11 @@ func resourceIdentityUserV3Read(ctx context.Context, d *schema.ResourceData, met
 	d.Set("region", GetRegion(d, config))
 
 	// Check and see if any options match those defined in the schema.
+	// Keystone omits the unset options, which are false.
 	options := user.Options
 	for _, option := range getUserOptions() {
-		if v, ok := options[string(option)]; ok {
-			d.Set(string(option), v.(bool))
-		}
+		v, _ := options[string(option)].(bool)
+		d.Set(string(option), v)
 	}
 
 	if v, ok := options["multi_factor_auth_rules"].([]interface{}); ok {
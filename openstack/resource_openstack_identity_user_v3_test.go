@@ -67,6 +67,12 @@ func TestAccIdentityV3User_basic(t *testing.T) {
 						"openstack_identity_user_v3.user_1", "enabled", "false"),
 					resource.TestCheckResourceAttr(
 						"openstack_identity_user_v3.user_1", "ignore_change_password_upon_first_use", "false"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_user_v3.user_1", "ignore_password_expiry", "false"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_user_v3.user_1", "ignore_lockout_failure_attempts", "false"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_user_v3.user_1", "lock_password", "true"),
 					resource.TestCheckResourceAttr(
 						"openstack_identity_user_v3.user_1", "multi_factor_auth_rule.#", "1"),
 					resource.TestCheckResourceAttr(
@@ -176,7 +182,9 @@ func testAccIdentityV3UserUpdate(projectName, userName string) string {
       enabled = false
       password = "password123"
       ignore_change_password_upon_first_use = false
+      ignore_password_expiry = false
       multi_factor_auth_enabled = true
+      lock_password = true
 
       multi_factor_auth_rule {
         rule = ["password", "totp"]
@@ -146,6 +146,16 @@ func resourceImagesImageV2() *schema.Resource {
 				Set:      schema.HashString,
 			},
 
+			"upload_chunk_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				// The chunk size is only used to upload the image on create.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Id() != ""
+				},
+			},
+
 			"verify_checksum": {
 				Type:          schema.TypeBool,
 				Optional:      true,
@@ -270,6 +280,7 @@ func resourceImagesImageV2Create(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	d.SetId(newImg.ID)
+	config.lookupCache.invalidate()
 
 	var fileChecksum string
 	useWebDownload := d.Get("web_download").(bool)
@@ -298,9 +309,32 @@ func resourceImagesImageV2Create(ctx context.Context, d *schema.ResourceData, me
 		defer imgFile.Close()
 		log.Printf("[WARN] Uploading image %s (%d bytes). This can be pretty long.", d.Id(), fileSize)
 
-		res := imagedata.Upload(imageClient, d.Id(), imgFile)
-		if res.Err != nil {
-			return diag.Errorf("Error while uploading file %q: %s", imgFilePath, res.Err)
+		reader := newImagesImageV2ChunkReader(imgFile, d.Id(), fileSize, d.Get("upload_chunk_size").(int))
+		if imagesImageV2GlanceDirectSupported(imageClient) {
+			// Stage the file and import it with the glance-direct method.
+			err = imagesImageV2UploadWithRetry(imageClient, d.Id(), reader, func() error {
+				return imagedata.Stage(imageClient, d.Id(), reader).Err
+			})
+			if err != nil {
+				return diag.Errorf("Error while staging file %q: %s", imgFilePath, err)
+			}
+
+			importOpts := &imageimport.CreateOpts{
+				Name: imageimport.GlanceDirectMethod,
+			}
+
+			log.Printf("[DEBUG] Import Options: %#v", importOpts)
+			res := imageimport.Create(imageClient, d.Id(), importOpts)
+			if res.Err != nil {
+				return diag.Errorf("Error while importing file %q: %s", imgFilePath, res.Err)
+			}
+		} else {
+			err = imagesImageV2UploadWithRetry(imageClient, d.Id(), reader, func() error {
+				return imagedata.Upload(imageClient, d.Id(), reader).Err
+			})
+			if err != nil {
+				return diag.Errorf("Error while uploading file %q: %s", imgFilePath, err)
+			}
 		}
 	} else {
 		// import
@@ -320,7 +354,7 @@ func resourceImagesImageV2Create(ctx context.Context, d *schema.ResourceData, me
 
 	//wait for active
 	stateConf := &resource.StateChangeConf{
-		Pending:    []string{string(images.ImageStatusQueued), string(images.ImageStatusSaving), string(images.ImageStatusImporting)},
+		Pending:    []string{string(images.ImageStatusQueued), string(images.ImageStatusSaving), "uploading", string(images.ImageStatusImporting)},
 		Target:     []string{string(images.ImageStatusActive)},
 		Refresh:    resourceImagesImageV2RefreshFunc(imageClient, d.Id()),
 		Timeout:    d.Timeout(schema.TimeoutCreate),
@@ -529,6 +563,10 @@ func resourceImagesImageV2Update(ctx context.Context, d *schema.ResourceData, me
 		return diag.Errorf("Error updating image: %s", err)
 	}
 
+	if d.HasChange("name") {
+		config.lookupCache.invalidate()
+	}
+
 	return resourceImagesImageV2Read(ctx, d, meta)
 }
 
@@ -543,6 +581,7 @@ func resourceImagesImageV2Delete(ctx context.Context, d *schema.ResourceData, me
 	if err := images.Delete(imageClient, d.Id()).Err; err != nil {
 		return diag.Errorf("Error deleting Image: %s", err)
 	}
+	config.lookupCache.invalidate()
 
 	d.SetId("")
 	return nil
@@ -106,6 +106,12 @@ func resourceKeyManagerContainerV1() *schema.Resource {
 				},
 			},
 
+			"prevent_destroy_if_consumed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
 			"created_at": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -284,6 +290,19 @@ func resourceKeyManagerContainerV1Delete(ctx context.Context, d *schema.Resource
 		return diag.Errorf("Error creating OpenStack barbican client: %s", err)
 	}
 
+	// Deleting a consumed container breaks the consumers, e.g. the Octavia
+	// listeners, which still reference it.
+	if d.Get("prevent_destroy_if_consumed").(bool) {
+		container, err := containers.Get(kmClient, d.Id()).Extract()
+		if err != nil {
+			return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_keymanager_container_v1"))
+		}
+
+		if len(container.Consumers) > 0 {
+			return diag.Errorf("Error deleting openstack_keymanager_container_v1 %s: the container is still consumed by %s", d.Id(), keyManagerContainerV1ConsumersString(container.Consumers))
+		}
+	}
+
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"PENDING"},
 		Target:     []string{"DELETED"},
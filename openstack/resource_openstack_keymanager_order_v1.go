@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -71,6 +72,12 @@ func resourceKeyManagerOrderV1() *schema.Resource {
 								"text/plain", "text/plain;charset=utf-8", "text/plain; charset=utf-8", "application/octet-stream", "application/pkcs8",
 							}, true),
 						},
+						"pass_phrase": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							ForceNew:  true,
+							Sensitive: true,
+						},
 					},
 				},
 			},
@@ -125,6 +132,12 @@ func resourceKeyManagerOrderV1() *schema.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: customdiff.Sequence(
+			func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceKeyManagerOrderV1MetaCustomizeDiff(diff)
+			},
+		),
 	}
 
 	return ret
@@ -139,9 +152,12 @@ func resourceKeyManagerOrderV1Create(ctx context.Context, d *schema.ResourceData
 
 	orderType := keyManagerOrderV1OrderType(d.Get("type").(string))
 	metaOpts := expandKeyManagerOrderV1Meta(d.Get("meta").([]interface{}))
-	createOpts := orders.CreateOpts{
-		Type: orderType,
-		Meta: metaOpts,
+	createOpts := keyManagerOrderV1CreateOpts{
+		CreateOpts: orders.CreateOpts{
+			Type: orderType,
+			Meta: metaOpts,
+		},
+		PassPhrase: d.Get("meta.0.pass_phrase").(string),
 	}
 
 	log.Printf("[DEBUG] Create Options for resource_keymanager_order_v1: %#v", createOpts)
@@ -197,7 +213,11 @@ func resourceKeyManagerOrderV1Read(ctx context.Context, d *schema.ResourceData,
 	d.Set("sub_status_message", order.SubStatusMessage)
 	d.Set("type", order.Type)
 	d.Set("updated", order.Updated.Format(time.RFC3339))
-	if err := d.Set("meta", flattenKeyManagerOrderV1Meta(order.Meta)); err != nil {
+
+	// The pass_phrase is not returned by the API.
+	orderMeta := flattenKeyManagerOrderV1Meta(order.Meta)
+	orderMeta[0]["pass_phrase"] = d.Get("meta.0.pass_phrase").(string)
+	if err := d.Set("meta", orderMeta); err != nil {
 		return diag.Errorf("error setting meta for resource %s: %s", d.Id(), err)
 	}
 
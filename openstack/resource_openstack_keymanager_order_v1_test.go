@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
 	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/keymanager/v1/containers"
 	"github.com/gophercloud/gophercloud/openstack/keymanager/v1/orders"
 	"github.com/gophercloud/gophercloud/openstack/keymanager/v1/secrets"
 )
@@ -40,6 +41,70 @@ func TestAccKeyManagerOrderV1_basic(t *testing.T) {
 	})
 }
 
+func TestAccKeyManagerOrderV1_asymmetric(t *testing.T) {
+	var order orders.Order
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckKeyManager(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckOrderV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyManagerOrderV1Asymmetric,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrderV1Exists(
+						"openstack_keymanager_order_v1.test-acc-asymmetric", &order),
+					resource.TestCheckResourceAttr("openstack_keymanager_order_v1.test-acc-asymmetric", "type", "asymmetric"),
+					resource.TestCheckResourceAttr("openstack_keymanager_order_v1.test-acc-asymmetric", "status", "ACTIVE"),
+					resource.TestCheckResourceAttr("openstack_keymanager_order_v1.test-acc-asymmetric", "meta.0.pass_phrase", "foobar"),
+					resource.TestCheckResourceAttrSet("openstack_keymanager_order_v1.test-acc-asymmetric", "container_ref"),
+				),
+			},
+		},
+	})
+}
+
+func TestKeyManagerOrderV1ValidateMeta(t *testing.T) {
+	valid := []struct {
+		orderType  string
+		algorithm  string
+		bitLength  int
+		passPhrase string
+	}{
+		{"key", "aes", 256, ""},
+		{"key", "AES", 128, ""},
+		{"key", "hmacsha256", 512, ""},
+		{"asymmetric", "rsa", 2048, "foobar"},
+		{"asymmetric", "dsa", 3072, ""},
+	}
+	for _, v := range valid {
+		if err := keyManagerOrderV1ValidateMeta(v.orderType, v.algorithm, v.bitLength, v.passPhrase); err != nil {
+			t.Fatalf("unexpected error for %+v: %s", v, err)
+		}
+	}
+
+	invalid := []struct {
+		orderType  string
+		algorithm  string
+		bitLength  int
+		passPhrase string
+	}{
+		{"key", "rsa", 2048, ""},
+		{"key", "aes", 512, ""},
+		{"key", "aes", 256, "foobar"},
+		{"asymmetric", "aes", 256, ""},
+		{"asymmetric", "rsa", 1000, ""},
+	}
+	for _, v := range invalid {
+		if err := keyManagerOrderV1ValidateMeta(v.orderType, v.algorithm, v.bitLength, v.passPhrase); err == nil {
+			t.Fatalf("expected an error for %+v", v)
+		}
+	}
+}
+
 func testAccCheckOrderV1Destroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	kmClient, err := config.KeyManagerV1Client(osRegionName)
@@ -57,6 +122,15 @@ func testAccCheckOrderV1Destroy(s *terraform.State) error {
 		if _, ok := err.(gophercloud.ErrDefault404); !ok {
 			return err
 		}
+		// asymmetric orders generate a container instead of a secret
+		if containerRef := rs.Primary.Attributes["container_ref"]; containerRef != "" {
+			uuid := keyManagerContainerV1GetUUIDfromContainerRef(containerRef)
+			result := containers.Delete(kmClient, uuid)
+			if result.ExtractErr() != nil {
+				return fmt.Errorf("Container (%s) still exists", uuid)
+			}
+			continue
+		}
 		secretRefSplit := strings.Split(rs.Primary.Attributes["secret_ref"], "/")
 		uuid := secretRefSplit[len(secretRefSplit)-1]
 		result := secrets.Delete(kmClient, uuid)
@@ -106,3 +180,14 @@ resource "openstack_keymanager_order_v1" "test-acc-basic" {
     mode = "cbc"
   }
 }`
+
+const testAccKeyManagerOrderV1Asymmetric = `
+resource "openstack_keymanager_order_v1" "test-acc-asymmetric" {
+  type = "asymmetric"
+  meta {
+    name = "test-acc-asymmetric"
+    algorithm = "rsa"
+    bit_length = 2048
+    pass_phrase = "foobar"
+  }
+}`
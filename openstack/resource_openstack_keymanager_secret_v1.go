@@ -2,6 +2,8 @@ package openstack
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"log"
 	"strings"
 	"time"
@@ -12,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/keymanager/v1/acls"
 	"github.com/gophercloud/gophercloud/openstack/keymanager/v1/secrets"
 )
@@ -94,11 +97,12 @@ func resourceKeyManagerSecretV1() *schema.Resource {
 			},
 
 			"payload": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Sensitive: true,
-				ForceNew:  true,
-				Computed:  true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ForceNew:      true,
+				Computed:      true,
+				ConflictsWith: []string{"payload_file"},
 				DiffSuppressFunc: func(k, o, n string, d *schema.ResourceData) bool {
 					if strings.TrimSpace(o) == strings.TrimSpace(n) {
 						return true
@@ -107,6 +111,18 @@ func resourceKeyManagerSecretV1() *schema.Resource {
 				},
 			},
 
+			"payload_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"payload"},
+			},
+
+			"payload_file_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"payload_content_type": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -171,6 +187,10 @@ func resourceKeyManagerSecretV1() *schema.Resource {
 			func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return resourceSecretV1PayloadBase64CustomizeDiff(diff)
 			},
+			// Recreate the secret if the payload_file content has changed.
+			func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceSecretV1PayloadFileCustomizeDiff(diff)
+			},
 		),
 	}
 
@@ -246,14 +266,27 @@ func resourceKeyManagerSecretV1Create(ctx context.Context, d *schema.ResourceDat
 	}
 
 	// set the payload
-	updateOpts := secrets.UpdateOpts{
-		Payload:         d.Get("payload").(string),
-		ContentType:     d.Get("payload_content_type").(string),
-		ContentEncoding: d.Get("payload_content_encoding").(string),
-	}
-	err = secrets.Update(kmClient, uuid, updateOpts).Err
-	if err != nil {
-		return diag.Errorf("Error setting openstack_keymanager_secret_v1 payload: %s", err)
+	if v, ok := d.GetOk("payload_file"); ok {
+		file, err := keyManagerSecretV1PayloadFile(v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		defer file.Close()
+
+		err = keyManagerSecretV1UpdatePayload(kmClient, uuid, file, d.Get("payload_content_type").(string), d.Get("payload_content_encoding").(string))
+		if err != nil {
+			return diag.Errorf("Error setting openstack_keymanager_secret_v1 payload from payload_file: %s", err)
+		}
+	} else {
+		updateOpts := secrets.UpdateOpts{
+			Payload:         d.Get("payload").(string),
+			ContentType:     d.Get("payload_content_type").(string),
+			ContentEncoding: d.Get("payload_content_encoding").(string),
+		}
+		err = secrets.Update(kmClient, uuid, updateOpts).Err
+		if err != nil {
+			return diag.Errorf("Error setting openstack_keymanager_secret_v1 payload: %s", err)
+		}
 	}
 
 	_, err = stateConf.WaitForStateContext(ctx)
@@ -302,11 +335,27 @@ func resourceKeyManagerSecretV1Read(ctx context.Context, d *schema.ResourceData,
 
 	secret, err := secrets.Get(kmClient, d.Id()).Extract()
 	if err != nil {
+		// Barbican doesn't return the expired secrets.
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			if expiration := d.Get("expiration").(string); keyManagerSecretV1Expired(expiration) {
+				diags := keyManagerSecretV1ExpiredWarning(d.Id(), expiration)
+				d.SetId("")
+				return diags
+			}
+		}
 		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_keymanager_secret_v1"))
 	}
 
 	log.Printf("[DEBUG] Retrieved openstack_keymanager_secret_v1 %s: %#v", d.Id(), secret)
 
+	if secret.Expiration != (time.Time{}) {
+		if expiration := secret.Expiration.Format(time.RFC3339); keyManagerSecretV1Expired(expiration) {
+			diags := keyManagerSecretV1ExpiredWarning(d.Id(), expiration)
+			d.SetId("")
+			return diags
+		}
+	}
+
 	d.Set("name", secret.Name)
 
 	d.Set("bit_length", secret.BitLength)
@@ -324,7 +373,16 @@ func resourceKeyManagerSecretV1Read(ctx context.Context, d *schema.ResourceData,
 	payloadContentType, _ := secret.ContentTypes["default"]
 	d.Set("payload_content_type", payloadContentType)
 
-	d.Set("payload", keyManagerSecretV1GetPayload(kmClient, d.Id()))
+	// Only the checksum of the payload is stored, when it is read from a file.
+	payload := keyManagerSecretV1GetPayload(kmClient, d.Id())
+	if d.Get("payload_file").(string) != "" {
+		hasher := sha256.New()
+		hasher.Write([]byte(payload))
+		d.Set("payload_file_sha256", hex.EncodeToString(hasher.Sum(nil)))
+	} else {
+		d.Set("payload", payload)
+	}
+
 	metadataMap, err := secrets.GetMetadata(kmClient, d.Id()).Extract()
 	if err != nil {
 		log.Printf("[DEBUG] Unable to get %s secret metadata: %s", d.Id(), err)
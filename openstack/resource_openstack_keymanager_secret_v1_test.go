@@ -2,6 +2,9 @@ package openstack
 
 import (
 	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -36,6 +39,88 @@ func TestAccKeyManagerSecretV1_basic(t *testing.T) {
 	})
 }
 
+func TestAccKeyManagerSecretV1_payloadFile(t *testing.T) {
+	var secret secrets.Secret
+	tmpfile, err := ioutil.TempFile("", "tf_test_keymanager_secret")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer os.Remove(tmpfile.Name())
+	if _, err := tmpfile.Write([]byte("foobar")); err != nil {
+		log.Fatal(err)
+	}
+	if err := tmpfile.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckKeyManager(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSecretV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccKeyManagerSecretV1PayloadFile, tmpfile.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretV1Exists(
+						"openstack_keymanager_secret_v1.secret_1", &secret),
+					testAccCheckPayloadEquals("foobar", &secret),
+					resource.TestCheckResourceAttr("openstack_keymanager_secret_v1.secret_1", "payload", ""),
+					resource.TestCheckResourceAttr("openstack_keymanager_secret_v1.secret_1", "payload_file_sha256",
+						"c3ab8ff13720e8ad9047dd39466b3c8974e592c2fa383d4a3960714caef0c4f2"),
+				),
+			},
+		},
+	})
+}
+
+func TestKeyManagerSecretV1PayloadFileSHA256(t *testing.T) {
+	tmpfile, err := ioutil.TempFile("", "tf_test_keymanager_secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpfile.Name())
+	if _, err := tmpfile.Write([]byte("Zm9vYmFy")); err != nil {
+		t.Fatal(err)
+	}
+	if err := tmpfile.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]string{
+		"":       "d7e924568e9c1fcd2de171d6f7f3bad2622837a62a51340ebe76ec0e4c0d340f",
+		"base64": "c3ab8ff13720e8ad9047dd39466b3c8974e592c2fa383d4a3960714caef0c4f2",
+	}
+	for encoding, sum := range expected {
+		actual, err := keyManagerSecretV1PayloadFileSHA256(tmpfile.Name(), encoding)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if actual != sum {
+			t.Fatalf("unexpected checksum for %q encoding: %s", encoding, actual)
+		}
+	}
+
+	if _, err := keyManagerSecretV1PayloadFileSHA256(tmpfile.Name()+"_missing", ""); err == nil {
+		t.Fatal("expected an error for a missing payload_file")
+	}
+}
+
+func TestKeyManagerSecretV1Expired(t *testing.T) {
+	if !keyManagerSecretV1Expired("2000-01-01T00:00:00Z") {
+		t.Fatal("expected the secret to be expired")
+	}
+	if keyManagerSecretV1Expired("3000-01-01T00:00:00Z") {
+		t.Fatal("expected the secret not to be expired")
+	}
+	if keyManagerSecretV1Expired("") {
+		t.Fatal("expected the secret without expiration not to be expired")
+	}
+}
+
 func TestAccKeyManagerSecretV1_basicWithMetadata(t *testing.T) {
 	var secret secrets.Secret
 	resource.Test(t, resource.TestCase{
@@ -335,6 +420,14 @@ resource "openstack_keymanager_secret_v1" "secret_1" {
   secret_type = "passphrase"
 }`
 
+const testAccKeyManagerSecretV1PayloadFile = `
+resource "openstack_keymanager_secret_v1" "secret_1" {
+  name = "mysecret"
+  payload_file = "%s"
+  payload_content_type = "text/plain"
+  secret_type = "passphrase"
+}`
+
 const testAccKeyManagerSecretV1BasicWithMetadata = `
 resource "openstack_keymanager_secret_v1" "secret_1" {
   algorithm = "aes"
@@ -0,0 +1,135 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/amphorae"
+)
+
+func resourceLoadBalancerAmphoraFailoverV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLoadBalancerAmphoraFailoverV2Create,
+		ReadContext:   resourceLoadBalancerAmphoraFailoverV2Read,
+		DeleteContext: resourceLoadBalancerAmphoraFailoverV2Delete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"amphora_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"failover_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"loadbalancer_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"replacement_amphora_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"replacement_compute_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceLoadBalancerAmphoraFailoverV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	if lbClient.Type != octaviaLBClientType {
+		return diag.Errorf("Error creating openstack_lb_amphora_failover_v2: Only available when using octavia")
+	}
+
+	amphoraID := d.Get("amphora_id").(string)
+	amphora, err := amphorae.Get(lbClient, amphoraID).Extract()
+	if err != nil {
+		return diag.Errorf("Unable to retrieve openstack_lb_amphora_failover_v2 amphora %s: %s", amphoraID, err)
+	}
+
+	// Wait for the load-balancer to become active before the failover.
+	timeout := d.Timeout(schema.TimeoutCreate)
+	err = waitForLBV2LoadBalancer(ctx, lbClient, amphora.LoadbalancerID, "ACTIVE", getLbPendingStatuses(), timeout)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Triggering openstack_lb_amphora_failover_v2 failover of amphora %s", amphoraID)
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		err = amphorae.Failover(lbClient, amphoraID).ExtractErr()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return diag.Errorf("Error triggering openstack_lb_amphora_failover_v2 failover of amphora %s: %s", amphoraID, err)
+	}
+
+	// Wait for the load-balancer to become active after the failover.
+	err = waitForLBV2LoadBalancer(ctx, lbClient, amphora.LoadbalancerID, "ACTIVE", getLbPendingStatuses(), timeout)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	replacement, err := waitForLBV2AmphoraReplacement(ctx, lbClient, amphora, timeout)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] openstack_lb_amphora_failover_v2 amphora %s was replaced by %s", amphoraID, replacement.ID)
+
+	d.SetId(amphoraID)
+	d.Set("loadbalancer_id", amphora.LoadbalancerID)
+	d.Set("replacement_amphora_id", replacement.ID)
+	d.Set("replacement_compute_id", replacement.ComputeID)
+
+	return resourceLoadBalancerAmphoraFailoverV2Read(ctx, d, meta)
+}
+
+// resourceLoadBalancerAmphoraFailoverV2Read doesn't query the API, since the
+// failed over amphora no longer exists once the failover has completed.
+func resourceLoadBalancerAmphoraFailoverV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceLoadBalancerAmphoraFailoverV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Removing openstack_lb_amphora_failover_v2 %s from the state", d.Id())
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccLBV2AmphoraFailover_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckLB(t)
+			testAccPreCheckUseOctavia(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBV2LoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLbV2LoadBalancerConfigBasic("octavia"),
+			},
+			{
+				Config: testAccLbV2AmphoraFailoverConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"openstack_lb_amphora_failover_v2.failover_1", "loadbalancer_id",
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "id"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_lb_amphora_failover_v2.failover_1", "replacement_amphora_id"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_lb_amphora_failover_v2.failover_1", "replacement_compute_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLbV2AmphoraFailoverConfigBasic() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_lb_amphorae_v2" "amphorae_1" {
+  loadbalancer_id = "${openstack_lb_loadbalancer_v2.loadbalancer_1.id}"
+}
+
+resource "openstack_lb_amphora_failover_v2" "failover_1" {
+  amphora_id = "${data.openstack_lb_amphorae_v2.amphorae_1.amphorae.0.id}"
+}
+`, testAccLbV2LoadBalancerConfigBasic("octavia"))
+}
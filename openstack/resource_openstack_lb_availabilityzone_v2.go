@@ -0,0 +1,167 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceLoadBalancerAvailabilityZoneV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLoadBalancerAvailabilityZoneV2Create,
+		ReadContext:   resourceLoadBalancerAvailabilityZoneV2Read,
+		UpdateContext: resourceLoadBalancerAvailabilityZoneV2Update,
+		DeleteContext: resourceLoadBalancerAvailabilityZoneV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"availability_zone_profile_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceLoadBalancerAvailabilityZoneV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	if lbClient.Type != octaviaLBClientType {
+		return diag.Errorf("Error creating openstack_lb_availabilityzone_v2: Only available when using octavia")
+	}
+
+	enabled := d.Get("enabled").(bool)
+	createOpts := lbAvailabilityZoneV2CreateOpts{
+		Name:                      d.Get("name").(string),
+		Description:               d.Get("description").(string),
+		AvailabilityZoneProfileID: d.Get("availability_zone_profile_id").(string),
+		Enabled:                   &enabled,
+	}
+
+	log.Printf("[DEBUG] openstack_lb_availabilityzone_v2 create options: %#v", createOpts)
+	availabilityZone, err := lbAvailabilityZoneV2Create(lbClient, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating openstack_lb_availabilityzone_v2: %s", err)
+	}
+
+	d.SetId(availabilityZone.Name)
+
+	return resourceLoadBalancerAvailabilityZoneV2Read(ctx, d, meta)
+}
+
+func resourceLoadBalancerAvailabilityZoneV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	if lbClient.Type != octaviaLBClientType {
+		return diag.Errorf("Error retrieving openstack_lb_availabilityzone_v2: Only available when using octavia")
+	}
+
+	availabilityZone, err := lbAvailabilityZoneV2Get(lbClient, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_lb_availabilityzone_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_lb_availabilityzone_v2 %s: %#v", d.Id(), availabilityZone)
+
+	d.Set("region", GetRegion(d, config))
+	d.Set("name", availabilityZone.Name)
+	d.Set("description", availabilityZone.Description)
+	d.Set("availability_zone_profile_id", availabilityZone.AvailabilityZoneProfileID)
+	d.Set("enabled", availabilityZone.Enabled)
+
+	return nil
+}
+
+func resourceLoadBalancerAvailabilityZoneV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	if lbClient.Type != octaviaLBClientType {
+		return diag.Errorf("Error updating openstack_lb_availabilityzone_v2: Only available when using octavia")
+	}
+
+	var (
+		hasChange  bool
+		updateOpts lbAvailabilityZoneV2UpdateOpts
+	)
+
+	if d.HasChange("description") {
+		hasChange = true
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if d.HasChange("enabled") {
+		hasChange = true
+		enabled := d.Get("enabled").(bool)
+		updateOpts.Enabled = &enabled
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] openstack_lb_availabilityzone_v2 %s update options: %#v", d.Id(), updateOpts)
+		_, err := lbAvailabilityZoneV2Update(lbClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error updating openstack_lb_availabilityzone_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceLoadBalancerAvailabilityZoneV2Read(ctx, d, meta)
+}
+
+func resourceLoadBalancerAvailabilityZoneV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	if lbClient.Type != octaviaLBClientType {
+		return diag.Errorf("Error deleting openstack_lb_availabilityzone_v2: Only available when using octavia")
+	}
+
+	if err := lbAvailabilityZoneV2Delete(lbClient, d.Id()).ExtractErr(); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_lb_availabilityzone_v2"))
+	}
+
+	return nil
+}
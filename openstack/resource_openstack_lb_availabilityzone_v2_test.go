@@ -0,0 +1,133 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccLBV2AvailabilityZone_basic(t *testing.T) {
+	var availabilityZone lbAvailabilityZoneV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckLB(t)
+			testAccPreCheckUseOctavia(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBV2AvailabilityZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV2AvailabilityZoneBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2AvailabilityZoneExists("openstack_lb_availabilityzone_v2.availabilityzone_1", &availabilityZone),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_availabilityzone_v2.availabilityzone_1", "name", "availabilityzone_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_availabilityzone_v2.availabilityzone_1", "description", "availabilityzone_1 description"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_availabilityzone_v2.availabilityzone_1", "enabled", "true"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_lb_availabilityzone_v2.availabilityzone_1", "availability_zone_profile_id",
+						"openstack_lb_availabilityzoneprofile_v2.availabilityzoneprofile_1", "id"),
+				),
+			},
+			{
+				Config: testAccLBV2AvailabilityZoneUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2AvailabilityZoneExists("openstack_lb_availabilityzone_v2.availabilityzone_1", &availabilityZone),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_availabilityzone_v2.availabilityzone_1", "description", "availabilityzone_1 updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_availabilityzone_v2.availabilityzone_1", "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLBV2AvailabilityZoneDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	lbClient, err := config.LoadBalancerV2Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack load balancing client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_lb_availabilityzone_v2" {
+			continue
+		}
+
+		_, err := lbAvailabilityZoneV2Get(lbClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Availability zone still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLBV2AvailabilityZoneExists(n string, availabilityZone *lbAvailabilityZoneV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		lbClient, err := config.LoadBalancerV2Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack load balancing client: %s", err)
+		}
+
+		found, err := lbAvailabilityZoneV2Get(lbClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.Name != rs.Primary.ID {
+			return fmt.Errorf("Availability zone not found")
+		}
+
+		*availabilityZone = *found
+
+		return nil
+	}
+}
+
+const testAccLBV2AvailabilityZoneBasic = `
+resource "openstack_lb_availabilityzoneprofile_v2" "availabilityzoneprofile_1" {
+  name                   = "availabilityzoneprofile_1"
+  provider_name          = "amphora"
+  availability_zone_data = "{\"compute_zone\": \"nova\"}"
+}
+
+resource "openstack_lb_availabilityzone_v2" "availabilityzone_1" {
+  name                         = "availabilityzone_1"
+  description                  = "availabilityzone_1 description"
+  availability_zone_profile_id = "${openstack_lb_availabilityzoneprofile_v2.availabilityzoneprofile_1.id}"
+}
+`
+
+const testAccLBV2AvailabilityZoneUpdate = `
+resource "openstack_lb_availabilityzoneprofile_v2" "availabilityzoneprofile_1" {
+  name                   = "availabilityzoneprofile_1"
+  provider_name          = "amphora"
+  availability_zone_data = "{\"compute_zone\": \"nova\"}"
+}
+
+resource "openstack_lb_availabilityzone_v2" "availabilityzone_1" {
+  name                         = "availabilityzone_1"
+  description                  = "availabilityzone_1 updated"
+  enabled                      = false
+  availability_zone_profile_id = "${openstack_lb_availabilityzoneprofile_v2.availabilityzoneprofile_1.id}"
+}
+`
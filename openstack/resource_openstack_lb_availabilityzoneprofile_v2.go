@@ -0,0 +1,182 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+)
+
+func resourceLoadBalancerAvailabilityZoneProfileV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLoadBalancerAvailabilityZoneProfileV2Create,
+		ReadContext:   resourceLoadBalancerAvailabilityZoneProfileV2Read,
+		UpdateContext: resourceLoadBalancerAvailabilityZoneProfileV2Update,
+		DeleteContext: resourceLoadBalancerAvailabilityZoneProfileV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"provider_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"availability_zone_data": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateJSONObject,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+		},
+	}
+}
+
+func resourceLoadBalancerAvailabilityZoneProfileV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	if lbClient.Type != octaviaLBClientType {
+		return diag.Errorf("Error creating openstack_lb_availabilityzoneprofile_v2: Only available when using octavia")
+	}
+
+	availabilityZoneData, err := structure.NormalizeJsonString(d.Get("availability_zone_data").(string))
+	if err != nil {
+		return diag.Errorf("Error normalizing openstack_lb_availabilityzoneprofile_v2 availability_zone_data: %s", err)
+	}
+
+	createOpts := lbAvailabilityZoneProfileV2CreateOpts{
+		Name:                 d.Get("name").(string),
+		ProviderName:         d.Get("provider_name").(string),
+		AvailabilityZoneData: availabilityZoneData,
+	}
+
+	log.Printf("[DEBUG] openstack_lb_availabilityzoneprofile_v2 create options: %#v", createOpts)
+	availabilityZoneProfile, err := lbAvailabilityZoneProfileV2Create(lbClient, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating openstack_lb_availabilityzoneprofile_v2: %s", err)
+	}
+
+	d.SetId(availabilityZoneProfile.ID)
+
+	return resourceLoadBalancerAvailabilityZoneProfileV2Read(ctx, d, meta)
+}
+
+func resourceLoadBalancerAvailabilityZoneProfileV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	if lbClient.Type != octaviaLBClientType {
+		return diag.Errorf("Error retrieving openstack_lb_availabilityzoneprofile_v2: Only available when using octavia")
+	}
+
+	availabilityZoneProfile, err := lbAvailabilityZoneProfileV2Get(lbClient, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_lb_availabilityzoneprofile_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_lb_availabilityzoneprofile_v2 %s: %#v", d.Id(), availabilityZoneProfile)
+
+	availabilityZoneData, err := structure.NormalizeJsonString(availabilityZoneProfile.AvailabilityZoneData)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to normalize openstack_lb_availabilityzoneprofile_v2 %s availability_zone_data: %s", d.Id(), err)
+		availabilityZoneData = availabilityZoneProfile.AvailabilityZoneData
+	}
+
+	d.Set("region", GetRegion(d, config))
+	d.Set("name", availabilityZoneProfile.Name)
+	d.Set("provider_name", availabilityZoneProfile.ProviderName)
+	d.Set("availability_zone_data", availabilityZoneData)
+
+	return nil
+}
+
+func resourceLoadBalancerAvailabilityZoneProfileV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	if lbClient.Type != octaviaLBClientType {
+		return diag.Errorf("Error updating openstack_lb_availabilityzoneprofile_v2: Only available when using octavia")
+	}
+
+	var (
+		hasChange  bool
+		updateOpts lbAvailabilityZoneProfileV2UpdateOpts
+	)
+
+	if d.HasChange("name") {
+		hasChange = true
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+
+	if d.HasChange("provider_name") {
+		hasChange = true
+		providerName := d.Get("provider_name").(string)
+		updateOpts.ProviderName = &providerName
+	}
+
+	if d.HasChange("availability_zone_data") {
+		hasChange = true
+		availabilityZoneData, err := structure.NormalizeJsonString(d.Get("availability_zone_data").(string))
+		if err != nil {
+			return diag.Errorf("Error normalizing openstack_lb_availabilityzoneprofile_v2 availability_zone_data: %s", err)
+		}
+		updateOpts.AvailabilityZoneData = &availabilityZoneData
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] openstack_lb_availabilityzoneprofile_v2 %s update options: %#v", d.Id(), updateOpts)
+		_, err := lbAvailabilityZoneProfileV2Update(lbClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error updating openstack_lb_availabilityzoneprofile_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceLoadBalancerAvailabilityZoneProfileV2Read(ctx, d, meta)
+}
+
+func resourceLoadBalancerAvailabilityZoneProfileV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	if lbClient.Type != octaviaLBClientType {
+		return diag.Errorf("Error deleting openstack_lb_availabilityzoneprofile_v2: Only available when using octavia")
+	}
+
+	if err := lbAvailabilityZoneProfileV2Delete(lbClient, d.Id()).ExtractErr(); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_lb_availabilityzoneprofile_v2"))
+	}
+
+	return nil
+}
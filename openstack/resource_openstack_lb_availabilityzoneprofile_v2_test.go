@@ -0,0 +1,125 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccLBV2AvailabilityZoneProfile_basic(t *testing.T) {
+	var availabilityZoneProfile lbAvailabilityZoneProfileV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckLB(t)
+			testAccPreCheckUseOctavia(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBV2AvailabilityZoneProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV2AvailabilityZoneProfileBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2AvailabilityZoneProfileExists("openstack_lb_availabilityzoneprofile_v2.availabilityzoneprofile_1", &availabilityZoneProfile),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_availabilityzoneprofile_v2.availabilityzoneprofile_1", "name", "availabilityzoneprofile_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_availabilityzoneprofile_v2.availabilityzoneprofile_1", "provider_name", "amphora"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_availabilityzoneprofile_v2.availabilityzoneprofile_1", "availability_zone_data", `{"compute_zone":"nova"}`),
+				),
+			},
+			{
+				Config: testAccLBV2AvailabilityZoneProfileUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2AvailabilityZoneProfileExists("openstack_lb_availabilityzoneprofile_v2.availabilityzoneprofile_1", &availabilityZoneProfile),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_availabilityzoneprofile_v2.availabilityzoneprofile_1", "name", "availabilityzoneprofile_1_updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_availabilityzoneprofile_v2.availabilityzoneprofile_1", "availability_zone_data", `{"compute_zone":"nova2"}`),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLBV2AvailabilityZoneProfileDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	lbClient, err := config.LoadBalancerV2Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack load balancing client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_lb_availabilityzoneprofile_v2" {
+			continue
+		}
+
+		_, err := lbAvailabilityZoneProfileV2Get(lbClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Availability zone profile still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLBV2AvailabilityZoneProfileExists(n string, availabilityZoneProfile *lbAvailabilityZoneProfileV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		lbClient, err := config.LoadBalancerV2Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack load balancing client: %s", err)
+		}
+
+		found, err := lbAvailabilityZoneProfileV2Get(lbClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Availability zone profile not found")
+		}
+
+		*availabilityZoneProfile = *found
+
+		return nil
+	}
+}
+
+const testAccLBV2AvailabilityZoneProfileBasic = `
+resource "openstack_lb_availabilityzoneprofile_v2" "availabilityzoneprofile_1" {
+  name          = "availabilityzoneprofile_1"
+  provider_name = "amphora"
+  availability_zone_data   = <<EOF
+{
+  "compute_zone": "nova"
+}
+EOF
+}
+`
+
+const testAccLBV2AvailabilityZoneProfileUpdate = `
+resource "openstack_lb_availabilityzoneprofile_v2" "availabilityzoneprofile_1" {
+  name          = "availabilityzoneprofile_1_updated"
+  provider_name = "amphora"
+  availability_zone_data   = <<EOF
+{
+  "compute_zone": "nova2"
+}
+EOF
+}
+`
@@ -0,0 +1,172 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceLoadBalancerFlavorV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLoadBalancerFlavorV2Create,
+		ReadContext:   resourceLoadBalancerFlavorV2Read,
+		UpdateContext: resourceLoadBalancerFlavorV2Update,
+		DeleteContext: resourceLoadBalancerFlavorV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"flavor_profile_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceLoadBalancerFlavorV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	if lbClient.Type != octaviaLBClientType {
+		return diag.Errorf("Error creating openstack_lb_flavor_v2: Only available when using octavia")
+	}
+
+	enabled := d.Get("enabled").(bool)
+	createOpts := lbFlavorV2CreateOpts{
+		Name:            d.Get("name").(string),
+		Description:     d.Get("description").(string),
+		FlavorProfileID: d.Get("flavor_profile_id").(string),
+		Enabled:         &enabled,
+	}
+
+	log.Printf("[DEBUG] openstack_lb_flavor_v2 create options: %#v", createOpts)
+	flavor, err := lbFlavorV2Create(lbClient, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating openstack_lb_flavor_v2: %s", err)
+	}
+
+	d.SetId(flavor.ID)
+
+	return resourceLoadBalancerFlavorV2Read(ctx, d, meta)
+}
+
+func resourceLoadBalancerFlavorV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	if lbClient.Type != octaviaLBClientType {
+		return diag.Errorf("Error retrieving openstack_lb_flavor_v2: Only available when using octavia")
+	}
+
+	flavor, err := lbFlavorV2Get(lbClient, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_lb_flavor_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_lb_flavor_v2 %s: %#v", d.Id(), flavor)
+
+	d.Set("region", GetRegion(d, config))
+	d.Set("name", flavor.Name)
+	d.Set("description", flavor.Description)
+	d.Set("flavor_profile_id", flavor.FlavorProfileID)
+	d.Set("enabled", flavor.Enabled)
+
+	return nil
+}
+
+func resourceLoadBalancerFlavorV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	if lbClient.Type != octaviaLBClientType {
+		return diag.Errorf("Error updating openstack_lb_flavor_v2: Only available when using octavia")
+	}
+
+	var (
+		hasChange  bool
+		updateOpts lbFlavorV2UpdateOpts
+	)
+
+	if d.HasChange("name") {
+		hasChange = true
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+
+	if d.HasChange("description") {
+		hasChange = true
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if d.HasChange("enabled") {
+		hasChange = true
+		enabled := d.Get("enabled").(bool)
+		updateOpts.Enabled = &enabled
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] openstack_lb_flavor_v2 %s update options: %#v", d.Id(), updateOpts)
+		_, err := lbFlavorV2Update(lbClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error updating openstack_lb_flavor_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceLoadBalancerFlavorV2Read(ctx, d, meta)
+}
+
+func resourceLoadBalancerFlavorV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	if lbClient.Type != octaviaLBClientType {
+		return diag.Errorf("Error deleting openstack_lb_flavor_v2: Only available when using octavia")
+	}
+
+	if err := lbFlavorV2Delete(lbClient, d.Id()).ExtractErr(); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_lb_flavor_v2"))
+	}
+
+	return nil
+}
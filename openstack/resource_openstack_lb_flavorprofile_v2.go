@@ -0,0 +1,182 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+)
+
+func resourceLoadBalancerFlavorProfileV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLoadBalancerFlavorProfileV2Create,
+		ReadContext:   resourceLoadBalancerFlavorProfileV2Read,
+		UpdateContext: resourceLoadBalancerFlavorProfileV2Update,
+		DeleteContext: resourceLoadBalancerFlavorProfileV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"provider_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"flavor_data": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateJSONObject,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+		},
+	}
+}
+
+func resourceLoadBalancerFlavorProfileV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	if lbClient.Type != octaviaLBClientType {
+		return diag.Errorf("Error creating openstack_lb_flavorprofile_v2: Only available when using octavia")
+	}
+
+	flavorData, err := structure.NormalizeJsonString(d.Get("flavor_data").(string))
+	if err != nil {
+		return diag.Errorf("Error normalizing openstack_lb_flavorprofile_v2 flavor_data: %s", err)
+	}
+
+	createOpts := lbFlavorProfileV2CreateOpts{
+		Name:         d.Get("name").(string),
+		ProviderName: d.Get("provider_name").(string),
+		FlavorData:   flavorData,
+	}
+
+	log.Printf("[DEBUG] openstack_lb_flavorprofile_v2 create options: %#v", createOpts)
+	flavorProfile, err := lbFlavorProfileV2Create(lbClient, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating openstack_lb_flavorprofile_v2: %s", err)
+	}
+
+	d.SetId(flavorProfile.ID)
+
+	return resourceLoadBalancerFlavorProfileV2Read(ctx, d, meta)
+}
+
+func resourceLoadBalancerFlavorProfileV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	if lbClient.Type != octaviaLBClientType {
+		return diag.Errorf("Error retrieving openstack_lb_flavorprofile_v2: Only available when using octavia")
+	}
+
+	flavorProfile, err := lbFlavorProfileV2Get(lbClient, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_lb_flavorprofile_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_lb_flavorprofile_v2 %s: %#v", d.Id(), flavorProfile)
+
+	flavorData, err := structure.NormalizeJsonString(flavorProfile.FlavorData)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to normalize openstack_lb_flavorprofile_v2 %s flavor_data: %s", d.Id(), err)
+		flavorData = flavorProfile.FlavorData
+	}
+
+	d.Set("region", GetRegion(d, config))
+	d.Set("name", flavorProfile.Name)
+	d.Set("provider_name", flavorProfile.ProviderName)
+	d.Set("flavor_data", flavorData)
+
+	return nil
+}
+
+func resourceLoadBalancerFlavorProfileV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	if lbClient.Type != octaviaLBClientType {
+		return diag.Errorf("Error updating openstack_lb_flavorprofile_v2: Only available when using octavia")
+	}
+
+	var (
+		hasChange  bool
+		updateOpts lbFlavorProfileV2UpdateOpts
+	)
+
+	if d.HasChange("name") {
+		hasChange = true
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+
+	if d.HasChange("provider_name") {
+		hasChange = true
+		providerName := d.Get("provider_name").(string)
+		updateOpts.ProviderName = &providerName
+	}
+
+	if d.HasChange("flavor_data") {
+		hasChange = true
+		flavorData, err := structure.NormalizeJsonString(d.Get("flavor_data").(string))
+		if err != nil {
+			return diag.Errorf("Error normalizing openstack_lb_flavorprofile_v2 flavor_data: %s", err)
+		}
+		updateOpts.FlavorData = &flavorData
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] openstack_lb_flavorprofile_v2 %s update options: %#v", d.Id(), updateOpts)
+		_, err := lbFlavorProfileV2Update(lbClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error updating openstack_lb_flavorprofile_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceLoadBalancerFlavorProfileV2Read(ctx, d, meta)
+}
+
+func resourceLoadBalancerFlavorProfileV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	if lbClient.Type != octaviaLBClientType {
+		return diag.Errorf("Error deleting openstack_lb_flavorprofile_v2: Only available when using octavia")
+	}
+
+	if err := lbFlavorProfileV2Delete(lbClient, d.Id()).ExtractErr(); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_lb_flavorprofile_v2"))
+	}
+
+	return nil
+}
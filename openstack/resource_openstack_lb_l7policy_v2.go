@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -62,7 +63,7 @@ func resourceL7PolicyV2() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 				ValidateFunc: validation.StringInSlice([]string{
-					"REDIRECT_TO_POOL", "REDIRECT_TO_URL", "REJECT",
+					"REDIRECT_TO_POOL", "REDIRECT_TO_URL", "REDIRECT_PREFIX", "REJECT",
 				}, true),
 			},
 
@@ -80,22 +81,29 @@ func resourceL7PolicyV2() *schema.Resource {
 
 			"redirect_pool_id": {
 				Type:          schema.TypeString,
-				ConflictsWith: []string{"redirect_url"},
+				ConflictsWith: []string{"redirect_url", "redirect_prefix"},
 				Optional:      true,
 			},
 
 			"redirect_url": {
 				Type:          schema.TypeString,
-				ConflictsWith: []string{"redirect_pool_id"},
+				ConflictsWith: []string{"redirect_pool_id", "redirect_prefix"},
 				Optional:      true,
-				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
-					value := v.(string)
-					_, err := url.ParseRequestURI(value)
-					if err != nil {
-						errors = append(errors, fmt.Errorf("URL is not valid: %s", err))
-					}
-					return
-				},
+				ValidateFunc:  validateL7PolicyV2URL,
+			},
+
+			"redirect_prefix": {
+				Type:          schema.TypeString,
+				ConflictsWith: []string{"redirect_pool_id", "redirect_url"},
+				Optional:      true,
+				ValidateFunc:  validateL7PolicyV2URL,
+			},
+
+			"redirect_http_code": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntInSlice([]int{301, 302, 303, 307, 308}),
 			},
 
 			"admin_state_up": {
@@ -103,7 +111,20 @@ func resourceL7PolicyV2() *schema.Resource {
 				Default:  true,
 				Optional: true,
 			},
+
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
 		},
+
+		CustomizeDiff: customdiff.Sequence(
+			func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return lbL7PolicyV2CustomizeDiff(diff)
+			},
+		),
 	}
 }
 
@@ -119,29 +140,44 @@ func resourceL7PolicyV2Create(ctx context.Context, d *schema.ResourceData, meta
 	action := d.Get("action").(string)
 	redirectPoolID := d.Get("redirect_pool_id").(string)
 	redirectURL := d.Get("redirect_url").(string)
+	redirectPrefix := d.Get("redirect_prefix").(string)
 
 	// Ensure the right combination of options have been specified.
-	err = checkL7PolicyAction(action, redirectURL, redirectPoolID)
+	err = checkL7PolicyAction(action, redirectURL, redirectPoolID, redirectPrefix)
 	if err != nil {
 		return diag.Errorf("Unable to create L7 Policy: %s", err)
 	}
 
+	if config.UseOctavia {
+		if err := lbV2CheckL7PolicyAPIVersion(d, lbClient, true); err != nil {
+			return diag.Errorf("Unable to create L7 Policy: %s", err)
+		}
+	}
+
 	adminStateUp := d.Get("admin_state_up").(bool)
-	createOpts := l7policies.CreateOpts{
-		TenantID:       d.Get("tenant_id").(string),
-		Name:           d.Get("name").(string),
-		Description:    d.Get("description").(string),
-		Action:         l7policies.Action(action),
-		ListenerID:     listenerID,
-		RedirectPoolID: redirectPoolID,
-		RedirectURL:    redirectURL,
-		AdminStateUp:   &adminStateUp,
+	createOpts := L7PolicyCreateOpts{
+		CreateOpts: l7policies.CreateOpts{
+			TenantID:       d.Get("tenant_id").(string),
+			Name:           d.Get("name").(string),
+			Description:    d.Get("description").(string),
+			Action:         l7policies.Action(action),
+			ListenerID:     listenerID,
+			RedirectPoolID: redirectPoolID,
+			RedirectURL:    redirectURL,
+			AdminStateUp:   &adminStateUp,
+		},
+		RedirectPrefix:   redirectPrefix,
+		RedirectHTTPCode: d.Get("redirect_http_code").(int),
 	}
 
 	if v, ok := d.GetOk("position"); ok {
 		createOpts.Position = int32(v.(int))
 	}
 
+	if tags := lbV2ExpandTags(d, lbClient, config.DefaultTags, true); tags != nil {
+		createOpts.Tags = *tags
+	}
+
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 
 	timeout := d.Timeout(schema.TimeoutCreate)
@@ -203,7 +239,7 @@ func resourceL7PolicyV2Read(ctx context.Context, d *schema.ResourceData, meta in
 		return diag.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	l7Policy, err := l7policies.Get(lbClient, d.Id()).Extract()
+	l7Policy, err := lbL7PolicyV2Get(lbClient, d.Id())
 	if err != nil {
 		return diag.FromErr(CheckDeleted(d, err, "L7 Policy"))
 	}
@@ -217,8 +253,11 @@ func resourceL7PolicyV2Read(ctx context.Context, d *schema.ResourceData, meta in
 	d.Set("position", int(l7Policy.Position))
 	d.Set("redirect_url", l7Policy.RedirectURL)
 	d.Set("redirect_pool_id", l7Policy.RedirectPoolID)
+	d.Set("redirect_prefix", l7Policy.RedirectPrefix)
+	d.Set("redirect_http_code", l7Policy.RedirectHTTPCode)
 	d.Set("region", GetRegion(d, config))
 	d.Set("admin_state_up", l7Policy.AdminStateUp)
+	lbV2SetTags(d, l7Policy.Tags, config.DefaultTags)
 
 	return nil
 }
@@ -235,8 +274,9 @@ func resourceL7PolicyV2Update(ctx context.Context, d *schema.ResourceData, meta
 	action := d.Get("action").(string)
 	redirectPoolID := d.Get("redirect_pool_id").(string)
 	redirectURL := d.Get("redirect_url").(string)
+	redirectPrefix := d.Get("redirect_prefix").(string)
 
-	var updateOpts l7policies.UpdateOpts
+	var updateOpts L7PolicyUpdateOpts
 
 	if d.HasChange("action") {
 		updateOpts.Action = l7policies.Action(action)
@@ -258,6 +298,13 @@ func resourceL7PolicyV2Update(ctx context.Context, d *schema.ResourceData, meta
 		redirectURL = d.Get("redirect_url").(string)
 		updateOpts.RedirectURL = &redirectURL
 	}
+	if d.HasChange("redirect_prefix") {
+		updateOpts.RedirectPrefix = &redirectPrefix
+	}
+	if d.HasChange("redirect_http_code") {
+		redirectHTTPCode := d.Get("redirect_http_code").(int)
+		updateOpts.RedirectHTTPCode = &redirectHTTPCode
+	}
 	if d.HasChange("position") {
 		updateOpts.Position = int32(d.Get("position").(int))
 	}
@@ -265,13 +312,22 @@ func resourceL7PolicyV2Update(ctx context.Context, d *schema.ResourceData, meta
 		adminStateUp := d.Get("admin_state_up").(bool)
 		updateOpts.AdminStateUp = &adminStateUp
 	}
+	if tags := lbV2ExpandTags(d, lbClient, config.DefaultTags, false); tags != nil {
+		updateOpts.Tags = tags
+	}
 
 	// Ensure the right combination of options have been specified.
-	err = checkL7PolicyAction(action, redirectURL, redirectPoolID)
+	err = checkL7PolicyAction(action, redirectURL, redirectPoolID, redirectPrefix)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	if config.UseOctavia {
+		if err := lbV2CheckL7PolicyAPIVersion(d, lbClient, false); err != nil {
+			return diag.Errorf("Unable to update L7 Policy %s: %s", d.Id(), err)
+		}
+	}
+
 	// Make sure the pool is active before continuing.
 	timeout := d.Timeout(schema.TimeoutUpdate)
 	if redirectPoolID != "" {
@@ -409,21 +465,46 @@ func resourceL7PolicyV2Import(d *schema.ResourceData, meta interface{}) ([]*sche
 	return []*schema.ResourceData{d}, nil
 }
 
-func checkL7PolicyAction(action, redirectURL, redirectPoolID string) error {
+func checkL7PolicyAction(action, redirectURL, redirectPoolID, redirectPrefix string) error {
 	if action == "REJECT" {
-		if redirectURL != "" || redirectPoolID != "" {
+		if redirectURL != "" || redirectPoolID != "" || redirectPrefix != "" {
 			return fmt.Errorf(
-				"redirect_url and redirect_pool_id must be empty when action is set to %s", action)
+				"redirect_url, redirect_pool_id and redirect_prefix must be empty when action is set to %s", action)
 		}
 	}
 
-	if action == "REDIRECT_TO_POOL" && redirectURL != "" {
-		return fmt.Errorf("redirect_url must be empty when action is set to %s", action)
+	if action == "REDIRECT_TO_POOL" && (redirectURL != "" || redirectPrefix != "") {
+		return fmt.Errorf("redirect_url and redirect_prefix must be empty when action is set to %s", action)
 	}
 
-	if action == "REDIRECT_TO_URL" && redirectPoolID != "" {
-		return fmt.Errorf("redirect_pool_id must be empty when action is set to %s", action)
+	if action == "REDIRECT_TO_URL" && (redirectPoolID != "" || redirectPrefix != "") {
+		return fmt.Errorf("redirect_pool_id and redirect_prefix must be empty when action is set to %s", action)
+	}
+
+	if action == "REDIRECT_PREFIX" && (redirectPoolID != "" || redirectURL != "") {
+		return fmt.Errorf("redirect_pool_id and redirect_url must be empty when action is set to %s", action)
 	}
 
 	return nil
 }
+
+func checkL7PolicyRedirectHTTPCode(action string, redirectHTTPCode int) error {
+	if redirectHTTPCode == 0 {
+		return nil
+	}
+
+	if action != "REDIRECT_TO_URL" && action != "REDIRECT_PREFIX" {
+		return fmt.Errorf("redirect_http_code can only be set when action is set to REDIRECT_TO_URL or REDIRECT_PREFIX")
+	}
+
+	return nil
+}
+
+func validateL7PolicyV2URL(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	_, err := url.ParseRequestURI(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("URL is not valid: %s", err))
+	}
+	return
+}
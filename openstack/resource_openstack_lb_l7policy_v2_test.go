@@ -100,6 +100,85 @@ func TestAccLBV2L7Policy_basic(t *testing.T) {
 	})
 }
 
+func TestAccLBV2L7Policy_octavia_redirect_prefix(t *testing.T) {
+	var l7Policy l7policies.L7Policy
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLB(t)
+			testAccPreCheckUseOctavia(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBV2L7PolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckLbV2L7PolicyConfigRedirectPrefix("https://www.example.com", 301),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2L7PolicyExists("openstack_lb_l7policy_v2.l7policy_1", &l7Policy),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7policy_v2.l7policy_1", "action", "REDIRECT_PREFIX"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7policy_v2.l7policy_1", "redirect_prefix", "https://www.example.com"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7policy_v2.l7policy_1", "redirect_http_code", "301"),
+				),
+			},
+			{
+				Config: testAccCheckLbV2L7PolicyConfigRedirectPrefix("https://www.example.org", 308),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2L7PolicyExists("openstack_lb_l7policy_v2.l7policy_1", &l7Policy),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7policy_v2.l7policy_1", "redirect_prefix", "https://www.example.org"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7policy_v2.l7policy_1", "redirect_http_code", "308"),
+				),
+			},
+			{
+				Config:      testAccCheckLbV2L7PolicyConfigRejectRedirectHTTPCode(),
+				ExpectError: regexp.MustCompile("redirect_http_code can only be set when action is set to REDIRECT_TO_URL or REDIRECT_PREFIX"),
+			},
+		},
+	})
+}
+
+func TestAccLBV2L7Policy_octavia_tags(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLB(t)
+			testAccPreCheckUseOctavia(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBV2L7PolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLbV2L7PolicyConfigOctaviaTags(`["tag1"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7policy_v2.l7policy_1", "tags.#", "1"),
+				),
+			},
+			{
+				Config: testAccLbV2L7PolicyConfigOctaviaTags(`["tag1", "tag2"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7policy_v2.l7policy_1", "tags.#", "2"),
+				),
+			},
+			{
+				Config: testAccLbV2L7PolicyConfigOctaviaTags(`[]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7policy_v2.l7policy_1", "tags.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckLBV2L7PolicyDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	lbClient, err := chooseLBV2AccTestClient(config, osRegionName)
@@ -199,6 +278,33 @@ resource "openstack_lb_l7policy_v2" "l7policy_1" {
 `, testAccCheckLbV2L7PolicyConfig)
 }
 
+func testAccCheckLbV2L7PolicyConfigRedirectPrefix(redirectPrefix string, redirectHTTPCode int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_lb_l7policy_v2" "l7policy_1" {
+  name               = "test"
+  action             = "REDIRECT_PREFIX"
+  listener_id        = "${openstack_lb_listener_v2.listener_1.id}"
+  redirect_prefix    = "%s"
+  redirect_http_code = %d
+}
+`, testAccCheckLbV2L7PolicyConfig, redirectPrefix, redirectHTTPCode)
+}
+
+func testAccCheckLbV2L7PolicyConfigRejectRedirectHTTPCode() string {
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_lb_l7policy_v2" "l7policy_1" {
+  name               = "test"
+  action             = "REJECT"
+  listener_id        = "${openstack_lb_listener_v2.listener_1.id}"
+  redirect_http_code = 302
+}
+`, testAccCheckLbV2L7PolicyConfig)
+}
+
 func testAccCheckLbV2L7PolicyConfigUpdate1() string {
 	return fmt.Sprintf(`
 %s
@@ -254,3 +360,44 @@ resource "openstack_lb_l7policy_v2" "l7policy_1" {
 }
 `, testAccCheckLbV2L7PolicyConfig)
 }
+
+func testAccLbV2L7PolicyConfigOctaviaTags(tags string) string {
+	return fmt.Sprintf(`
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+
+  timeouts {
+    create = "15m"
+    update = "15m"
+    delete = "15m"
+  }
+}
+
+resource "openstack_lb_listener_v2" "listener_1" {
+  name = "listener_1"
+  protocol = "HTTP"
+  protocol_port = 8080
+  loadbalancer_id = "${openstack_lb_loadbalancer_v2.loadbalancer_1.id}"
+}
+
+resource "openstack_lb_l7policy_v2" "l7policy_1" {
+  name = "l7policy_1"
+  action = "REJECT"
+  listener_id = "${openstack_lb_listener_v2.listener_1.id}"
+  tags = %s
+}
+`, tags)
+}
@@ -10,7 +10,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
-	octavialisteners "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/listeners"
 	neutronlisteners "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/listeners"
 )
 
@@ -141,6 +140,62 @@ func resourceListenerV2() *schema.Resource {
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+
+			"tls_versions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"SSLv3", "TLSv1", "TLSv1.1", "TLSv1.2", "TLSv1.3",
+					}, false),
+				},
+			},
+
+			"tls_ciphers": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"alpn_protocols": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"http/1.0", "http/1.1", "h2",
+					}, false),
+				},
+			},
+
+			"client_authentication": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"NONE", "OPTIONAL", "MANDATORY",
+				}, false),
+			},
+
+			"client_ca_tls_container_ref": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"client_crl_container_ref": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
 		},
 	}
 }
@@ -160,8 +215,14 @@ func resourceListenerV2Create(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
+	if config.UseOctavia {
+		if err := lbV2CheckArgumentsAPIVersion(d, lbClient, lbV2ListenerMinAPIVersions, true); err != nil {
+			return diag.Errorf("Error creating openstack_lb_listener_v2: %s", err)
+		}
+	}
+
 	// Choose either the Octavia or Neutron create options.
-	createOpts, err := chooseLBV2ListenerCreateOpts(d, config)
+	createOpts, err := chooseLBV2ListenerCreateOpts(d, config, lbClient)
 	if err != nil {
 		return diag.Errorf("Error building openstack_lb_listener_v2 create options: %s", err)
 	}
@@ -200,7 +261,7 @@ func resourceListenerV2Read(ctx context.Context, d *schema.ResourceData, meta in
 
 	// Use Octavia listener body if Octavia/LBaaS is enabled.
 	if config.UseOctavia {
-		listener, err := octavialisteners.Get(lbClient, d.Id()).Extract()
+		listener, err := lbListenerV2Get(lbClient, d.Id())
 		if err != nil {
 			return diag.FromErr(CheckDeleted(d, err, "openstack_lb_listener_v2"))
 		}
@@ -222,7 +283,14 @@ func resourceListenerV2Read(ctx context.Context, d *schema.ResourceData, meta in
 		d.Set("sni_container_refs", listener.SniContainerRefs)
 		d.Set("default_tls_container_ref", listener.DefaultTlsContainerRef)
 		d.Set("allowed_cidrs", listener.AllowedCIDRs)
+		d.Set("tls_versions", listener.TLSVersions)
+		d.Set("tls_ciphers", listener.TLSCiphers)
+		d.Set("alpn_protocols", listener.ALPNProtocols)
+		d.Set("client_authentication", listener.ClientAuthentication)
+		d.Set("client_ca_tls_container_ref", listener.ClientCATLSContainerRef)
+		d.Set("client_crl_container_ref", listener.ClientCRLContainerRef)
 		d.Set("region", GetRegion(d, config))
+		lbV2SetTags(d, listener.Tags, config.DefaultTags)
 
 		// Required by import.
 		if len(listener.Loadbalancers) > 0 {
@@ -284,7 +352,13 @@ func resourceListenerV2Update(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
-	updateOpts, err := chooseLBV2ListenerUpdateOpts(d, config)
+	if config.UseOctavia {
+		if err := lbV2CheckArgumentsAPIVersion(d, lbClient, lbV2ListenerMinAPIVersions, false); err != nil {
+			return diag.Errorf("Error updating openstack_lb_listener_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	updateOpts, err := chooseLBV2ListenerUpdateOpts(d, config, lbClient)
 	if err != nil {
 		return diag.Errorf("Error building openstack_lb_listener_v2 update options: %s", err)
 	}
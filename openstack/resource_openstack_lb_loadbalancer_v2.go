@@ -121,6 +121,33 @@ func resourceLoadBalancerV2() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
+
+			"additional_vips": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"ip_address": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
+			"failover_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -164,19 +191,31 @@ func resourceLoadBalancerV2Create(ctx context.Context, d *schema.ResourceData, m
 			createOpts.AvailabilityZone = aZ
 		}
 
-		if v, ok := d.GetOk("tags"); ok {
-			tags := v.(*schema.Set).List()
-			createOpts.Tags = expandToStringSlice(tags)
+		if tags := lbV2ExpandTags(d, lbClient, config.DefaultTags, true); tags != nil {
+			createOpts.Tags = *tags
 		}
 
-		log.Printf("[DEBUG][Octavia] openstack_lb_loadbalancer_v2 create options: %#v", createOpts)
-		lb, err := octavialoadbalancers.Create(lbClient, createOpts).Extract()
+		if err := lbV2CheckArgumentsAPIVersion(d, lbClient, lbV2LoadBalancerMinAPIVersions, true); err != nil {
+			return diag.Errorf("Error creating openstack_lb_loadbalancer_v2: %s", err)
+		}
+
+		extOpts := LoadBalancerCreateOpts{
+			CreateOpts:     createOpts,
+			AdditionalVips: expandLBLoadBalancerV2AdditionalVips(d.Get("additional_vips").([]interface{})),
+		}
+
+		log.Printf("[DEBUG][Octavia] openstack_lb_loadbalancer_v2 create options: %#v", extOpts)
+		lb, err := octavialoadbalancers.Create(lbClient, extOpts).Extract()
 		if err != nil {
 			return diag.Errorf("Error creating openstack_lb_loadbalancer_v2: %s", err)
 		}
 		lbID = lb.ID
 		vipPortID = lb.VipPortID
 	} else {
+		if _, ok := d.GetOk("additional_vips"); ok {
+			return diag.Errorf("Error creating openstack_lb_loadbalancer_v2: additional_vips is only supported by Octavia")
+		}
+
 		createOpts := neutronloadbalancers.CreateOpts{
 			Name:         d.Get("name").(string),
 			Description:  d.Get("description").(string),
@@ -229,7 +268,7 @@ func resourceLoadBalancerV2Read(ctx context.Context, d *schema.ResourceData, met
 	var vipPortID string
 
 	if lbClient.Type == octaviaLBClientType {
-		lb, err := octavialoadbalancers.Get(lbClient, d.Id()).Extract()
+		lb, err := lbLoadBalancerV2Get(lbClient, d.Id())
 		if err != nil {
 			return diag.FromErr(CheckDeleted(d, err, "Unable to retrieve openstack_lb_loadbalancer_v2"))
 		}
@@ -248,7 +287,10 @@ func resourceLoadBalancerV2Read(ctx context.Context, d *schema.ResourceData, met
 		d.Set("loadbalancer_provider", lb.Provider)
 		d.Set("availability_zone", lb.AvailabilityZone)
 		d.Set("region", GetRegion(d, config))
-		d.Set("tags", lb.Tags)
+		lbV2SetTags(d, lb.Tags, config.DefaultTags)
+		if err := d.Set("additional_vips", flattenLBLoadBalancerV2AdditionalVips(lb.AdditionalVips)); err != nil {
+			log.Printf("[DEBUG] Unable to set openstack_lb_loadbalancer_v2 additional_vips: %s", err)
+		}
 		vipPortID = lb.VipPortID
 	} else {
 		lb, err := neutronloadbalancers.Get(lbClient, d.Id()).Extract()
@@ -292,7 +334,7 @@ func resourceLoadBalancerV2Update(ctx context.Context, d *schema.ResourceData, m
 		return diag.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	updateOpts, err := chooseLBV2LoadbalancerUpdateOpts(d, config)
+	updateOpts, err := chooseLBV2LoadbalancerUpdateOpts(d, config, lbClient)
 	if err != nil {
 		return diag.Errorf("Error building openstack_lb_loadbalancer_v2 update options: %s", err)
 	}
@@ -325,6 +367,38 @@ func resourceLoadBalancerV2Update(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
+	// The failover is triggered by any change of failover_trigger.
+	if d.HasChange("failover_trigger") {
+		if lbClient.Type != octaviaLBClientType {
+			return diag.Errorf("Error triggering openstack_lb_loadbalancer_v2 %s failover: failover is only supported by Octavia", d.Id())
+		}
+
+		timeout := d.Timeout(schema.TimeoutUpdate)
+		err = waitForLBV2LoadBalancer(ctx, lbClient, d.Id(), "ACTIVE", getLbPendingStatuses(), timeout)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		log.Printf("[DEBUG] Triggering openstack_lb_loadbalancer_v2 %s failover", d.Id())
+		err = resource.Retry(timeout, func() *resource.RetryError {
+			err = octavialoadbalancers.Failover(lbClient, d.Id()).ExtractErr()
+			if err != nil {
+				return checkForRetryableError(err)
+			}
+			return nil
+		})
+
+		if err != nil {
+			return diag.Errorf("Error triggering openstack_lb_loadbalancer_v2 %s failover: %s", d.Id(), err)
+		}
+
+		// Wait for load-balancer to become active after the failover.
+		err = waitForLBV2LoadBalancer(ctx, lbClient, d.Id(), "ACTIVE", getLbPendingStatuses(), timeout)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	// Security Groups get updated separately.
 	if d.HasChange("security_group_ids") {
 		networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
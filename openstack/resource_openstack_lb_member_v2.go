@@ -65,10 +65,11 @@ func resourceMemberV2() *schema.Resource {
 			},
 
 			"weight": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validation.IntBetween(0, 256),
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.IntBetween(0, 256),
+				DiffSuppressFunc: lbMemberV2DrainDiffSuppressFunc("0"),
 			},
 
 			"subnet_id": {
@@ -78,8 +79,14 @@ func resourceMemberV2() *schema.Resource {
 			},
 
 			"admin_state_up": {
+				Type:             schema.TypeBool,
+				Default:          true,
+				Optional:         true,
+				DiffSuppressFunc: lbMemberV2DrainDiffSuppressFunc("false"),
+			},
+
+			"ignore_drain_changes": {
 				Type:     schema.TypeBool,
-				Default:  true,
 				Optional: true,
 			},
 
@@ -88,6 +95,13 @@ func resourceMemberV2() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
 		},
 	}
 }
@@ -100,12 +114,14 @@ func resourceMemberV2Create(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	adminStateUp := d.Get("admin_state_up").(bool)
-	createOpts := pools.CreateMemberOpts{
-		Name:         d.Get("name").(string),
-		TenantID:     d.Get("tenant_id").(string),
-		Address:      d.Get("address").(string),
-		ProtocolPort: d.Get("protocol_port").(int),
-		AdminStateUp: &adminStateUp,
+	createOpts := MemberCreateOpts{
+		CreateMemberOpts: pools.CreateMemberOpts{
+			Name:         d.Get("name").(string),
+			TenantID:     d.Get("tenant_id").(string),
+			Address:      d.Get("address").(string),
+			ProtocolPort: d.Get("protocol_port").(int),
+			AdminStateUp: &adminStateUp,
+		},
 	}
 
 	// Must omit if not set
@@ -120,6 +136,10 @@ func resourceMemberV2Create(ctx context.Context, d *schema.ResourceData, meta in
 		createOpts.Weight = &weight
 	}
 
+	if tags := lbV2ExpandTags(d, lbClient, config.DefaultTags, true); tags != nil {
+		createOpts.Tags = *tags
+	}
+
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 
 	// Get a clean copy of the parent pool.
@@ -139,7 +159,7 @@ func resourceMemberV2Create(ctx context.Context, d *schema.ResourceData, meta in
 	log.Printf("[DEBUG] Attempting to create member")
 	var member *pools.Member
 	err = resource.Retry(timeout, func() *resource.RetryError {
-		member, err = pools.CreateMember(lbClient, poolID, createOpts).Extract()
+		member, err = lbMemberV2Create(lbClient, poolID, createOpts).Extract()
 		if err != nil {
 			return checkForRetryableError(err)
 		}
@@ -170,7 +190,7 @@ func resourceMemberV2Read(ctx context.Context, d *schema.ResourceData, meta inte
 
 	poolID := d.Get("pool_id").(string)
 
-	member, err := pools.GetMember(lbClient, poolID, d.Id()).Extract()
+	member, err := lbMemberV2Get(lbClient, poolID, d.Id())
 	if err != nil {
 		return diag.FromErr(CheckDeleted(d, err, "member"))
 	}
@@ -185,6 +205,7 @@ func resourceMemberV2Read(ctx context.Context, d *schema.ResourceData, meta inte
 	d.Set("address", member.Address)
 	d.Set("protocol_port", member.ProtocolPort)
 	d.Set("region", GetRegion(d, config))
+	lbV2SetTags(d, member.Tags, config.DefaultTags)
 
 	return nil
 }
@@ -196,7 +217,7 @@ func resourceMemberV2Update(ctx context.Context, d *schema.ResourceData, meta in
 		return diag.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	var updateOpts pools.UpdateMemberOpts
+	var updateOpts MemberUpdateOpts
 	if d.HasChange("name") {
 		name := d.Get("name").(string)
 		updateOpts.Name = &name
@@ -209,6 +230,9 @@ func resourceMemberV2Update(ctx context.Context, d *schema.ResourceData, meta in
 		asu := d.Get("admin_state_up").(bool)
 		updateOpts.AdminStateUp = &asu
 	}
+	if tags := lbV2ExpandTags(d, lbClient, config.DefaultTags, false); tags != nil {
+		updateOpts.Tags = tags
+	}
 
 	// Get a clean copy of the parent pool.
 	poolID := d.Get("pool_id").(string)
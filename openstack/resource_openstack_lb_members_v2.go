@@ -46,8 +46,9 @@ func resourceMembersV2() *schema.Resource {
 			},
 
 			"member": {
-				Type:     schema.TypeSet,
-				Optional: true,
+				Type:             schema.TypeSet,
+				Optional:         true,
+				DiffSuppressFunc: lbMembersV2DrainDiffSuppressFunc,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
@@ -93,9 +94,31 @@ func resourceMembersV2() *schema.Resource {
 							Default:  true,
 							Optional: true,
 						},
+
+						"monitor_address": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"monitor_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 65535),
+						},
+
+						"tags": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
 					},
 				},
 			},
+
+			"ignore_drain_changes": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -107,7 +130,7 @@ func resourceMembersV2Create(ctx context.Context, d *schema.ResourceData, meta i
 		return diag.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	createOpts := expandLBMembersV2(d.Get("member").(*schema.Set), lbClient)
+	createOpts := expandLBMembersV2(d.Get("member").(*schema.Set), nil, lbClient)
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 
 	// Get a clean copy of the parent pool.
@@ -126,7 +149,7 @@ func resourceMembersV2Create(ctx context.Context, d *schema.ResourceData, meta i
 
 	log.Printf("[DEBUG] Attempting to create members")
 	err = resource.Retry(timeout, func() *resource.RetryError {
-		err = octaviapools.BatchUpdateMembers(lbClient, poolID, createOpts).ExtractErr()
+		err = lbMembersV2BatchUpdate(lbClient, poolID, createOpts).ExtractErr()
 		if err != nil {
 			return checkForRetryableError(err)
 		}
@@ -182,7 +205,8 @@ func resourceMembersV2Update(ctx context.Context, d *schema.ResourceData, meta i
 	}
 
 	if d.HasChange("member") {
-		updateOpts := expandLBMembersV2(d.Get("member").(*schema.Set), lbClient)
+		oldMembers, newMembers := d.GetChange("member")
+		updateOpts := expandLBMembersV2(newMembers.(*schema.Set), oldMembers.(*schema.Set), lbClient)
 
 		// Get a clean copy of the parent pool.
 		parentPool, err := neutronpools.Get(lbClient, d.Id()).Extract()
@@ -199,7 +223,7 @@ func resourceMembersV2Update(ctx context.Context, d *schema.ResourceData, meta i
 
 		log.Printf("[DEBUG] Updating %s pool members with options: %#v", d.Id(), updateOpts)
 		err = resource.Retry(timeout, func() *resource.RetryError {
-			err = octaviapools.BatchUpdateMembers(lbClient, d.Id(), updateOpts).ExtractErr()
+			err = lbMembersV2BatchUpdate(lbClient, d.Id(), updateOpts).ExtractErr()
 			if err != nil {
 				return checkForRetryableError(err)
 			}
@@ -82,6 +82,8 @@ func TestAccLBV2Members_basic(t *testing.T) {
 					testCheckResourceAttrWithIndexesAddr("openstack_lb_members_v2.members_1", "member.%d.weight", &idx2, "15"),
 					testCheckResourceAttrWithIndexesAddr("openstack_lb_members_v2.members_1", "member.%d.backup", &idx1, "true"),
 					testCheckResourceAttrWithIndexesAddr("openstack_lb_members_v2.members_1", "member.%d.backup", &idx2, "false"),
+					testCheckResourceAttrWithIndexesAddr("openstack_lb_members_v2.members_1", "member.%d.monitor_address", &idx1, "192.168.199.112"),
+					testCheckResourceAttrWithIndexesAddr("openstack_lb_members_v2.members_1", "member.%d.monitor_port", &idx1, "8081"),
 					testCheckResourceAttrSetWithIndexesAddr("openstack_lb_members_v2.members_1", "member.%d.subnet_id", &idx1),
 					testCheckResourceAttrSetWithIndexesAddr("openstack_lb_members_v2.members_1", "member.%d.subnet_id", &idx2),
 				),
@@ -279,6 +281,8 @@ resource "openstack_lb_members_v2" "members_1" {
     admin_state_up = "true"
     subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
 	backup = true
+    monitor_address = "192.168.199.112"
+    monitor_port = 8081
 }
 
   member {
@@ -12,7 +12,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
-	octaviamonitors "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/monitors"
 	neutronmonitors "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/monitors"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/pools"
 )
@@ -64,7 +63,7 @@ func resourceMonitorV2() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 				ValidateFunc: validation.StringInSlice([]string{
-					"TCP", "UDP-CONNECT", "HTTP", "HTTPS", "TLS-HELLO", "PING",
+					"TCP", "UDP-CONNECT", "HTTP", "HTTPS", "TLS-HELLO", "PING", "SCTP",
 				}, false),
 			},
 
@@ -107,11 +106,32 @@ func resourceMonitorV2() *schema.Resource {
 				Computed: true,
 			},
 
+			"http_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"1.0", "1.1",
+				}, false),
+			},
+
+			"domain_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
 			"admin_state_up": {
 				Type:     schema.TypeBool,
 				Default:  true,
 				Optional: true,
 			},
+
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
 		},
 	}
 }
@@ -123,8 +143,17 @@ func resourceMonitorV2Create(ctx context.Context, d *schema.ResourceData, meta i
 		return diag.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
+	if config.UseOctavia {
+		if err := lbV2CheckMonitorAPIVersion(d, lbClient, true); err != nil {
+			return diag.Errorf("Unable to create openstack_lb_monitor_v2: %s", err)
+		}
+	}
+
 	// Choose either the Octavia or Neutron create options.
-	createOpts := chooseLBV2MonitorCreateOpts(d, config)
+	createOpts, err := chooseLBV2MonitorCreateOpts(d, config, lbClient)
+	if err != nil {
+		return diag.Errorf("Error building openstack_lb_monitor_v2 create options: %s", err)
+	}
 
 	// Get a clean copy of the parent pool.
 	poolID := d.Get("pool_id").(string)
@@ -174,7 +203,7 @@ func resourceMonitorV2Read(ctx context.Context, d *schema.ResourceData, meta int
 
 	// Use Octavia monitor body if Octavia/LBaaS is enabled.
 	if config.UseOctavia {
-		monitor, err := octaviamonitors.Get(lbClient, d.Id()).Extract()
+		monitor, err := lbMonitorV2Get(lbClient, d.Id())
 		if err != nil {
 			return diag.FromErr(CheckDeleted(d, err, "monitor"))
 		}
@@ -190,9 +219,12 @@ func resourceMonitorV2Read(ctx context.Context, d *schema.ResourceData, meta int
 		d.Set("url_path", monitor.URLPath)
 		d.Set("http_method", monitor.HTTPMethod)
 		d.Set("expected_codes", monitor.ExpectedCodes)
+		d.Set("http_version", flattenLBMonitorV2HTTPVersion(monitor.HTTPVersion))
+		d.Set("domain_name", monitor.DomainName)
 		d.Set("admin_state_up", monitor.AdminStateUp)
 		d.Set("name", monitor.Name)
 		d.Set("region", GetRegion(d, config))
+		lbV2SetTags(d, monitor.Tags, config.DefaultTags)
 
 		// OpenContrail workaround (https://github.com/terraform-provider-openstack/terraform-provider-openstack/issues/762)
 		if len(monitor.Pools) > 0 && monitor.Pools[0].ID != "" {
@@ -237,7 +269,16 @@ func resourceMonitorV2Update(ctx context.Context, d *schema.ResourceData, meta i
 		return diag.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	updateOpts := chooseLBV2MonitorUpdateOpts(d, config)
+	if config.UseOctavia {
+		if err := lbV2CheckMonitorAPIVersion(d, lbClient, false); err != nil {
+			return diag.Errorf("Unable to update openstack_lb_monitor_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	updateOpts, err := chooseLBV2MonitorUpdateOpts(d, config, lbClient)
+	if err != nil {
+		return diag.Errorf("Error building openstack_lb_monitor_v2 update options: %s", err)
+	}
 	if updateOpts == nil {
 		log.Printf("[DEBUG] openstack_lb_monitor_v2 %s: nothing to update", d.Id())
 		return resourceMonitorV2Read(ctx, d, meta)
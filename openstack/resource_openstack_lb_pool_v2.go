@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -90,15 +91,12 @@ func resourcePoolV2() *schema.Resource {
 			"persistence": {
 				Type:     schema.TypeList,
 				Optional: true,
-				ForceNew: true,
-				Computed: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"type": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 							ValidateFunc: validation.StringInSlice([]string{
 								"SOURCE_IP", "HTTP_COOKIE", "APP_COOKIE",
 							}, false),
@@ -107,7 +105,6 @@ func resourcePoolV2() *schema.Resource {
 						"cookie_name": {
 							Type:     schema.TypeString,
 							Optional: true,
-							ForceNew: true,
 						},
 					},
 				},
@@ -118,7 +115,59 @@ func resourcePoolV2() *schema.Resource {
 				Default:  true,
 				Optional: true,
 			},
+
+			"tls_enabled": {
+				Type:     schema.TypeBool,
+				Default:  false,
+				Optional: true,
+			},
+
+			"tls_container_ref": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"ca_tls_container_ref": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"crl_container_ref": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tls_ciphers": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"tls_versions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"SSLv3", "TLSv1", "TLSv1.1", "TLSv1.2", "TLSv1.3",
+					}, false),
+				},
+			},
+
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
 		},
+
+		CustomizeDiff: customdiff.Sequence(
+			func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return lbPoolV2TLSCustomizeDiff(diff)
+			},
+		),
 	}
 }
 
@@ -132,26 +181,9 @@ func resourcePoolV2Create(ctx context.Context, d *schema.ResourceData, meta inte
 	adminStateUp := d.Get("admin_state_up").(bool)
 	lbID := d.Get("loadbalancer_id").(string)
 	listenerID := d.Get("listener_id").(string)
-	var persistence pools.SessionPersistence
-	if p, ok := d.GetOk("persistence"); ok {
-		pV := (p.([]interface{}))[0].(map[string]interface{})
-
-		persistence = pools.SessionPersistence{
-			Type: pV["type"].(string),
-		}
-
-		if persistence.Type == "APP_COOKIE" {
-			if pV["cookie_name"].(string) == "" {
-				return diag.Errorf(
-					"Persistence cookie_name needs to be set if using 'APP_COOKIE' persistence type")
-			}
-			persistence.CookieName = pV["cookie_name"].(string)
-		} else {
-			if pV["cookie_name"].(string) != "" {
-				return diag.Errorf(
-					"Persistence cookie_name can only be set if using 'APP_COOKIE' persistence type")
-			}
-		}
+	persistence, err := expandLBPoolPersistenceV2(d.Get("persistence").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	createOpts := pools.CreateOpts{
@@ -170,7 +202,36 @@ func resourcePoolV2Create(ctx context.Context, d *schema.ResourceData, meta inte
 		createOpts.Persistence = &persistence
 	}
 
-	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	var createOptsBuilder pools.CreateOptsBuilder = createOpts
+	if config.UseOctavia {
+		if err := lbV2CheckArgumentsAPIVersion(d, lbClient, lbV2PoolMinAPIVersions, true); err != nil {
+			return diag.Errorf("Error creating pool: %s", err)
+		}
+
+		extOpts := PoolCreateOpts{
+			CreateOpts:        createOpts,
+			TLSContainerRef:   d.Get("tls_container_ref").(string),
+			CATLSContainerRef: d.Get("ca_tls_container_ref").(string),
+			CRLContainerRef:   d.Get("crl_container_ref").(string),
+			TLSCiphers:        d.Get("tls_ciphers").(string),
+		}
+
+		if tlsEnabled := d.Get("tls_enabled").(bool); tlsEnabled {
+			extOpts.TLSEnabled = &tlsEnabled
+		}
+
+		if raw, ok := d.GetOk("tls_versions"); ok {
+			extOpts.TLSVersions = expandToStringSlice(raw.(*schema.Set).List())
+		}
+
+		if tags := lbV2ExpandTags(d, lbClient, config.DefaultTags, true); tags != nil {
+			extOpts.Tags = *tags
+		}
+
+		createOptsBuilder = extOpts
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOptsBuilder)
 
 	timeout := d.Timeout(schema.TimeoutCreate)
 
@@ -197,7 +258,7 @@ func resourcePoolV2Create(ctx context.Context, d *schema.ResourceData, meta inte
 	log.Printf("[DEBUG] Attempting to create pool")
 	var pool *pools.Pool
 	err = resource.Retry(timeout, func() *resource.RetryError {
-		pool, err = pools.Create(lbClient, createOpts).Extract()
+		pool, err = pools.Create(lbClient, createOptsBuilder).Extract()
 		if err != nil {
 			return checkForRetryableError(err)
 		}
@@ -227,7 +288,7 @@ func resourcePoolV2Read(ctx context.Context, d *schema.ResourceData, meta interf
 		return diag.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	pool, err := pools.Get(lbClient, d.Id()).Extract()
+	pool, err := lbPoolV2Get(lbClient, d.Id())
 	if err != nil {
 		return diag.FromErr(CheckDeleted(d, err, "pool"))
 	}
@@ -241,7 +302,14 @@ func resourcePoolV2Read(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set("admin_state_up", pool.AdminStateUp)
 	d.Set("name", pool.Name)
 	d.Set("persistence", flattenLBPoolPersistenceV2(pool.Persistence))
+	d.Set("tls_enabled", pool.TLSEnabled)
+	d.Set("tls_container_ref", pool.TLSContainerRef)
+	d.Set("ca_tls_container_ref", pool.CATLSContainerRef)
+	d.Set("crl_container_ref", pool.CRLContainerRef)
+	d.Set("tls_ciphers", pool.TLSCiphers)
+	d.Set("tls_versions", pool.TLSVersions)
 	d.Set("region", GetRegion(d, config))
+	lbV2SetTags(d, pool.Tags, config.DefaultTags)
 
 	return nil
 }
@@ -270,6 +338,57 @@ func resourcePoolV2Update(ctx context.Context, d *schema.ResourceData, meta inte
 		updateOpts.AdminStateUp = &asu
 	}
 
+	var updateOptsBuilder pools.UpdateOptsBuilder = updateOpts
+	extOpts := PoolUpdateOpts{
+		UpdateOpts: updateOpts,
+	}
+
+	// An empty persistence removes the session persistence from the pool.
+	if d.HasChange("persistence") {
+		persistence, err := expandLBPoolPersistenceV2(d.Get("persistence").([]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		extOpts.Persistence = &persistence
+		updateOptsBuilder = extOpts
+	}
+
+	if config.UseOctavia {
+		if err := lbV2CheckArgumentsAPIVersion(d, lbClient, lbV2PoolMinAPIVersions, false); err != nil {
+			return diag.Errorf("Unable to update pool %s: %s", d.Id(), err)
+		}
+
+		if d.HasChange("tls_enabled") {
+			tlsEnabled := d.Get("tls_enabled").(bool)
+			extOpts.TLSEnabled = &tlsEnabled
+		}
+		if d.HasChange("tls_container_ref") {
+			tlsContainerRef := d.Get("tls_container_ref").(string)
+			extOpts.TLSContainerRef = &tlsContainerRef
+		}
+		if d.HasChange("ca_tls_container_ref") {
+			caTLSContainerRef := d.Get("ca_tls_container_ref").(string)
+			extOpts.CATLSContainerRef = &caTLSContainerRef
+		}
+		if d.HasChange("crl_container_ref") {
+			crlContainerRef := d.Get("crl_container_ref").(string)
+			extOpts.CRLContainerRef = &crlContainerRef
+		}
+		if d.HasChange("tls_ciphers") {
+			tlsCiphers := d.Get("tls_ciphers").(string)
+			extOpts.TLSCiphers = &tlsCiphers
+		}
+		if d.HasChange("tls_versions") {
+			tlsVersions := expandToStringSlice(d.Get("tls_versions").(*schema.Set).List())
+			extOpts.TLSVersions = &tlsVersions
+		}
+		if tags := lbV2ExpandTags(d, lbClient, config.DefaultTags, false); tags != nil {
+			extOpts.Tags = tags
+		}
+
+		updateOptsBuilder = extOpts
+	}
+
 	timeout := d.Timeout(schema.TimeoutUpdate)
 
 	// Get a clean copy of the pool.
@@ -284,9 +403,9 @@ func resourcePoolV2Update(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.FromErr(err)
 	}
 
-	log.Printf("[DEBUG] Updating pool %s with options: %#v", d.Id(), updateOpts)
+	log.Printf("[DEBUG] Updating pool %s with options: %#v", d.Id(), updateOptsBuilder)
 	err = resource.Retry(timeout, func() *resource.RetryError {
-		_, err = pools.Update(lbClient, d.Id(), updateOpts).Extract()
+		_, err = pools.Update(lbClient, d.Id(), updateOptsBuilder).Extract()
 		if err != nil {
 			return checkForRetryableError(err)
 		}
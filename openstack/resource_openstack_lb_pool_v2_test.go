@@ -2,6 +2,7 @@ package openstack
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -38,6 +39,106 @@ func TestAccLBV2Pool_basic(t *testing.T) {
 	})
 }
 
+func TestAccLBV2Pool_persistence(t *testing.T) {
+	var pool pools.Pool
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLB(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBV2PoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLbV2PoolConfigPersistence(`
+  persistence {
+    type = "SOURCE_IP"
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2PoolExists("openstack_lb_pool_v2.pool_1", &pool),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_pool_v2.pool_1", "persistence.0.type", "SOURCE_IP"),
+				),
+			},
+			{
+				Config: testAccLbV2PoolConfigPersistence(`
+  persistence {
+    type        = "APP_COOKIE"
+    cookie_name = "session_1"
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(
+						"openstack_lb_pool_v2.pool_1", "id", &pool.ID),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_pool_v2.pool_1", "persistence.0.type", "APP_COOKIE"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_pool_v2.pool_1", "persistence.0.cookie_name", "session_1"),
+				),
+			},
+			{
+				Config: testAccLbV2PoolConfigPersistence(`
+  persistence {
+    type        = "APP_COOKIE"
+    cookie_name = "session_2"
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(
+						"openstack_lb_pool_v2.pool_1", "id", &pool.ID),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_pool_v2.pool_1", "persistence.0.cookie_name", "session_2"),
+				),
+			},
+			{
+				Config: testAccLbV2PoolConfigPersistence(""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(
+						"openstack_lb_pool_v2.pool_1", "id", &pool.ID),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_pool_v2.pool_1", "persistence.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLBV2Pool_octavia_tags(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLB(t)
+			testAccPreCheckUseOctavia(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBV2PoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLbV2PoolConfigOctaviaTags(`["tag1"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_lb_pool_v2.pool_1", "tags.#", "1"),
+				),
+			},
+			{
+				Config: testAccLbV2PoolConfigOctaviaTags(`["tag1", "tag2"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_lb_pool_v2.pool_1", "tags.#", "2"),
+				),
+			},
+			{
+				Config: testAccLbV2PoolConfigOctaviaTags(`[]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_lb_pool_v2.pool_1", "tags.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLBV2Pool_octavia_udp(t *testing.T) {
 	var pool pools.Pool
 
@@ -62,6 +163,58 @@ func TestAccLBV2Pool_octavia_udp(t *testing.T) {
 	})
 }
 
+func TestAccLBV2Pool_octavia_tls(t *testing.T) {
+	var pool pools.Pool
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLB(t)
+			testAccPreCheckUseOctavia(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBV2PoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLbV2PoolConfigOctaviaTLS(true, `["TLSv1.2", "TLSv1.3"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2PoolExists("openstack_lb_pool_v2.pool_1", &pool),
+					resource.TestCheckResourceAttr("openstack_lb_pool_v2.pool_1", "tls_enabled", "true"),
+					resource.TestCheckResourceAttr("openstack_lb_pool_v2.pool_1", "tls_versions.#", "2"),
+				),
+			},
+			{
+				Config: testAccLbV2PoolConfigOctaviaTLS(false, `["TLSv1.3"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2PoolExists("openstack_lb_pool_v2.pool_1", &pool),
+					resource.TestCheckResourceAttr("openstack_lb_pool_v2.pool_1", "tls_enabled", "false"),
+					resource.TestCheckResourceAttr("openstack_lb_pool_v2.pool_1", "tls_versions.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLBV2Pool_octavia_tls_proxy(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLB(t)
+			testAccPreCheckUseOctavia(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBV2PoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      TestAccLbV2PoolConfigOctaviaTLSProxy,
+				ExpectError: regexp.MustCompile("tls_enabled can not be set to true for a PROXY pool"),
+			},
+		},
+	})
+}
+
 func testAccCheckLBV2PoolDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	lbClient, err := chooseLBV2AccTestClient(config, osRegionName)
@@ -243,3 +396,126 @@ resource "openstack_lb_pool_v2" "pool_1" {
   }
 }
 `
+
+func testAccLbV2PoolConfigOctaviaTLS(tlsEnabled bool, tlsVersions string) string {
+	return fmt.Sprintf(`
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+
+  timeouts {
+    create = "15m"
+    update = "15m"
+    delete = "15m"
+  }
+}
+
+resource "openstack_lb_pool_v2" "pool_1" {
+  name = "pool_1"
+  protocol = "HTTP"
+  lb_method = "ROUND_ROBIN"
+  loadbalancer_id = "${openstack_lb_loadbalancer_v2.loadbalancer_1.id}"
+
+  tls_enabled  = %t
+  tls_versions = %s
+
+  timeouts {
+    create = "5m"
+    update = "5m"
+    delete = "5m"
+  }
+}
+`, tlsEnabled, tlsVersions)
+}
+
+const TestAccLbV2PoolConfigOctaviaTLSProxy = `
+resource "openstack_lb_pool_v2" "pool_1" {
+  name = "pool_1"
+  protocol = "PROXY"
+  lb_method = "ROUND_ROBIN"
+  loadbalancer_id = "d9415786-5f1a-428b-b35f-2f1523e146d2"
+  tls_enabled = true
+}
+`
+
+func testAccLbV2PoolConfigOctaviaTags(tags string) string {
+	return fmt.Sprintf(`
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+
+  timeouts {
+    create = "15m"
+    update = "15m"
+    delete = "15m"
+  }
+}
+
+resource "openstack_lb_pool_v2" "pool_1" {
+  name = "pool_1"
+  protocol = "HTTP"
+  lb_method = "ROUND_ROBIN"
+  loadbalancer_id = "${openstack_lb_loadbalancer_v2.loadbalancer_1.id}"
+  tags = %s
+}
+`, tags)
+}
+
+func testAccLbV2PoolConfigPersistence(persistence string) string {
+	return fmt.Sprintf(`
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+
+  timeouts {
+    create = "15m"
+    update = "15m"
+    delete = "15m"
+  }
+}
+
+resource "openstack_lb_pool_v2" "pool_1" {
+  name = "pool_1"
+  protocol = "HTTP"
+  lb_method = "ROUND_ROBIN"
+  loadbalancer_id = "${openstack_lb_loadbalancer_v2.loadbalancer_1.id}"
+%s
+}
+`, persistence)
+}
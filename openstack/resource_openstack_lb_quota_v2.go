@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/quotas"
 )
@@ -20,7 +21,7 @@ func resourceLoadBalancerQuotaV2() *schema.Resource {
 		UpdateContext: resourceLoadBalancerQuotaV2Update,
 		DeleteContext: resourceLoadBalancerQuotaV2Delete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceLoadBalancerQuotaV2Import,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -44,45 +45,52 @@ func resourceLoadBalancerQuotaV2() *schema.Resource {
 			},
 
 			"loadbalancer": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
 			},
 
 			"listener": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
 			},
 
 			"member": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
 			},
 
 			"pool": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
 			},
 
 			"health_monitor": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
 			},
 
 			"l7_policy": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
 			},
 
 			"l7_rule": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
 			},
 		},
 	}
@@ -245,7 +253,33 @@ func resourceLoadBalancerQuotaV2Update(ctx context.Context, d *schema.ResourceDa
 	return resourceLoadBalancerQuotaV2Read(ctx, d, meta)
 }
 
-func resourceLoadBalancerQuotaV2Delete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
-	d.SetId("")
+func resourceLoadBalancerQuotaV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	if lbClient.Type != octaviaLBClientType {
+		return diag.Errorf("Error deleting openstack_lb_quota_v2: Only available when using octavia")
+	}
+
+	// Deleting the quota resets it to the default values.
+	projectID := d.Get("project_id").(string)
+	if err := lbQuotaV2Delete(lbClient, projectID).ExtractErr(); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_lb_quota_v2"))
+	}
+
 	return nil
 }
+
+func resourceLoadBalancerQuotaV2Import(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+
+	// Allow to import the quota using the project ID only.
+	if !strings.Contains(d.Id(), "/") {
+		d.SetId(fmt.Sprintf("%s/%s", d.Id(), GetRegion(d, config)))
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -64,6 +64,12 @@ func resourceNetworkingFloatingIPAssociateV2Create(ctx context.Context, d *schem
 		return diag.Errorf("Unable to get ID of openstack_networking_floatingip_associate_v2 floating_ip %s: %s", floatingIP, err)
 	}
 
+	if fixedIP != "" {
+		if err := networkingFloatingIPV2ValidateFixedIP(networkingClient, portID, fixedIP); err != nil {
+			return diag.Errorf("Error associating openstack_networking_floatingip_associate_v2 floating_ip %s with port %s: %s", fipID, portID, err)
+		}
+	}
+
 	updateOpts := floatingips.UpdateOpts{
 		PortID:  &portID,
 		FixedIP: fixedIP,
@@ -115,8 +121,13 @@ func resourceNetworkingFloatingIPAssociateV2Update(ctx context.Context, d *schem
 	portID := d.Get("port_id").(string)
 	updateOpts.PortID = &portID
 
-	if d.HasChange("fixed_ip") {
-		updateOpts.FixedIP = d.Get("fixed_ip").(string)
+	// A configured fixed_ip is always sent, because Neutron picks an arbitrary
+	// fixed IP of a new port otherwise.
+	if fixedIP := d.Get("fixed_ip").(string); fixedIP != "" && networkingFloatingIPV2FixedIPConfigured(d) {
+		if err := networkingFloatingIPV2ValidateFixedIP(networkingClient, portID, fixedIP); err != nil {
+			return diag.Errorf("Error updating openstack_networking_floatingip_associate_v2 %s: %s", d.Id(), err)
+		}
+		updateOpts.FixedIP = fixedIP
 	}
 
 	log.Printf("[DEBUG] openstack_networking_floatingip_associate_v2 %s update options: %#v", d.Id(), updateOpts)
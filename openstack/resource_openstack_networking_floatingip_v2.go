@@ -2,7 +2,9 @@ package openstack
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"net"
 	"regexp"
 	"time"
 
@@ -23,11 +25,12 @@ func resourceNetworkingFloatingIPV2() *schema.Resource {
 		UpdateContext: resourceNetworkFloatingIPV2Update,
 		DeleteContext: resourceNetworkFloatingIPV2Delete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceNetworkFloatingIPV2Import,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -155,13 +158,21 @@ func resourceNetworkFloatingIPV2Create(ctx context.Context, d *schema.ResourceDa
 		subnetID = subnetIDs[0]
 	}
 
+	portID := d.Get("port_id").(string)
+	fixedIP := d.Get("fixed_ip").(string)
+	if portID != "" && fixedIP != "" {
+		if err := networkingFloatingIPV2ValidateFixedIP(networkingClient, portID, fixedIP); err != nil {
+			return diag.Errorf("Error creating openstack_networking_floatingip_v2: %s", err)
+		}
+	}
+
 	createOpts := &floatingips.CreateOpts{
 		FloatingNetworkID: poolID,
 		Description:       d.Get("description").(string),
 		FloatingIP:        d.Get("address").(string),
-		PortID:            d.Get("port_id").(string),
+		PortID:            portID,
 		TenantID:          d.Get("tenant_id").(string),
-		FixedIP:           d.Get("fixed_ip").(string),
+		FixedIP:           fixedIP,
 		SubnetID:          subnetID,
 	}
 
@@ -235,7 +246,7 @@ func resourceNetworkFloatingIPV2Create(ctx context.Context, d *schema.ResourceDa
 		d.Set("subnet_id", createOpts.SubnetID)
 	}
 
-	tags := networkingV2AttributesTags(d)
+	tags := networkingV2CreateAttributesTags(d, config.DefaultTags)
 	if len(tags) > 0 {
 		tagOpts := attributestags.ReplaceAllOpts{Tags: tags}
 		tags, err := attributestags.ReplaceAll(networkingClient, "floatingips", fip.ID, tagOpts).Extract()
@@ -306,12 +317,15 @@ func resourceNetworkFloatingIPV2Update(ctx context.Context, d *schema.ResourceDa
 		hasChange = true
 		portID := d.Get("port_id").(string)
 		updateOpts.PortID = &portID
-	}
 
-	if d.HasChange("fixed_ip") {
-		hasChange = true
-		fixedIP := d.Get("fixed_ip").(string)
-		updateOpts.FixedIP = fixedIP
+		// A configured fixed_ip is always sent, because Neutron picks an
+		// arbitrary fixed IP of a new port otherwise.
+		if fixedIP := d.Get("fixed_ip").(string); portID != "" && fixedIP != "" && networkingFloatingIPV2FixedIPConfigured(d) {
+			if err := networkingFloatingIPV2ValidateFixedIP(networkingClient, portID, fixedIP); err != nil {
+				return diag.Errorf("Error updating openstack_networking_floatingip_v2 %s: %s", d.Id(), err)
+			}
+			updateOpts.FixedIP = fixedIP
+		}
 	}
 
 	if hasChange {
@@ -320,10 +334,25 @@ func resourceNetworkFloatingIPV2Update(ctx context.Context, d *schema.ResourceDa
 		if err != nil {
 			return diag.Errorf("Error updating openstack_networking_floatingip_v2 %s: %s", d.Id(), err)
 		}
+
+		log.Printf("[DEBUG] Waiting for openstack_networking_floatingip_v2 %s to become available.", d.Id())
+
+		stateConf := &resource.StateChangeConf{
+			Target:     []string{"ACTIVE", "DOWN"},
+			Refresh:    networkingFloatingIPV2StateRefreshFunc(networkingClient, d.Id()),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			Delay:      5 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		_, err = stateConf.WaitForStateContext(ctx)
+		if err != nil {
+			return diag.Errorf("Error waiting for openstack_networking_floatingip_v2 %s to become available: %s", d.Id(), err)
+		}
 	}
 
 	if d.HasChange("tags") {
-		tags := networkingV2UpdateAttributesTags(d)
+		tags := networkingV2UpdateAttributesTags(d, config.DefaultTags)
 		tagOpts := attributestags.ReplaceAllOpts{Tags: tags}
 		tags, err := attributestags.ReplaceAll(networkingClient, "floatingips", d.Id(), tagOpts).Extract()
 		if err != nil {
@@ -363,3 +392,26 @@ func resourceNetworkFloatingIPV2Delete(ctx context.Context, d *schema.ResourceDa
 	d.SetId("")
 	return nil
 }
+
+func resourceNetworkFloatingIPV2Import(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if net.ParseIP(d.Id()) == nil {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return nil, fmt.Errorf("Error creating OpenStack network client: %s", err)
+	}
+
+	fipID, err := networkingFloatingIPV2ID(networkingClient, d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("Unable to import openstack_networking_floatingip_v2 %s: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Resolved openstack_networking_floatingip_v2 %s to %s", d.Id(), fipID)
+
+	d.SetId(fipID)
+
+	return []*schema.ResourceData{d}, nil
+}
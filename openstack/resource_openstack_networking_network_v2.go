@@ -290,7 +290,7 @@ func resourceNetworkingNetworkV2Create(ctx context.Context, d *schema.ResourceDa
 
 	d.SetId(n.ID)
 
-	tags := networkingV2AttributesTags(d)
+	tags := networkingV2CreateAttributesTags(d, config.DefaultTags)
 	if len(tags) > 0 {
 		tagOpts := attributestags.ReplaceAllOpts{Tags: tags}
 		tags, err := attributestags.ReplaceAll(networkingClient, "networks", n.ID, tagOpts).Extract()
@@ -375,7 +375,7 @@ func resourceNetworkingNetworkV2Update(ctx context.Context, d *schema.ResourceDa
 
 	// Change tags if needed.
 	if d.HasChange("tags") {
-		tags := networkingV2UpdateAttributesTags(d)
+		tags := networkingV2UpdateAttributesTags(d, config.DefaultTags)
 		tagOpts := attributestags.ReplaceAllOpts{Tags: tags}
 		tags, err := attributestags.ReplaceAll(networkingClient, "networks", d.Id(), tagOpts).Extract()
 		if err != nil {
@@ -413,7 +413,7 @@ func resourceNetworkingPortV2Create(ctx context.Context, d *schema.ResourceData,
 
 	d.SetId(port.ID)
 
-	tags := networkingV2AttributesTags(d)
+	tags := networkingV2CreateAttributesTags(d, config.DefaultTags)
 	if len(tags) > 0 {
 		tagOpts := attributestags.ReplaceAllOpts{Tags: tags}
 		tags, err := attributestags.ReplaceAll(networkingClient, "ports", port.ID, tagOpts).Extract()
@@ -658,7 +658,7 @@ func resourceNetworkingPortV2Update(ctx context.Context, d *schema.ResourceData,
 
 	// Next, perform any required updates to the tags.
 	if d.HasChange("tags") {
-		tags := networkingV2UpdateAttributesTags(d)
+		tags := networkingV2UpdateAttributesTags(d, config.DefaultTags)
 		tagOpts := attributestags.ReplaceAllOpts{Tags: tags}
 		tags, err := attributestags.ReplaceAll(networkingClient, "ports", d.Id(), tagOpts).Extract()
 		if err != nil {
@@ -147,7 +147,7 @@ func resourceNetworkingQoSPolicyV2Create(ctx context.Context, d *schema.Resource
 
 	d.SetId(p.ID)
 
-	tags := networkingV2AttributesTags(d)
+	tags := networkingV2CreateAttributesTags(d, config.DefaultTags)
 	if len(tags) > 0 {
 		tagOpts := attributestags.ReplaceAllOpts{Tags: tags}
 		tags, err := attributestags.ReplaceAll(networkingClient, "qos/policies", p.ID, tagOpts).Extract()
@@ -238,7 +238,7 @@ func resourceNetworkingQoSPolicyV2Update(ctx context.Context, d *schema.Resource
 	}
 
 	if d.HasChange("tags") {
-		tags := networkingV2UpdateAttributesTags(d)
+		tags := networkingV2UpdateAttributesTags(d, config.DefaultTags)
 		tagOpts := attributestags.ReplaceAllOpts{Tags: tags}
 		tags, err := attributestags.ReplaceAll(networkingClient, "qos/policies", d.Id(), tagOpts).Extract()
 		if err != nil {
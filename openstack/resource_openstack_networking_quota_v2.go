@@ -96,6 +96,12 @@ func resourceNetworkingQuotaV2() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+
+			"trunk": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -141,7 +147,7 @@ func resourceNetworkingQuotaV2Create(ctx context.Context, d *schema.ResourceData
 		psecurityGroup := securityGroup.(int)
 		updateOpts.SecurityGroup = &psecurityGroup
 	}
-	securityGroupRule := d.Get("security_group_rule")
+	securityGroupRule, ok := d.GetOk("security_group_rule")
 	if ok {
 		psecurityGroupRule := securityGroupRule.(int)
 		updateOpts.SecurityGroupRule = &psecurityGroupRule
@@ -156,6 +162,11 @@ func resourceNetworkingQuotaV2Create(ctx context.Context, d *schema.ResourceData
 		psubnetPool := subnetPool.(int)
 		updateOpts.SubnetPool = &psubnetPool
 	}
+	trunk, ok := d.GetOk("trunk")
+	if ok {
+		ptrunk := trunk.(int)
+		updateOpts.Trunk = &ptrunk
+	}
 
 	q, err := quotas.Update(networkingClient, projectID, updateOpts).Extract()
 	if err != nil {
@@ -201,6 +212,7 @@ func resourceNetworkingQuotaV2Read(ctx context.Context, d *schema.ResourceData,
 	d.Set("security_group_rule", q.SecurityGroupRule)
 	d.Set("subnet", q.Subnet)
 	d.Set("subnetpool", q.SubnetPool)
+	d.Set("trunk", q.Trunk)
 
 	return nil
 }
@@ -271,6 +283,12 @@ func resourceNetworkingQuotaV2Update(ctx context.Context, d *schema.ResourceData
 		updateOpts.SubnetPool = &subnetPool
 	}
 
+	if d.HasChange("trunk") {
+		hasChange = true
+		trunk := d.Get("trunk").(int)
+		updateOpts.Trunk = &trunk
+	}
+
 	if hasChange {
 		log.Printf("[DEBUG] openstack_networking_quota_v2 %s update options: %#v", d.Id(), updateOpts)
 		projectID := d.Get("project_id").(string)
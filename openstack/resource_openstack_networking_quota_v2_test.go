@@ -41,6 +41,8 @@ func TestAccNetworkingQuotaV2_basic(t *testing.T) {
 						"openstack_networking_quota_v2.quota_1", "subnet", "1"),
 					resource.TestCheckResourceAttr(
 						"openstack_networking_quota_v2.quota_1", "subnetpool", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_quota_v2.quota_1", "trunk", "1"),
 				),
 			},
 			{
@@ -65,6 +67,8 @@ func TestAccNetworkingQuotaV2_basic(t *testing.T) {
 						"openstack_networking_quota_v2.quota_1", "subnet", "1"),
 					resource.TestCheckResourceAttr(
 						"openstack_networking_quota_v2.quota_1", "subnetpool", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_quota_v2.quota_1", "trunk", "1"),
 				),
 			},
 			{
@@ -89,6 +93,8 @@ func TestAccNetworkingQuotaV2_basic(t *testing.T) {
 						"openstack_networking_quota_v2.quota_1", "subnet", "3"),
 					resource.TestCheckResourceAttr(
 						"openstack_networking_quota_v2.quota_1", "subnetpool", "3"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_quota_v2.quota_1", "trunk", "3"),
 				),
 			},
 		},
@@ -111,6 +117,7 @@ resource "openstack_networking_quota_v2" "quota_1" {
   security_group_rule = 2
   subnet              = 1
   subnetpool          = 1
+  trunk               = 1
 }
 `
 
@@ -130,6 +137,7 @@ resource "openstack_networking_quota_v2" "quota_1" {
   security_group_rule = 2
   subnet              = 1
   subnetpool          = 1
+  trunk               = 1
 }
 `
 
@@ -149,5 +157,6 @@ resource "openstack_networking_quota_v2" "quota_1" {
   security_group_rule = 3
   subnet              = 3
   subnetpool          = 3
+  trunk               = 3
 }
 `
@@ -3,6 +3,7 @@ package openstack
 import (
 	"context"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -37,6 +38,7 @@ func resourceNetworkingRouterV2() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -317,9 +319,14 @@ func resourceNetworkingRouterV2Create(ctx context.Context, d *schema.ResourceDat
 		if err != nil {
 			return diag.Errorf("Error updating openstack_networking_router_v2: %s", err)
 		}
+
+		_, err = stateConf.WaitForStateContext(ctx)
+		if err != nil {
+			return diag.Errorf("Error waiting for openstack_networking_router_v2 %s to become available: %s", r.ID, err)
+		}
 	}
 
-	tags := networkingV2AttributesTags(d)
+	tags := networkingV2CreateAttributesTags(d, config.DefaultTags)
 	if len(tags) > 0 {
 		tagOpts := attributestags.ReplaceAllOpts{Tags: tags}
 		tags, err := attributestags.ReplaceAll(networkingClient, "routers", r.ID, tagOpts).Extract()
@@ -456,20 +463,75 @@ func resourceNetworkingRouterV2Update(ctx context.Context, d *schema.ResourceDat
 
 	if updateGatewaySettings {
 		hasChange = true
+
+		// Some Neutron versions reset enable_snat to true, if it's omitted
+		// from a gateway update, so always send it when it's configured.
+		if externalNetworkID != "" && networkingRouterV2EnableSNATConfigured(d) {
+			enableSNAT := d.Get("enable_snat").(bool)
+			gatewayInfo.EnableSNAT = &enableSNAT
+		}
+
 		updateOpts.GatewayInfo = &gatewayInfo
 	}
 
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"BUILD", "PENDING_CREATE", "PENDING_UPDATE"},
+		Target:     []string{"ACTIVE"},
+		Refresh:    resourceNetworkingRouterV2StateRefreshFunc(networkingClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	// Neutron rejects changing the external network in a single update, so
+	// clear the gateway first and then set the new one.
+	oldExternalNetworkID, _ := d.GetChange("external_network_id")
+	if updateGatewaySettings && oldExternalNetworkID.(string) != "" && externalNetworkID != "" && oldExternalNetworkID.(string) != externalNetworkID {
+		fips, err := networkingRouterV2GatewayFloatingIPs(networkingClient, d.Id())
+		if err != nil {
+			return diag.Errorf("Error retrieving floating IPs of openstack_networking_router_v2 %s: %s", d.Id(), err)
+		}
+		if len(fips) > 0 {
+			return diag.Errorf("Unable to change the external network of openstack_networking_router_v2 %s, "+
+				"because it's used by the floating IPs: %s", d.Id(), strings.Join(fips, ", "))
+		}
+
+		clearOpts := routers.UpdateOpts{GatewayInfo: &routers.GatewayInfo{}}
+		log.Printf("[DEBUG] Clearing external gateway of openstack_networking_router_v2 %s", d.Id())
+		_, err = routers.Update(networkingClient, d.Id(), clearOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error clearing external gateway of openstack_networking_router_v2 %s: %s", d.Id(), err)
+		}
+
+		_, err = stateConf.WaitForStateContext(ctx)
+		if err != nil {
+			return diag.Errorf("Error waiting for openstack_networking_router_v2 %s to become available: %s", d.Id(), err)
+		}
+	}
+
 	if hasChange {
 		log.Printf("[DEBUG] openstack_networking_router_v2 %s update options: %#v", d.Id(), updateOpts)
-		_, err = routers.Update(networkingClient, d.Id(), updateOpts).Extract()
+		r, err := routers.Update(networkingClient, d.Id(), updateOpts).Extract()
 		if err != nil {
 			return diag.Errorf("Error updating openstack_networking_router_v2: %s", err)
 		}
+
+		if es := gatewayInfo.EnableSNAT; es != nil && r.GatewayInfo.EnableSNAT != nil && *es != *r.GatewayInfo.EnableSNAT {
+			return diag.Errorf("Error updating openstack_networking_router_v2 %s: enable_snat was set to %t instead of %t",
+				d.Id(), *r.GatewayInfo.EnableSNAT, *es)
+		}
+
+		log.Printf("[DEBUG] Waiting for openstack_networking_router_v2 %s to become available.", d.Id())
+
+		_, err = stateConf.WaitForStateContext(ctx)
+		if err != nil {
+			return diag.Errorf("Error waiting for openstack_networking_router_v2 %s to become available: %s", d.Id(), err)
+		}
 	}
 
 	// Next, perform any required updates to the tags.
 	if d.HasChange("tags") {
-		tags := networkingV2UpdateAttributesTags(d)
+		tags := networkingV2UpdateAttributesTags(d, config.DefaultTags)
 		tagOpts := attributestags.ReplaceAllOpts{Tags: tags}
 		tags, err := attributestags.ReplaceAll(networkingClient, "routers", d.Id(), tagOpts).Extract()
 		if err != nil {
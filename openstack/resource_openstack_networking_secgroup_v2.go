@@ -25,6 +25,7 @@ func resourceNetworkingSecGroupV2() *schema.Resource {
 		},
 
 		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -60,6 +61,55 @@ func resourceNetworkingSecGroupV2() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"replace_default_rules": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"direction": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"ethertype": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"port_range_min": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+
+						"port_range_max": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+
+						"protocol": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"remote_group_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"remote_ip_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
 			"tags": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -88,31 +138,47 @@ func resourceNetworkingSecGroupV2Create(ctx context.Context, d *schema.ResourceD
 		TenantID:    d.Get("tenant_id").(string),
 	}
 
+	replaceRules, err := expandNetworkingSecGroupV2ReplaceDefaultRules(d.Get("replace_default_rules").([]interface{}))
+	if err != nil {
+		return diag.Errorf("Error creating openstack_networking_secgroup_v2: %s", err)
+	}
+
 	log.Printf("[DEBUG] openstack_networking_secgroup_v2 create options: %#v", opts)
 	sg, err := groups.Create(networkingClient, opts).Extract()
 	if err != nil {
 		return diag.Errorf("Error creating openstack_networking_secgroup_v2: %s", err)
 	}
 
+	d.SetId(sg.ID)
+
 	// Delete the default security group rules if it has been requested.
-	deleteDefaultRules := d.Get("delete_default_rules").(bool)
-	if deleteDefaultRules {
-		sgID := sg.ID
-		sg, err := groups.Get(networkingClient, sgID).Extract()
-		if err != nil {
-			return diag.Errorf("Error retrieving the created openstack_networking_secgroup_v2 %s: %s", sgID, err)
+	// Some clouds create the default rules asynchronously, so keep deleting
+	// them until the security group is observed to be empty.
+	if d.Get("delete_default_rules").(bool) || len(replaceRules) > 0 {
+		stateConf := &resource.StateChangeConf{
+			Pending:                   []string{"PENDING"},
+			Target:                    []string{"EMPTY"},
+			Refresh:                   networkingSecgroupV2StateRefreshFuncDeleteDefaultRules(networkingClient, sg.ID),
+			Timeout:                   d.Timeout(schema.TimeoutCreate),
+			MinTimeout:                500 * time.Millisecond,
+			ContinuousTargetOccurence: 3,
 		}
 
-		for _, rule := range sg.Rules {
-			if err := rules.Delete(networkingClient, rule.ID).ExtractErr(); err != nil {
-				return diag.Errorf("Error deleting a default rule for openstack_networking_secgroup_v2 %s: %s", sgID, err)
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return diag.Errorf("Error deleting the default rules for openstack_networking_secgroup_v2 %s: %s", sg.ID, err)
+		}
+
+		for _, opts := range replaceRules {
+			opts.SecGroupID = sg.ID
+			opts.ProjectID = sg.TenantID
+			log.Printf("[DEBUG] openstack_networking_secgroup_v2 %s replace_default_rules create options: %#v", sg.ID, opts)
+			if _, err := rules.Create(networkingClient, opts).Extract(); err != nil {
+				return diag.Errorf("Error creating a replace_default_rules rule for openstack_networking_secgroup_v2 %s: %s", sg.ID, err)
 			}
 		}
 	}
 
-	d.SetId(sg.ID)
-
-	tags := networkingV2AttributesTags(d)
+	tags := networkingV2CreateAttributesTags(d, config.DefaultTags)
 	if len(tags) > 0 {
 		tagOpts := attributestags.ReplaceAllOpts{Tags: tags}
 		tags, err := attributestags.ReplaceAll(networkingClient, "security-groups", sg.ID, tagOpts).Extract()
@@ -181,7 +247,7 @@ func resourceNetworkingSecGroupV2Update(ctx context.Context, d *schema.ResourceD
 	}
 
 	if d.HasChange("tags") {
-		tags := networkingV2UpdateAttributesTags(d)
+		tags := networkingV2UpdateAttributesTags(d, config.DefaultTags)
 		tagOpts := attributestags.ReplaceAllOpts{Tags: tags}
 		tags, err := attributestags.ReplaceAll(networkingClient, "security-groups", d.Id(), tagOpts).Extract()
 		if err != nil {
@@ -62,6 +62,29 @@ func TestAccNetworkingV2SecGroup_noDefaultRules(t *testing.T) {
 	})
 }
 
+func TestAccNetworkingV2SecGroup_replaceDefaultRules(t *testing.T) {
+	var securityGroup groups.SecGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNetworkingV2SecGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2SecGroupReplaceDefaultRules,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SecGroupExists(
+						"openstack_networking_secgroup_v2.secgroup_1", &securityGroup),
+					testAccCheckNetworkingV2SecGroupRuleCount(&securityGroup, 1),
+				),
+			},
+		},
+	})
+}
+
 func TestAccNetworkingV2SecGroup_timeout(t *testing.T) {
 	var securityGroup groups.SecGroup
 
@@ -170,12 +193,29 @@ resource "openstack_networking_secgroup_v2" "secgroup_1" {
 }
 `
 
+const testAccNetworkingV2SecGroupReplaceDefaultRules = `
+resource "openstack_networking_secgroup_v2" "secgroup_1" {
+  name = "security_group_1"
+  description = "terraform security group acceptance test"
+
+  replace_default_rules {
+    direction = "egress"
+    ethertype = "IPv4"
+    protocol = "tcp"
+    port_range_min = 443
+    port_range_max = 443
+    remote_ip_prefix = "0.0.0.0/0"
+  }
+}
+`
+
 const testAccNetworkingV2SecGroupTimeout = `
 resource "openstack_networking_secgroup_v2" "secgroup_1" {
   name = "security_group"
   description = "terraform security group acceptance test"
 
   timeouts {
+    create = "5m"
     delete = "5m"
   }
 }
@@ -2,6 +2,7 @@ package openstack
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -62,6 +63,21 @@ func TestAccNetworkingV2SubnetPoolBasic(t *testing.T) {
 						"openstack_networking_subnetpool_v2.subnetpool_1", "max_prefixlen", "28"),
 				),
 			},
+			{
+				Config: testAccNetworkingV2SubnetPoolPrefixAdded,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(
+						"openstack_networking_subnetpool_v2.subnetpool_1", "id", &subnetPool.ID),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_subnetpool_v2.subnetpool_1", "prefixes.#", "3"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_subnetpool_v2.subnetpool_1", "prefixes.2", "10.12.0.0/24"),
+				),
+			},
+			{
+				Config:      testAccNetworkingV2SubnetPoolBasic,
+				ExpectError: regexp.MustCompile("prefix 10.12.0.0/24 can't be removed"),
+			},
 		},
 	})
 }
@@ -184,3 +200,18 @@ resource "openstack_networking_subnetpool_v2" "subnetpool_1" {
 	max_prefixlen = 28
 }
 `
+
+const testAccNetworkingV2SubnetPoolPrefixAdded = `
+resource "openstack_networking_subnetpool_v2" "subnetpool_1" {
+	name = "subnetpool_1"
+	description = "terraform subnetpool acceptance test updated"
+
+	prefixes = ["10.10.0.0/16", "10.11.11.0/24", "10.12.0.0/24"]
+
+	default_quota = 8
+
+	default_prefixlen = 26
+	min_prefixlen = 25
+	max_prefixlen = 28
+}
+`
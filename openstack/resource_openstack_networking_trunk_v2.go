@@ -22,6 +22,7 @@ func resourceNetworkingTrunkV2() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
@@ -144,7 +145,7 @@ func resourceNetworkingTrunkV2Create(ctx context.Context, d *schema.ResourceData
 
 	d.SetId(trunk.ID)
 
-	tags := networkingV2AttributesTags(d)
+	tags := networkingV2CreateAttributesTags(d, config.DefaultTags)
 	if len(tags) > 0 {
 		tagOpts := attributestags.ReplaceAllOpts{Tags: tags}
 		tags, err := attributestags.ReplaceAll(client, "trunks", trunk.ID, tagOpts).Extract()
@@ -264,8 +265,25 @@ func resourceNetworkingTrunkV2Update(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
+	if updateTrunk || d.HasChange("sub_port") {
+		log.Printf("[DEBUG] Waiting for openstack_networking_trunk_v2 %s to become available.", d.Id())
+
+		stateConf := &resource.StateChangeConf{
+			Target:     []string{"ACTIVE", "DOWN"},
+			Refresh:    networkingTrunkV2StateRefreshFunc(client, d.Id()),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			Delay:      5 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		_, err = stateConf.WaitForStateContext(ctx)
+		if err != nil {
+			return diag.Errorf("Error waiting for openstack_networking_trunk_v2 %s to become available: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("tags") {
-		tags := networkingV2UpdateAttributesTags(d)
+		tags := networkingV2UpdateAttributesTags(d, config.DefaultTags)
 		tagOpts := attributestags.ReplaceAllOpts{Tags: tags}
 		tags, err := attributestags.ReplaceAll(client, "trunks", d.Id(), tagOpts).Extract()
 		if err != nil {
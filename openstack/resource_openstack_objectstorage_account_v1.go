@@ -0,0 +1,310 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/accounts"
+)
+
+func resourceObjectStorageAccountV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceObjectStorageAccountV1Create,
+		ReadContext:   resourceObjectStorageAccountV1Read,
+		UpdateContext: resourceObjectStorageAccountV1Update,
+		DeleteContext: resourceObjectStorageAccountV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceObjectStorageAccountV1Import,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"quota_bytes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"temp_url_key": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+
+			"temp_url_key_2": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: func(v interface{}, k string) ([]string, []error) {
+					var errs []error
+					for key := range v.(map[string]interface{}) {
+						if objectStorageAccountV1IsSystemMetadata(key) {
+							errs = append(errs, fmt.Errorf("%s: the %s key must be set using the dedicated argument", k, key))
+						}
+					}
+					return nil, errs
+				},
+			},
+
+			// Read Only
+			"bytes_used": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"container_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"object_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceObjectStorageAccountV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	objectStorageClient, err := config.ObjectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	accountClient, projectID, err := objectStorageAccountV1Client(objectStorageClient, d.Get("project_id").(string))
+	if err != nil {
+		return diag.Errorf("Error creating openstack_objectstorage_account_v1 client: %s", err)
+	}
+
+	updateOpts := accounts.UpdateOpts{
+		Metadata:    expandToMapStringString(d.Get("metadata").(map[string]interface{})),
+		TempURLKey:  d.Get("temp_url_key").(string),
+		TempURLKey2: d.Get("temp_url_key_2").(string),
+	}
+
+	if v := d.Get("quota_bytes").(int); v > 0 {
+		updateOpts.Metadata[objectStorageAccountV1QuotaBytes] = strconv.Itoa(v)
+	}
+
+	log.Printf("[DEBUG] Updating openstack_objectstorage_account_v1 %s", projectID)
+	_, err = accounts.Update(accountClient, updateOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error updating openstack_objectstorage_account_v1 %s: %s", projectID, err)
+	}
+
+	d.SetId(projectID)
+
+	return resourceObjectStorageAccountV1Read(ctx, d, meta)
+}
+
+func resourceObjectStorageAccountV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	objectStorageClient, err := config.ObjectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	accountClient, _, err := objectStorageAccountV1Client(objectStorageClient, d.Id())
+	if err != nil {
+		return diag.Errorf("Error creating openstack_objectstorage_account_v1 client: %s", err)
+	}
+
+	result := accounts.Get(accountClient, nil)
+	headers, err := result.Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_objectstorage_account_v1"))
+	}
+
+	metadata, err := result.ExtractMetadata()
+	if err != nil {
+		return diag.Errorf("Error extracting openstack_objectstorage_account_v1 %s metadata: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_objectstorage_account_v1 %s metadata: %#v", d.Id(), metadata)
+
+	d.Set("region", GetRegion(d, config))
+	d.Set("project_id", d.Id())
+	d.Set("temp_url_key", headers.TempURLKey)
+	d.Set("temp_url_key_2", headers.TempURLKey2)
+	d.Set("bytes_used", headers.BytesUsed)
+	d.Set("container_count", headers.ContainerCount)
+	d.Set("object_count", headers.ObjectCount)
+
+	if headers.QuotaBytes != nil {
+		d.Set("quota_bytes", *headers.QuotaBytes)
+	} else {
+		d.Set("quota_bytes", 0)
+	}
+
+	// Only the metadata keys managed by the resource are read, the account
+	// may have metadata set by other tools.
+	if err := d.Set("metadata", objectStorageAccountV1Metadata(metadata, d.Get("metadata").(map[string]interface{}))); err != nil {
+		log.Printf("[DEBUG] Unable to set openstack_objectstorage_account_v1 %s metadata: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceObjectStorageAccountV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	objectStorageClient, err := config.ObjectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	accountClient, _, err := objectStorageAccountV1Client(objectStorageClient, d.Id())
+	if err != nil {
+		return diag.Errorf("Error creating openstack_objectstorage_account_v1 client: %s", err)
+	}
+
+	updateOpts := accounts.UpdateOpts{
+		Metadata: make(map[string]string),
+	}
+
+	if d.HasChange("metadata") {
+		o, n := d.GetChange("metadata")
+		oldMetadata := o.(map[string]interface{})
+		newMetadata := n.(map[string]interface{})
+
+		for key, value := range newMetadata {
+			updateOpts.Metadata[key] = value.(string)
+		}
+
+		for key := range oldMetadata {
+			if _, ok := newMetadata[key]; !ok {
+				updateOpts.RemoveMetadata = append(updateOpts.RemoveMetadata, key)
+			}
+		}
+	}
+
+	if d.HasChange("quota_bytes") {
+		if v := d.Get("quota_bytes").(int); v > 0 {
+			updateOpts.Metadata[objectStorageAccountV1QuotaBytes] = strconv.Itoa(v)
+		} else {
+			updateOpts.RemoveMetadata = append(updateOpts.RemoveMetadata, objectStorageAccountV1QuotaBytes)
+		}
+	}
+
+	if d.HasChange("temp_url_key") {
+		if v := d.Get("temp_url_key").(string); v != "" {
+			updateOpts.TempURLKey = v
+		} else {
+			updateOpts.RemoveMetadata = append(updateOpts.RemoveMetadata, objectStorageAccountV1TempURLKey)
+		}
+	}
+
+	if d.HasChange("temp_url_key_2") {
+		if v := d.Get("temp_url_key_2").(string); v != "" {
+			updateOpts.TempURLKey2 = v
+		} else {
+			updateOpts.RemoveMetadata = append(updateOpts.RemoveMetadata, objectStorageAccountV1TempURLKey2)
+		}
+	}
+
+	log.Printf("[DEBUG] Updating openstack_objectstorage_account_v1 %s", d.Id())
+	_, err = accounts.Update(accountClient, updateOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error updating openstack_objectstorage_account_v1 %s: %s", d.Id(), err)
+	}
+
+	return resourceObjectStorageAccountV1Read(ctx, d, meta)
+}
+
+func resourceObjectStorageAccountV1Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	objectStorageClient, err := config.ObjectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	accountClient, _, err := objectStorageAccountV1Client(objectStorageClient, d.Id())
+	if err != nil {
+		return diag.Errorf("Error creating openstack_objectstorage_account_v1 client: %s", err)
+	}
+
+	// Only the keys set by the resource are removed.
+	var updateOpts accounts.UpdateOpts
+	for key := range d.Get("metadata").(map[string]interface{}) {
+		updateOpts.RemoveMetadata = append(updateOpts.RemoveMetadata, key)
+	}
+
+	if d.Get("quota_bytes").(int) > 0 {
+		updateOpts.RemoveMetadata = append(updateOpts.RemoveMetadata, objectStorageAccountV1QuotaBytes)
+	}
+
+	if d.Get("temp_url_key").(string) != "" {
+		updateOpts.RemoveMetadata = append(updateOpts.RemoveMetadata, objectStorageAccountV1TempURLKey)
+	}
+
+	if d.Get("temp_url_key_2").(string) != "" {
+		updateOpts.RemoveMetadata = append(updateOpts.RemoveMetadata, objectStorageAccountV1TempURLKey2)
+	}
+
+	if len(updateOpts.RemoveMetadata) == 0 {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Removing openstack_objectstorage_account_v1 %s keys: %v", d.Id(), updateOpts.RemoveMetadata)
+	_, err = accounts.Update(accountClient, updateOpts).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_objectstorage_account_v1"))
+	}
+
+	return nil
+}
+
+// resourceObjectStorageAccountV1Import imports the metadata of the account,
+// since there is no configuration to restrict the managed keys.
+func resourceObjectStorageAccountV1Import(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	objectStorageClient, err := config.ObjectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return nil, fmt.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	accountClient, _, err := objectStorageAccountV1Client(objectStorageClient, d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("Error creating openstack_objectstorage_account_v1 client: %s", err)
+	}
+
+	metadata, err := accounts.Get(accountClient, nil).ExtractMetadata()
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving openstack_objectstorage_account_v1 %s: %s", d.Id(), err)
+	}
+
+	m := make(map[string]string)
+	for key, value := range metadata {
+		if !objectStorageAccountV1IsSystemMetadata(key) {
+			m[key] = value
+		}
+	}
+	d.Set("metadata", m)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,110 @@
+package openstack
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/accounts"
+)
+
+func TestAccObjectStorageV1Account_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSwift(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckObjectStorageV1AccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectStorageV1AccountBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"openstack_objectstorage_account_v1.account_1", "project_id"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_account_v1.account_1", "temp_url_key", "key_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_account_v1.account_1", "metadata.tftest", "true"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_account_v1.account_1", "metadata.tfupperTest", "true"),
+				),
+			},
+			{
+				Config: testAccObjectStorageV1AccountUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_account_v1.account_1", "temp_url_key", ""),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_account_v1.account_1", "temp_url_key_2", "key_2"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_account_v1.account_1", "metadata.%", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_account_v1.account_1", "metadata.tftest", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckObjectStorageV1AccountDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	objectStorageClient, err := config.ObjectStorageV1Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_objectstorage_account_v1" {
+			continue
+		}
+
+		result := accounts.Get(objectStorageClient, nil)
+		headers, err := result.Extract()
+		if err != nil {
+			return err
+		}
+
+		if headers.TempURLKey != "" || headers.TempURLKey2 != "" {
+			return fmt.Errorf("Account temp URL keys still exist")
+		}
+
+		metadata, err := result.ExtractMetadata()
+		if err != nil {
+			return err
+		}
+
+		for key := range metadata {
+			if strings.HasPrefix(strings.ToLower(key), "tf") {
+				return fmt.Errorf("Account metadata %s still exists", key)
+			}
+		}
+	}
+
+	return nil
+}
+
+const testAccObjectStorageV1AccountBasic = `
+resource "openstack_objectstorage_account_v1" "account_1" {
+  temp_url_key = "key_1"
+
+  metadata = {
+    tftest      = "true"
+    tfupperTest = "true"
+  }
+}
+`
+
+const testAccObjectStorageV1AccountUpdate = `
+resource "openstack_objectstorage_account_v1" "account_1" {
+  temp_url_key_2 = "key_2"
+
+  metadata = {
+    tftest = "false"
+  }
+}
+`
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -26,6 +27,15 @@ func resourceObjectStorageContainerV1() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceObjectStorageContainerV1V0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceObjectStorageContainerV1StateUpgradeV0,
+				Version: 0,
+			},
+		},
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -39,9 +49,34 @@ func resourceObjectStorageContainerV1() *schema.Resource {
 				ForceNew: false,
 			},
 			"container_read": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: false,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      false,
+				ConflictsWith: []string{"read_acl"},
+			},
+			"read_acl": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"container_read"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"referrers": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"users": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"rlistings": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
 			},
 			"container_sync_to": {
 				Type:     schema.TypeString,
@@ -54,9 +89,25 @@ func resourceObjectStorageContainerV1() *schema.Resource {
 				ForceNew: false,
 			},
 			"container_write": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: false,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      false,
+				ConflictsWith: []string{"write_acl"},
+			},
+			"write_acl": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"container_write"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"users": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
 			},
 			"content_type": {
 				Type:     schema.TypeString,
@@ -64,9 +115,17 @@ func resourceObjectStorageContainerV1() *schema.Resource {
 				ForceNew: false,
 			},
 			"versioning": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				MaxItems: 1,
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"versioning_legacy"},
+			},
+			"versioning_legacy": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"versioning"},
+				Deprecated:    "Use newer \"versioning\" implementation",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"type": {
@@ -88,6 +147,26 @@ func resourceObjectStorageContainerV1() *schema.Resource {
 				Optional: true,
 				ForceNew: false,
 			},
+			"quota_bytes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"quota_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"temp_url_key": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"temp_url_key_2": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
 			"force_destroy": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -106,16 +185,29 @@ func resourceObjectStorageContainerV1Create(ctx context.Context, d *schema.Resou
 
 	cn := d.Get("name").(string)
 
-	createOpts := &containers.CreateOpts{
-		ContainerRead:    d.Get("container_read").(string),
-		ContainerSyncTo:  d.Get("container_sync_to").(string),
-		ContainerSyncKey: d.Get("container_sync_key").(string),
-		ContainerWrite:   d.Get("container_write").(string),
-		ContentType:      d.Get("content_type").(string),
-		Metadata:         resourceContainerMetadataV2(d),
+	createOpts := &objectStorageContainerV1CreateOpts{
+		CreateOpts: containers.CreateOpts{
+			ContainerRead:    resourceObjectStorageContainerV1ReadACL(d),
+			ContainerSyncTo:  d.Get("container_sync_to").(string),
+			ContainerSyncKey: d.Get("container_sync_key").(string),
+			ContainerWrite:   resourceObjectStorageContainerV1WriteACL(d),
+			ContentType:      d.Get("content_type").(string),
+			Metadata:         resourceContainerMetadataV2(d),
+			TempURLKey:       d.Get("temp_url_key").(string),
+			TempURLKey2:      d.Get("temp_url_key_2").(string),
+		},
+		VersionsEnabled: d.Get("versioning").(bool),
+	}
+
+	if v := d.Get("quota_bytes").(int); v > 0 {
+		createOpts.Metadata[objectStorageContainerV1QuotaBytes] = strconv.Itoa(v)
 	}
 
-	versioning := d.Get("versioning").(*schema.Set)
+	if v := d.Get("quota_count").(int); v > 0 {
+		createOpts.Metadata[objectStorageContainerV1QuotaCount] = strconv.Itoa(v)
+	}
+
+	versioning := d.Get("versioning_legacy").(*schema.Set)
 	if versioning.Len() > 0 {
 		vParams := versioning.List()[0]
 		if vRaw, ok := vParams.(map[string]interface{}); ok {
@@ -168,16 +260,33 @@ func resourceObjectStorageContainerV1Read(ctx context.Context, d *schema.Resourc
 	log.Printf("[DEBUG] Retrieved metadata for objectstorage_container_v1 '%s': %#v", d.Id(), metadata)
 
 	d.Set("name", d.Id())
-
-	if len(headers.Read) > 0 && headers.Read[0] != "" {
+	d.Set("temp_url_key", headers.TempURLKey)
+	d.Set("temp_url_key_2", headers.TempURLKey2)
+	d.Set("quota_bytes", objectStorageContainerV1QuotaMetadata(metadata, objectStorageContainerV1QuotaBytes))
+	d.Set("quota_count", objectStorageContainerV1QuotaMetadata(metadata, objectStorageContainerV1QuotaCount))
+
+	// The structured ACLs are only read when they are used instead of the
+	// raw ACL strings.
+	if len(d.Get("read_acl").([]interface{})) > 0 {
+		if err := d.Set("read_acl", flattenObjectStorageContainerV1ACL(headers.Read, true)); err != nil {
+			log.Printf("[DEBUG] Unable to set read_acl for objectstorage_container_v1 '%s': %s", d.Id(), err)
+		}
+	} else if len(headers.Read) > 0 && headers.Read[0] != "" {
 		d.Set("container_read", strings.Join(headers.Read, ","))
 	}
 
-	if len(headers.Write) > 0 && headers.Write[0] != "" {
+	if len(d.Get("write_acl").([]interface{})) > 0 {
+		if err := d.Set("write_acl", flattenObjectStorageContainerV1ACL(headers.Write, false)); err != nil {
+			log.Printf("[DEBUG] Unable to set write_acl for objectstorage_container_v1 '%s': %s", d.Id(), err)
+		}
+	} else if len(headers.Write) > 0 && headers.Write[0] != "" {
 		d.Set("container_write", strings.Join(headers.Write, ","))
 	}
 
-	versioningResource := resourceObjectStorageContainerV1().Schema["versioning"].Elem.(*schema.Resource)
+	versionsEnabled, _ := strconv.ParseBool(result.Header.Get("X-Versions-Enabled"))
+	d.Set("versioning", versionsEnabled)
+
+	versioningResource := resourceObjectStorageContainerV1().Schema["versioning_legacy"].Elem.(*schema.Resource)
 
 	if headers.VersionsLocation != "" && headers.HistoryLocation != "" {
 		return diag.Errorf("error reading versioning headers for objectstorage_container_v1 '%s': found location for both exclusive types, versions ('%s') and history ('%s')", d.Id(), headers.VersionsLocation, headers.HistoryLocation)
@@ -188,7 +297,7 @@ func resourceObjectStorageContainerV1Read(ctx context.Context, d *schema.Resourc
 			"type":     "versions",
 			"location": headers.VersionsLocation,
 		}
-		if err := d.Set("versioning", schema.NewSet(schema.HashResource(versioningResource), []interface{}{versioning})); err != nil {
+		if err := d.Set("versioning_legacy", schema.NewSet(schema.HashResource(versioningResource), []interface{}{versioning})); err != nil {
 			return diag.Errorf("error setting 'versions' versioning for objectstorage_container_v1 '%s': %s", d.Id(), err)
 		}
 	}
@@ -198,7 +307,7 @@ func resourceObjectStorageContainerV1Read(ctx context.Context, d *schema.Resourc
 			"type":     "history",
 			"location": headers.HistoryLocation,
 		}
-		if err := d.Set("versioning", schema.NewSet(schema.HashResource(versioningResource), []interface{}{versioning})); err != nil {
+		if err := d.Set("versioning_legacy", schema.NewSet(schema.HashResource(versioningResource), []interface{}{versioning})); err != nil {
 			return diag.Errorf("error setting 'history' versioning for objectstorage_container_v1 '%s': %s", d.Id(), err)
 		}
 	}
@@ -215,22 +324,29 @@ func resourceObjectStorageContainerV1Update(ctx context.Context, d *schema.Resou
 		return diag.Errorf("error creating OpenStack object storage client: %s", err)
 	}
 
-	containerRead := d.Get("container_read").(string)
+	containerRead := resourceObjectStorageContainerV1ReadACL(d)
 	containerSyncTo := d.Get("container_sync_to").(string)
 	containerSyncKey := d.Get("container_sync_key").(string)
-	containerWrite := d.Get("container_write").(string)
+	containerWrite := resourceObjectStorageContainerV1WriteACL(d)
 	contentType := d.Get("content_type").(string)
 
-	updateOpts := containers.UpdateOpts{
-		ContainerRead:    &containerRead,
-		ContainerSyncTo:  &containerSyncTo,
-		ContainerSyncKey: &containerSyncKey,
-		ContainerWrite:   &containerWrite,
-		ContentType:      &contentType,
+	updateOpts := objectStorageContainerV1UpdateOpts{
+		UpdateOpts: containers.UpdateOpts{
+			ContainerRead:    &containerRead,
+			ContainerSyncTo:  &containerSyncTo,
+			ContainerSyncKey: &containerSyncKey,
+			ContainerWrite:   &containerWrite,
+			ContentType:      &contentType,
+		},
 	}
 
 	if d.HasChange("versioning") {
-		versioning := d.Get("versioning").(*schema.Set)
+		versionsEnabled := d.Get("versioning").(bool)
+		updateOpts.VersionsEnabled = &versionsEnabled
+	}
+
+	if d.HasChange("versioning_legacy") {
+		versioning := d.Get("versioning_legacy").(*schema.Set)
 		if versioning.Len() == 0 {
 			updateOpts.RemoveVersionsLocation = "true"
 			updateOpts.RemoveHistoryLocation = "true"
@@ -255,6 +371,39 @@ func resourceObjectStorageContainerV1Update(ctx context.Context, d *schema.Resou
 		updateOpts.Metadata = resourceContainerMetadataV2(d)
 	}
 
+	// The quotas and the temp URL keys are stored as container metadata.
+	systemMetadata := map[string]string{
+		"quota_bytes":    objectStorageContainerV1QuotaBytes,
+		"quota_count":    objectStorageContainerV1QuotaCount,
+		"temp_url_key":   objectStorageContainerV1TempURLKey,
+		"temp_url_key_2": objectStorageContainerV1TempURLKey2,
+	}
+	for attr, key := range systemMetadata {
+		if !d.HasChange(attr) {
+			continue
+		}
+
+		var v string
+		switch value := d.Get(attr).(type) {
+		case int:
+			if value > 0 {
+				v = strconv.Itoa(value)
+			}
+		case string:
+			v = value
+		}
+
+		if v == "" {
+			updateOpts.RemoveMetadata = append(updateOpts.RemoveMetadata, key)
+			continue
+		}
+
+		if updateOpts.Metadata == nil {
+			updateOpts.Metadata = make(map[string]string)
+		}
+		updateOpts.Metadata[key] = v
+	}
+
 	_, err = containers.Update(objectStorageClient, d.Id(), updateOpts).Extract()
 	if err != nil {
 		return diag.Errorf("error updating objectstorage_container_v1 '%s': %s", d.Id(), err)
@@ -278,6 +427,21 @@ func resourceObjectStorageContainerV1Delete(ctx context.Context, d *schema.Resou
 			log.Printf("[DEBUG] Attempting to forceDestroy objectstorage_container_v1 '%s': %+v", d.Id(), err)
 
 			container := d.Id()
+
+			// The objects of a versioned container can only be removed by
+			// deleting all their versions. The X-Versions-Enabled header is
+			// also returned once the versioning has been disabled.
+			result := containers.Get(objectStorageClient, container, nil)
+			if result.Err != nil {
+				return diag.FromErr(CheckDeleted(d, result.Err, "container"))
+			}
+			if result.Header.Get("X-Versions-Enabled") != "" {
+				if err := objectStorageContainerV1DeleteVersions(objectStorageClient, container); err != nil {
+					return diag.FromErr(err)
+				}
+				return resourceObjectStorageContainerV1Delete(ctx, d, meta)
+			}
+
 			opts := &objects.ListOpts{
 				Full: false,
 			}
@@ -312,7 +476,27 @@ func resourceObjectStorageContainerV1Delete(ctx context.Context, d *schema.Resou
 func resourceContainerMetadataV2(d *schema.ResourceData) map[string]string {
 	m := make(map[string]string)
 	for key, val := range d.Get("metadata").(map[string]interface{}) {
+		if objectStorageContainerV1IsSystemMetadata(key) {
+			log.Printf("[WARN] Ignoring objectstorage_container_v1 '%s' metadata key '%s', use the dedicated argument instead", d.Id(), key)
+			continue
+		}
 		m[key] = val.(string)
 	}
 	return m
 }
+
+func resourceObjectStorageContainerV1ReadACL(d *schema.ResourceData) string {
+	if v, ok := d.GetOk("read_acl"); ok {
+		return expandObjectStorageContainerV1ACL(v.([]interface{}))
+	}
+
+	return d.Get("container_read").(string)
+}
+
+func resourceObjectStorageContainerV1WriteACL(d *schema.ResourceData) string {
+	if v, ok := d.GetOk("write_acl"); ok {
+		return expandObjectStorageContainerV1ACL(v.([]interface{}))
+	}
+
+	return d.Get("container_write").(string)
+}
@@ -44,6 +44,125 @@ func TestAccObjectStorageV1Container_basic(t *testing.T) {
 	})
 }
 
+func TestAccObjectStorageV1Container_quotaAndTempURLKeys(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSwift(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckObjectStorageV1ContainerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectStorageV1ContainerQuotaAndTempURLKeys,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "quota_bytes", "1048576"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "quota_count", "10"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "temp_url_key", "key_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "temp_url_key_2", "key_2"),
+				),
+			},
+			{
+				Config: testAccObjectStorageV1ContainerQuotaAndTempURLKeysUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "quota_bytes", "2097152"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "quota_count", "0"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "temp_url_key", "key_3"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "temp_url_key_2", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccObjectStorageV1Container_acl(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSwift(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckObjectStorageV1ContainerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectStorageV1ContainerACL,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "read_acl.0.referrers.#", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "read_acl.0.referrers.0", "*"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "read_acl.0.rlistings", "true"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "write_acl.0.users.#", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "write_acl.0.users.0", "*:*"),
+				),
+			},
+			{
+				Config: testAccObjectStorageV1ContainerACLUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "read_acl.0.referrers.#", "0"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "read_acl.0.rlistings", "false"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "read_acl.0.users.#", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "read_acl.0.users.0", "*:*"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "write_acl.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccObjectStorageV1Container_versioning(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSwift(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckObjectStorageV1ContainerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectStorageV1ContainerVersioning(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "versioning", "true"),
+				),
+			},
+			{
+				Config: testAccObjectStorageV1ContainerVersioningObject(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_v1.object_1", "content", "bar"),
+				),
+			},
+			{
+				Config: testAccObjectStorageV1ContainerVersioning(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "versioning", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckObjectStorageV1ContainerDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	objectStorageClient, err := config.ObjectStorageV1Client(osRegionName)
@@ -84,7 +203,7 @@ resource "openstack_objectstorage_container_v1" "container_1" {
     upperTest = "true"
   }
   content_type = "application/json"
-  versioning {
+  versioning_legacy {
     type = "versions"
     location = "othercontainer"
   }
@@ -102,3 +221,68 @@ resource "openstack_objectstorage_container_v1" "container_1" {
   content_type = "text/plain"
 }
 `
+
+const testAccObjectStorageV1ContainerQuotaAndTempURLKeys = `
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name           = "container_1"
+  quota_bytes    = 1048576
+  quota_count    = 10
+  temp_url_key   = "key_1"
+  temp_url_key_2 = "key_2"
+}
+`
+
+const testAccObjectStorageV1ContainerQuotaAndTempURLKeysUpdate = `
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name         = "container_1"
+  quota_bytes  = 2097152
+  temp_url_key = "key_3"
+}
+`
+
+const testAccObjectStorageV1ContainerACL = `
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name = "container_1"
+
+  read_acl {
+    referrers = ["*"]
+    rlistings = true
+  }
+
+  write_acl {
+    users = ["*:*"]
+  }
+}
+`
+
+const testAccObjectStorageV1ContainerACLUpdate = `
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name = "container_1"
+
+  read_acl {
+    users = ["*:*"]
+  }
+}
+`
+
+func testAccObjectStorageV1ContainerVersioning(versioning bool) string {
+	return fmt.Sprintf(`
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name          = "container_1"
+  versioning    = %t
+  force_destroy = true
+}
+`, versioning)
+}
+
+func testAccObjectStorageV1ContainerVersioningObject(versioning bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_objectstorage_object_v1" "object_1" {
+  name           = "object_1"
+  container_name = "${openstack_objectstorage_container_v1.container_1.name}"
+  content        = "bar"
+}
+`, testAccObjectStorageV1ContainerVersioning(versioning))
+}
@@ -0,0 +1,208 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceObjectStorageObjectTreeV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceObjectStorageObjectTreeV1Create,
+		ReadContext:   resourceObjectStorageObjectTreeV1Read,
+		UpdateContext: resourceObjectStorageObjectTreeV1Update,
+		DeleteContext: resourceObjectStorageObjectTreeV1Delete,
+
+		CustomizeDiff: resourceObjectStorageObjectTreeV1CustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"container_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"source_dir": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"content_types": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      objectStorageObjectTreeV1DefaultConcurrency,
+				ValidateFunc: validation.IntBetween(1, 64),
+			},
+
+			// this attribute is used to trigger resource updates
+			// if the content of the source directory is changed
+			"files": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceObjectStorageObjectTreeV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	objectStorageClient, err := config.ObjectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	cn := d.Get("container_name").(string)
+	prefix := d.Get("prefix").(string)
+
+	files, err := objectStorageObjectTreeV1Walk(d.Get("source_dir").(string), prefix)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	etags, err := objectStorageObjectTreeV1Sync(objectStorageClient, cn, files, nil, true,
+		d.Get("content_types").(map[string]interface{}), resourceObjectMetadataV1(d), d.Get("concurrency").(int))
+
+	// Store the ID and the uploaded objects now, so that they are removed,
+	// if some of the uploads failed.
+	d.SetId(fmt.Sprintf("%s/%s", cn, prefix))
+	d.Set("files", etags)
+
+	if err != nil {
+		return diag.Errorf("Error creating openstack_objectstorage_object_tree_v1 %s: %s", d.Id(), err)
+	}
+
+	return resourceObjectStorageObjectTreeV1Read(ctx, d, meta)
+}
+
+func resourceObjectStorageObjectTreeV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	objectStorageClient, err := config.ObjectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	cn := d.Get("container_name").(string)
+
+	objectETags, err := objectStorageObjectTreeV1ListETags(objectStorageClient, cn, d.Get("prefix").(string))
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error listing openstack_objectstorage_object_tree_v1 objects"))
+	}
+
+	// Only the objects managed by the resource are tracked. The missing and
+	// modified objects are uploaded again by the next apply.
+	etags := make(map[string]string)
+	for name := range objectStorageObjectTreeV1ExpandETags(d.Get("files")) {
+		if etag, ok := objectETags[name]; ok {
+			etags[name] = etag
+		}
+	}
+
+	log.Printf("[DEBUG] Retrieved %d objects of openstack_objectstorage_object_tree_v1 %s", len(etags), d.Id())
+
+	d.Set("files", etags)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceObjectStorageObjectTreeV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	objectStorageClient, err := config.ObjectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	cn := d.Get("container_name").(string)
+
+	files, err := objectStorageObjectTreeV1Walk(d.Get("source_dir").(string), d.Get("prefix").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The headers and metadata of each object are set on upload, so all files
+	// are uploaded again, when they change.
+	oldFiles, _ := d.GetChange("files")
+	uploadAll := d.HasChanges("content_types", "metadata")
+
+	etags, err := objectStorageObjectTreeV1Sync(objectStorageClient, cn, files, objectStorageObjectTreeV1ExpandETags(oldFiles), uploadAll,
+		d.Get("content_types").(map[string]interface{}), resourceObjectMetadataV1(d), d.Get("concurrency").(int))
+	d.Set("files", etags)
+	if err != nil {
+		return diag.Errorf("Error updating openstack_objectstorage_object_tree_v1 %s: %s", d.Id(), err)
+	}
+
+	return resourceObjectStorageObjectTreeV1Read(ctx, d, meta)
+}
+
+func resourceObjectStorageObjectTreeV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	objectStorageClient, err := config.ObjectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	cn := d.Get("container_name").(string)
+
+	etags, err := objectStorageObjectTreeV1Sync(objectStorageClient, cn, nil, objectStorageObjectTreeV1ExpandETags(d.Get("files")), false,
+		nil, nil, d.Get("concurrency").(int))
+	if err != nil {
+		// Keep the objects, which couldn't be removed.
+		d.Set("files", etags)
+		return diag.Errorf("Error deleting openstack_objectstorage_object_tree_v1 %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceObjectStorageObjectTreeV1CustomizeDiff computes the checksums of the
+// files in the source directory, so that new, changed and removed files are
+// detected.
+func resourceObjectStorageObjectTreeV1CustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("source_dir") || !diff.NewValueKnown("prefix") {
+		return diff.SetNewComputed("files")
+	}
+
+	files, err := objectStorageObjectTreeV1Walk(diff.Get("source_dir").(string), diff.Get("prefix").(string))
+	if err != nil {
+		// The source directory may be created during the apply.
+		log.Printf("[DEBUG] Unable to compute the openstack_objectstorage_object_tree_v1 checksums: %s", err)
+		return nil
+	}
+
+	etags := objectStorageObjectTreeV1ETags(files)
+	if !reflect.DeepEqual(objectStorageObjectTreeV1ExpandETags(diff.Get("files")), etags) {
+		return diff.SetNew("files", etags)
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package openstack
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
+)
+
+func TestAccObjectStorageV1ObjectTree_basic(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf_test_objectstorage_object_tree")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := os.Mkdir(filepath.Join(dir, "css"), 0700); err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "index.html"), []byte("foo"), 0600); err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "css", "site.css"), []byte("foo"), 0600); err != nil {
+		log.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSwift(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy: func(s *terraform.State) error {
+			return testAccCheckObjectStorageV1ObjectTreeDestroy(s, "site/index.html", "site/css/site.css", "site/robots.txt")
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectStorageV1ObjectTreeBasic(dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_tree_v1.site", "files.%", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_tree_v1.site", "files.site/index.html", fooMD5()),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_tree_v1.site", "files.site/css/site.css", fooMD5()),
+					testAccCheckObjectStorageV1ObjectTreeContentType("site/index.html", "text/html; charset=utf-8"),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := os.Remove(filepath.Join(dir, "index.html")); err != nil {
+						t.Fatal(err)
+					}
+					if err := ioutil.WriteFile(filepath.Join(dir, "css", "site.css"), []byte("bar"), 0600); err != nil {
+						t.Fatal(err)
+					}
+					if err := ioutil.WriteFile(filepath.Join(dir, "robots.txt"), []byte("foo"), 0600); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccObjectStorageV1ObjectTreeBasic(dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_tree_v1.site", "files.%", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_tree_v1.site", "files.site/css/site.css", barMD5()),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_tree_v1.site", "files.site/robots.txt", fooMD5()),
+					func(s *terraform.State) error {
+						return testAccCheckObjectStorageV1ObjectTreeDestroy(s, "site/index.html")
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckObjectStorageV1ObjectTreeDestroy(s *terraform.State, names ...string) error {
+	config := testAccProvider.Meta().(*Config)
+	objectStorageClient, err := config.ObjectStorageV1Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	for _, name := range names {
+		_, err := objects.Get(objectStorageClient, "tf_test_container_1", name, &objects.GetOpts{}).Extract()
+		if err == nil {
+			return fmt.Errorf("Container object %s still exists", name)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckObjectStorageV1ObjectTreeContentType(name, contentType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+		objectStorageClient, err := config.ObjectStorageV1Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack object storage client: %s", err)
+		}
+
+		object, err := objects.Get(objectStorageClient, "tf_test_container_1", name, &objects.GetOpts{}).Extract()
+		if err != nil {
+			return err
+		}
+
+		if object.ContentType != contentType {
+			return fmt.Errorf("Unexpected content type of %s: %s", name, object.ContentType)
+		}
+
+		return nil
+	}
+}
+
+func testAccObjectStorageV1ObjectTreeBasic(dir string) string {
+	return fmt.Sprintf(`
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name = "tf_test_container_1"
+  force_destroy = true
+}
+
+resource "openstack_objectstorage_object_tree_v1" "site" {
+  container_name = "${openstack_objectstorage_container_v1.container_1.name}"
+  source_dir = "%s"
+  prefix = "site/"
+  concurrency = 2
+
+  content_types = {
+    ".html" = "text/html; charset=utf-8"
+  }
+}
+`, dir)
+}
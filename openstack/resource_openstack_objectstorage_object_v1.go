@@ -4,12 +4,16 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"log"
 	"os"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/mitchellh/go-homedir"
 
 	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
@@ -22,6 +26,8 @@ func resourceObjectStorageObjectV1() *schema.Resource {
 		UpdateContext: resourceObjectStorageObjectV1Update,
 		DeleteContext: resourceObjectStorageObjectV1Delete,
 
+		CustomizeDiff: resourceObjectStorageObjectV1CustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -76,14 +82,18 @@ func resourceObjectStorageObjectV1() *schema.Resource {
 			},
 
 			"delete_after": {
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"delete_at"},
+				ValidateFunc:  validation.IntAtLeast(1),
 			},
 
 			"delete_at": {
 				Type:             schema.TypeString,
 				Computed:         true,
 				Optional:         true,
+				ConflictsWith:    []string{"delete_after"},
+				ValidateFunc:     validation.IsRFC3339Time,
 				DiffSuppressFunc: suppressEquivalentTimeDiffs,
 			},
 
@@ -119,6 +129,22 @@ func resourceObjectStorageObjectV1() *schema.Resource {
 				ConflictsWith: []string{"content", "copy_from", "object_manifest"},
 			},
 
+			"large_object": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"copy_from", "object_manifest", "etag"},
+				ValidateFunc: validation.StringInSlice([]string{
+					"slo", "dlo",
+				}, false),
+			},
+
+			"segment_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"large_object"},
+				ValidateFunc: validation.IntAtLeast(1024 * 1024),
+			},
+
 			// Read Only
 			"content_length": {
 				Type:     schema.TypeInt,
@@ -206,17 +232,9 @@ func resourceObjectStorageObjectV1Create(ctx context.Context, d *schema.Resource
 		createOpts.ContentType = v.(string)
 	}
 
-	if v, ok := d.GetOk("delete_after"); ok {
-		createOpts.DeleteAfter = int64(v.(int))
-	}
-
-	if v, ok := d.GetOk("delete_at"); ok && v != "" {
-		t, err := time.Parse(time.RFC3339, fmt.Sprintf("%s", v))
-		if err != nil {
-			return diag.Errorf("Error Parsing Swift Object Lifecycle Expiration Date: %s, %s", err.Error(), v)
-		}
-
-		createOpts.DeleteAt = t.Unix()
+	createOpts.DeleteAfter, createOpts.DeleteAt, err = resourceObjectExpirationV1(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	if v, ok := d.GetOk("detect_content_type"); ok && v.(bool) {
@@ -227,6 +245,19 @@ func resourceObjectStorageObjectV1Create(ctx context.Context, d *schema.Resource
 		createOpts.ETag = v.(string)
 	}
 
+	if kind := d.Get("large_object").(string); kind != "" {
+		segmentSize := objectStorageObjectV1SegmentSize(d.Get("segment_size").(int))
+		err = objectStorageObjectV1CreateLargeObject(objectStorageClient, cn, name, kind, segmentSize, *createOpts)
+		if err != nil {
+			return diag.Errorf("Error creating OpenStack container large object: %s", err)
+		}
+
+		// Store the ID now
+		d.SetId(fmt.Sprintf("%s/%s", cn, name))
+
+		return resourceObjectStorageObjectV1Read(ctx, d, meta)
+	}
+
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 	_, err = objects.Create(objectStorageClient, cn, name, createOpts).Extract()
 	if err != nil {
@@ -261,12 +292,18 @@ func resourceObjectStorageObjectV1Read(ctx context.Context, d *schema.ResourceDa
 	log.Printf("[DEBUG] Get Options: %#v", getOpts)
 	result, err := objects.Get(objectStorageClient, cn, name, getOpts).Extract()
 	if err != nil {
-		return diag.Errorf("Error getting OpenStack container object: %s", err)
+		// An expired object is removed by Swift.
+		return diag.FromErr(CheckDeleted(d, err, "Error getting OpenStack container object"))
 	}
 
 	log.Printf("[DEBUG] Retrieved OpenStack Object Storage Object: %#v", result)
 
-	d.Set("etag", result.ETag)
+	// The ETag of a large object is quoted.
+	if d.Get("large_object").(string) != "" {
+		d.Set("etag", strings.Trim(result.ETag, `"`))
+	} else {
+		d.Set("etag", result.ETag)
+	}
 	d.Set("content_disposition", result.ContentDisposition)
 	d.Set("content_encoding", result.ContentEncoding)
 	d.Set("content_length", result.ContentLength)
@@ -276,6 +313,8 @@ func resourceObjectStorageObjectV1Read(ctx context.Context, d *schema.ResourceDa
 	}
 	if result.DeleteAt.Unix() > 0 {
 		d.Set("delete_at", result.DeleteAt.Format(time.RFC3339))
+	} else {
+		d.Set("delete_at", "")
 	}
 	if result.LastModified.Unix() > 0 {
 		d.Set("last_modified", result.LastModified.Format(time.RFC3339))
@@ -348,17 +387,9 @@ func resourceObjectStorageObjectV1Update(ctx context.Context, d *schema.Resource
 		createOpts.ContentType = v.(string)
 	}
 
-	if v, ok := d.GetOk("delete_after"); ok {
-		createOpts.DeleteAfter = int64(v.(int))
-	}
-
-	if v, ok := d.GetOk("delete_at"); ok && v != "" {
-		t, err := time.Parse(time.RFC3339, fmt.Sprintf("%s", v))
-		if err != nil {
-			return diag.Errorf("Error Parsing Swift Object Lifecycle Expiration Date: %s, %s", err.Error(), v)
-		}
-
-		createOpts.DeleteAt = t.Unix()
+	createOpts.DeleteAfter, createOpts.DeleteAt, err = resourceObjectExpirationV1(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	if v, ok := d.GetOk("detect_content_type"); ok && v.(bool) {
@@ -370,12 +401,116 @@ func resourceObjectStorageObjectV1Update(ctx context.Context, d *schema.Resource
 		createOpts.ETag = d.Get("etag").(string)
 	}
 
-	log.Printf("[DEBUG] Update Options: %#v", createOpts)
-	_, err = objects.Create(objectStorageClient, cn, name, createOpts).Extract()
-	if err != nil {
-		return diag.Errorf("Error updating OpenStack container object: %s", err)
+	// The object headers and metadata are updated without uploading the
+	// content again, when the content hasn't changed.
+	if d.Get("large_object").(string) == "" && !d.HasChanges("source", "content", "copy_from", "object_manifest", "etag") {
+		updateOpts := objectStorageObjectV1UpdateOpts{
+			UpdateOpts: objects.UpdateOpts{
+				Metadata: resourceObjectMetadataV1(d),
+			},
+		}
+
+		if v, ok := d.GetOk("content_disposition"); ok {
+			contentDisposition := v.(string)
+			updateOpts.ContentDisposition = &contentDisposition
+		}
+
+		if v, ok := d.GetOk("content_encoding"); ok {
+			contentEncoding := v.(string)
+			updateOpts.ContentEncoding = &contentEncoding
+		}
+
+		if v, ok := d.GetOk("content_type"); ok {
+			contentType := v.(string)
+			updateOpts.ContentType = &contentType
+		}
+
+		if createOpts.DeleteAfter > 0 {
+			updateOpts.DeleteAfter = &createOpts.DeleteAfter
+		}
+
+		if createOpts.DeleteAt > 0 {
+			updateOpts.DeleteAt = &createOpts.DeleteAt
+		}
+
+		// The expiration of the object is removed, when neither delete_after
+		// nor delete_at are set anymore.
+		if createOpts.DeleteAfter == 0 && createOpts.DeleteAt == 0 && d.HasChanges("delete_after", "delete_at") {
+			updateOpts.RemoveDeleteAt = true
+		}
+
+		if v, ok := d.GetOk("detect_content_type"); ok && v.(bool) {
+			detectContentType := true
+			updateOpts.DetectContentType = &detectContentType
+		}
+
+		log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+		_, err = objects.Update(objectStorageClient, cn, name, updateOpts).Extract()
+		if err != nil {
+			return diag.FromErr(CheckDeleted(d, err, "Error updating OpenStack container object"))
+		}
+
+		return resourceObjectStorageObjectV1Read(ctx, d, meta)
+	}
+
+	// Keep the segments of the previous large object, so they can be removed
+	// once the content has been replaced.
+	var oldSegments []objectStorageObjectV1Segment
+	contentChanged := d.HasChanges("source", "content", "copy_from", "object_manifest", "etag", "large_object", "segment_size")
+	if oldKind, _ := d.GetChange("large_object"); oldKind.(string) != "" && contentChanged {
+		lo, err := objectStorageObjectV1GetLargeObject(objectStorageClient, cn, name)
+		if err != nil {
+			return diag.Errorf("Error getting OpenStack container large object: %s", err)
+		}
+		if lo != nil {
+			oldSegments = lo.Segments
+		}
+	}
+
+	if kind := d.Get("large_object").(string); kind != "" {
+		if contentChanged {
+			// The full content must be uploaded again, even if only
+			// its checksum has changed.
+			if v, ok := d.GetOk("source"); ok {
+				file, size, err := resourceObjectSourceV1(v.(string))
+				if err != nil {
+					return diag.FromErr(err)
+				}
+
+				createOpts.Content = file
+				createOpts.ContentLength = size
+				defer file.Close()
+			} else {
+				v := d.Get("content").(string)
+				createOpts.Content = bytes.NewReader([]byte(v))
+				createOpts.ContentLength = int64(len(v))
+			}
+
+			segmentSize := objectStorageObjectV1SegmentSize(d.Get("segment_size").(int))
+			err = objectStorageObjectV1CreateLargeObject(objectStorageClient, cn, name, kind, segmentSize, *createOpts)
+		} else {
+			var lo *objectStorageObjectV1LargeObject
+			lo, err = objectStorageObjectV1GetLargeObject(objectStorageClient, cn, name)
+			if err == nil && lo == nil {
+				err = fmt.Errorf("%s/%s is not a large object", cn, name)
+			}
+			if err == nil {
+				err = objectStorageObjectV1PutManifest(objectStorageClient, cn, name, lo, *createOpts)
+			}
+		}
+		if err != nil {
+			return diag.Errorf("Error updating OpenStack container large object: %s", err)
+		}
+	} else {
+		log.Printf("[DEBUG] Update Options: %#v", createOpts)
+		_, err = objects.Create(objectStorageClient, cn, name, createOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error updating OpenStack container object: %s", err)
+		}
 	}
 
+	objectStorageObjectV1DeleteSegments(objectStorageClient, oldSegments)
+
 	return resourceObjectStorageObjectV1Read(ctx, d, meta)
 }
 
@@ -390,10 +525,79 @@ func resourceObjectStorageObjectV1Delete(ctx context.Context, d *schema.Resource
 	cn := d.Get("container_name").(string)
 	deleteOpts := &objects.DeleteOpts{}
 
+	// The segments of a large object are removed after its manifest.
+	var segments []objectStorageObjectV1Segment
+	if d.Get("large_object").(string) != "" {
+		lo, err := objectStorageObjectV1GetLargeObject(objectStorageClient, cn, name)
+		if err != nil {
+			return diag.Errorf("Error getting OpenStack container large object: %s", err)
+		}
+		if lo != nil {
+			segments = lo.Segments
+		}
+	}
+
 	_, err = objects.Delete(objectStorageClient, cn, name, deleteOpts).Extract()
 	if err != nil {
-		return diag.Errorf("Error getting OpenStack container object: %s", err)
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting OpenStack container object"))
+	}
+
+	objectStorageObjectV1DeleteSegments(objectStorageClient, segments)
+
+	return nil
+}
+
+// resourceObjectStorageObjectV1CustomizeDiff plans the removal of the object
+// expiration and computes the checksum of the large object content, so that
+// changes of the source file are detected.
+func resourceObjectStorageObjectV1CustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	// delete_at is computed, so its removal from the configuration must be
+	// planned explicitly.
+	if diff.Id() != "" && diff.Get("delete_at").(string) != "" {
+		_, deleteAfter := diff.GetOk("delete_after")
+		if !deleteAfter && !resourceObjectDeleteAtConfiguredV1(diff.GetRawConfig()) {
+			if err := diff.SetNew("delete_at", ""); err != nil {
+				return err
+			}
+		}
+	}
+
+	if diff.Get("large_object").(string) == "" {
+		return nil
+	}
+
+	if !diff.NewValueKnown("source") || !diff.NewValueKnown("content") || !diff.NewValueKnown("segment_size") {
+		return nil
+	}
+
+	var r io.ReaderAt
+	var size int64
+	if v, ok := diff.GetOk("source"); ok {
+		file, fileSize, err := resourceObjectSourceV1(v.(string))
+		if err != nil {
+			// The source may be created during the apply.
+			log.Printf("[DEBUG] Unable to compute the large object checksum: %s", err)
+			return nil
+		}
+		defer file.Close()
+
+		r = file
+		size = fileSize
+	} else {
+		content := diff.Get("content").(string)
+		r = strings.NewReader(content)
+		size = int64(len(content))
+	}
+
+	etag, err := objectStorageObjectV1LargeObjectETag(r, size, objectStorageObjectV1SegmentSize(diff.Get("segment_size").(int)))
+	if err != nil {
+		return fmt.Errorf("Error computing the large object checksum: %s", err)
 	}
+
+	if diff.Get("etag").(string) != etag {
+		return diff.SetNew("etag", etag)
+	}
+
 	return nil
 }
 
@@ -405,6 +609,36 @@ func resourceObjectMetadataV1(d *schema.ResourceData) map[string]string {
 	return m
 }
 
+// resourceObjectExpirationV1 returns the X-Delete-After and X-Delete-At
+// headers of the object. The delete_at value computed by Swift is ignored,
+// unless delete_at is set in the configuration.
+func resourceObjectExpirationV1(d *schema.ResourceData) (int64, int64, error) {
+	if v, ok := d.GetOk("delete_after"); ok {
+		return int64(v.(int)), 0, nil
+	}
+
+	if v := d.Get("delete_at").(string); v != "" && resourceObjectDeleteAtConfiguredV1(d.GetRawConfig()) {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return 0, 0, fmt.Errorf("Error Parsing Swift Object Lifecycle Expiration Date: %s, %s", err.Error(), v)
+		}
+
+		return 0, t.Unix(), nil
+	}
+
+	return 0, 0, nil
+}
+
+// resourceObjectDeleteAtConfiguredV1 reports whether delete_at is set in the
+// configuration, as opposed to being only computed by Swift from delete_after.
+func resourceObjectDeleteAtConfiguredV1(rawConfig cty.Value) bool {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return false
+	}
+
+	return !rawConfig.GetAttr("delete_at").IsNull()
+}
+
 func resourceObjectSourceV1(source string) (*os.File, int64, error) {
 	path, err := homedir.Expand(source)
 	if err != nil {
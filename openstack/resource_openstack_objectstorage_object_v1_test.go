@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/containers"
 	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
 )
 
@@ -75,6 +77,10 @@ func TestAccObjectStorageV1Object_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(
 						"openstack_objectstorage_object_v1.myfile", "content_type", "application/octet-stream"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_v1.myfile", "content_length", "3"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_v1.myfile", "etag", fooMD5()),
 				),
 			},
 			{
@@ -141,6 +147,81 @@ func TestAccObjectStorageV1Object_fromSource(t *testing.T) {
 	})
 }
 
+func TestAccObjectStorageV1Object_largeObject(t *testing.T) {
+	segmentSize := int64(1024 * 1024)
+	content := []byte(strings.Repeat("foo", 1024*1024))
+	tmpfile, err := ioutil.TempFile("", "tf_test_objectstorage_object")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer os.Remove(tmpfile.Name())
+	if _, err := tmpfile.Write(content); err != nil {
+		log.Fatal(err)
+	}
+	if err := tmpfile.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	etag, err := objectStorageObjectV1LargeObjectETag(strings.NewReader(string(content)), int64(len(content)), segmentSize)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	updatedContent := []byte(strings.Repeat("bar", 512*1024))
+	updatedEtag, err := objectStorageObjectV1LargeObjectETag(strings.NewReader(string(updatedContent)), int64(len(updatedContent)), segmentSize)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, kind := range []string{"slo", "dlo"} {
+		t.Run(kind, func(t *testing.T) {
+			if err := ioutil.WriteFile(tmpfile.Name(), content, 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testAccPreCheckNonAdminOnly(t)
+					testAccPreCheckSwift(t)
+				},
+				ProviderFactories: testAccProviders,
+				CheckDestroy: func(s *terraform.State) error {
+					if err := testAccCheckObjectStorageV1ObjectDestroy(s, "terraform/test/myfile.bin"); err != nil {
+						return err
+					}
+					return testAccCheckObjectStorageV1ObjectSegmentsDestroy(s, "tf_test_container_1", "terraform/test/myfile.bin/")
+				},
+				Steps: []resource.TestStep{
+					{
+						Config: testAccObjectStorageV1ObjectLargeObject(tmpfile.Name(), kind, segmentSize),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(
+								"openstack_objectstorage_object_v1.myfile", "content_length", fmt.Sprintf("%v", len(content))),
+							resource.TestCheckResourceAttr(
+								"openstack_objectstorage_object_v1.myfile", "etag", etag),
+						),
+					},
+					{
+						PreConfig: func() {
+							if err := ioutil.WriteFile(tmpfile.Name(), updatedContent, 0600); err != nil {
+								t.Fatal(err)
+							}
+						},
+						Config: testAccObjectStorageV1ObjectLargeObject(tmpfile.Name(), kind, segmentSize),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(
+								"openstack_objectstorage_object_v1.myfile", "content_length", fmt.Sprintf("%v", len(updatedContent))),
+							resource.TestCheckResourceAttr(
+								"openstack_objectstorage_object_v1.myfile", "etag", updatedEtag),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
 func TestAccObjectStorageV1Object_detectContentType(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -253,6 +334,41 @@ func testAccCheckObjectStorageV1ObjectDestroy(s *terraform.State, objectname str
 	return nil
 }
 
+func testAccCheckObjectStorageV1ObjectSegmentsDestroy(s *terraform.State, containerName, prefix string) error {
+	config := testAccProvider.Meta().(*Config)
+	objectStorageClient, err := config.ObjectStorageV1Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	segmentContainer := objectStorageObjectV1SegmentContainer(containerName)
+	allPages, err := objects.List(objectStorageClient, segmentContainer, &objects.ListOpts{Prefix: prefix}).AllPages()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			return nil
+		}
+		return err
+	}
+
+	names, err := objects.ExtractNames(allPages)
+	if err != nil {
+		return err
+	}
+
+	if len(names) > 0 {
+		return fmt.Errorf("Large object segments still exist: %v", names)
+	}
+
+	_, err = containers.Delete(objectStorageClient, segmentContainer).Extract()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); !ok {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func testAccCheckObjectStorageV1ObjectExists(n string, object *objects.GetHeader) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -447,3 +563,20 @@ resource "openstack_objectstorage_object_v1" "myfile" {
   }
 }
 `
+
+func testAccObjectStorageV1ObjectLargeObject(source, kind string, segmentSize int64) string {
+	return fmt.Sprintf(`
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name = "tf_test_container_1"
+}
+
+resource "openstack_objectstorage_object_v1" "myfile" {
+  name = "terraform/test/myfile.bin"
+  container_name = "${openstack_objectstorage_container_v1.container_1.name}"
+  content_type = "application/octet-stream"
+  source = "%s"
+  large_object = "%s"
+  segment_size = %d
+}
+`, source, kind, segmentSize)
+}
@@ -0,0 +1,216 @@
+package openstack
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
+)
+
+func resourceObjectStorageSymlinkV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceObjectStorageSymlinkV1Create,
+		ReadContext:   resourceObjectStorageSymlinkV1Read,
+		UpdateContext: resourceObjectStorageSymlinkV1Update,
+		DeleteContext: resourceObjectStorageSymlinkV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceObjectStorageSymlinkV1Import,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"container_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"target_container": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"target_object": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"target_account": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"content_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"last_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceObjectStorageSymlinkV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	objectStorageClient, err := config.ObjectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	cn := d.Get("container_name").(string)
+	name := d.Get("name").(string)
+
+	if err := resourceObjectStorageSymlinkV1Put(d, objectStorageClient, cn, name); err != nil {
+		return diag.Errorf("Error creating openstack_objectstorage_symlink_v1 %s/%s: %s", cn, name, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", cn, name))
+
+	return resourceObjectStorageSymlinkV1Read(ctx, d, meta)
+}
+
+func resourceObjectStorageSymlinkV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	objectStorageClient, err := config.ObjectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	cn := d.Get("container_name").(string)
+	name := d.Get("name").(string)
+
+	res := objects.Get(objectStorageClient, cn, name, objectStorageSymlinkV1GetOpts{})
+	header, err := res.Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_objectstorage_symlink_v1"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_objectstorage_symlink_v1 %s: %#v", d.Id(), header)
+
+	target := res.Header.Get("X-Symlink-Target")
+	if target == "" {
+		return diag.Errorf("Error retrieving openstack_objectstorage_symlink_v1 %s: the object is not a symlink", d.Id())
+	}
+
+	targetContainer, targetObject, err := objectStorageSymlinkV1ParseTarget(target)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	targetAccount, err := url.PathUnescape(res.Header.Get("X-Symlink-Target-Account"))
+	if err != nil {
+		return diag.Errorf("Invalid OpenStack symlink target account %s: %s", res.Header.Get("X-Symlink-Target-Account"), err)
+	}
+
+	d.Set("target_container", targetContainer)
+	d.Set("target_object", targetObject)
+	d.Set("target_account", targetAccount)
+	d.Set("content_type", header.ContentType)
+	if header.LastModified.Unix() > 0 {
+		d.Set("last_modified", header.LastModified.Format(time.RFC3339))
+	}
+
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+// resourceObjectStorageSymlinkV1Update overwrites the symlink, since its target
+// can't be updated with a POST request.
+func resourceObjectStorageSymlinkV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	objectStorageClient, err := config.ObjectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	cn := d.Get("container_name").(string)
+	name := d.Get("name").(string)
+
+	if err := resourceObjectStorageSymlinkV1Put(d, objectStorageClient, cn, name); err != nil {
+		return diag.Errorf("Error updating openstack_objectstorage_symlink_v1 %s: %s", d.Id(), err)
+	}
+
+	return resourceObjectStorageSymlinkV1Read(ctx, d, meta)
+}
+
+func resourceObjectStorageSymlinkV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	objectStorageClient, err := config.ObjectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	cn := d.Get("container_name").(string)
+	name := d.Get("name").(string)
+
+	// Deleting a symlink doesn't delete its target.
+	_, err = objects.Delete(objectStorageClient, cn, name, nil).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_objectstorage_symlink_v1"))
+	}
+
+	return nil
+}
+
+func resourceObjectStorageSymlinkV1Import(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Invalid format specified for openstack_objectstorage_symlink_v1. Format must be <container_name>/<name>")
+	}
+
+	d.Set("container_name", parts[0])
+	d.Set("name", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceObjectStorageSymlinkV1Put(d *schema.ResourceData, client *gophercloud.ServiceClient, cn, name string) error {
+	createOpts := objectStorageSymlinkV1CreateOpts{
+		CreateOpts: objects.CreateOpts{
+			Content:     bytes.NewReader([]byte("")),
+			ContentType: d.Get("content_type").(string),
+			Metadata:    resourceObjectMetadataV1(d),
+			NoETag:      true,
+		},
+		TargetContainer: d.Get("target_container").(string),
+		TargetObject:    d.Get("target_object").(string),
+		TargetAccount:   d.Get("target_account").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_objectstorage_symlink_v1 %s/%s create options: %#v", cn, name, createOpts)
+
+	_, err := objects.Create(client, cn, name, createOpts).Extract()
+
+	return err
+}
@@ -0,0 +1,99 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
+)
+
+func TestAccObjectStorageV1Symlink_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSwift(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckObjectStorageV1SymlinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectStorageV1SymlinkBasic("v1.txt"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_symlink_v1.latest", "target_container", "tf_test_container_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_symlink_v1.latest", "target_object", "releases/v1.txt"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_symlink_v1.latest", "target_account", ""),
+					resource.TestCheckResourceAttrSet(
+						"openstack_objectstorage_symlink_v1.latest", "content_type"),
+				),
+			},
+			{
+				Config: testAccObjectStorageV1SymlinkBasic("v2.txt"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_symlink_v1.latest", "target_object", "releases/v2.txt"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckObjectStorageV1SymlinkDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	objectStorageClient, err := config.ObjectStorageV1Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_objectstorage_symlink_v1" {
+			continue
+		}
+
+		_, err := objects.Get(objectStorageClient, rs.Primary.Attributes["container_name"], rs.Primary.Attributes["name"], objectStorageSymlinkV1GetOpts{}).Extract()
+		if err == nil {
+			return fmt.Errorf("Symlink still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccObjectStorageV1SymlinkBasic(target string) string {
+	return fmt.Sprintf(`
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name          = "tf_test_container_1"
+  force_destroy = true
+}
+
+resource "openstack_objectstorage_object_v1" "v1" {
+  name           = "releases/v1.txt"
+  container_name = "${openstack_objectstorage_container_v1.container_1.name}"
+  content        = "v1"
+}
+
+resource "openstack_objectstorage_object_v1" "v2" {
+  name           = "releases/v2.txt"
+  container_name = "${openstack_objectstorage_container_v1.container_1.name}"
+  content        = "v2"
+}
+
+resource "openstack_objectstorage_symlink_v1" "latest" {
+  name             = "releases/latest.txt"
+  container_name   = "${openstack_objectstorage_container_v1.container_1.name}"
+  target_container = "${openstack_objectstorage_container_v1.container_1.name}"
+  target_object    = "releases/%s"
+
+  depends_on = [
+    "openstack_objectstorage_object_v1.v1",
+    "openstack_objectstorage_object_v1.v2",
+  ]
+}
+`, target)
+}
@@ -12,8 +12,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-
-	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceObjectstorageTempurlV1() *schema.Resource {
@@ -69,6 +68,13 @@ func resourceObjectstorageTempurlV1() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"digest": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"sha1", "sha256", "sha512"}, false),
+			},
+
 			"regenerate": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -92,20 +98,11 @@ func resourceObjectstorageTempurlV1Create(ctx context.Context, d *schema.Resourc
 		return diag.Errorf("Error creating OpenStack compute client: %s", err)
 	}
 
-	method := objects.GET
-	switch d.Get("method") {
-	case "post":
-		method = objects.POST
-		// gophercloud doesn't have support for PUT yet,
-		// although it's a valid method for swift
-		//case "put":
-		//	method = objects.PUT
-	}
-
-	turlOptions := objects.CreateTempURLOpts{
-		Method: method,
+	turlOptions := objectStorageTempurlV1Opts{
+		Method: d.Get("method").(string),
 		TTL:    d.Get("ttl").(int),
 		Split:  d.Get("split").(string),
+		Digest: d.Get("digest").(string),
 	}
 
 	containerName := d.Get("container").(string)
@@ -113,7 +110,7 @@ func resourceObjectstorageTempurlV1Create(ctx context.Context, d *schema.Resourc
 
 	log.Printf("[DEBUG] Create temporary url Options: %#v", turlOptions)
 
-	url, err := objects.CreateTempURL(objectStorageClient, containerName, objectName, turlOptions)
+	url, _, err := objectStorageTempurlV1Create(objectStorageClient, containerName, objectName, turlOptions)
 	if err != nil {
 		return diag.Errorf("Unable to generate a temporary url for the object %s in container %s: %s",
 			objectName, containerName, err)
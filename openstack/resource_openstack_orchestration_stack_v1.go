@@ -2,7 +2,9 @@ package openstack
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"reflect"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -22,6 +24,8 @@ func resourceOrchestrationStackV1() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceOrchestrationStackV1CustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
 			Update: schema.DefaultTimeout(60 * time.Minute),
@@ -57,6 +61,18 @@ func resourceOrchestrationStackV1() *schema.Resource {
 				Optional: true,
 			},
 
+			"files": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"resolved_files": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
 			"parameters": {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -168,9 +184,11 @@ func resourceOrchestrationStackV1Create(ctx context.Context, d *schema.ResourceD
 	if err != nil {
 		return diag.Errorf("Error creating OpenStack Orchestration client: %s", err)
 	}
-	createOpts := &stacks.CreateOpts{
-		Name:         d.Get("name").(string),
-		TemplateOpts: buildTemplateOpts(d),
+	createOpts := &orchestrationStackV1CreateOpts{
+		CreateOpts: stacks.CreateOpts{
+			Name:         d.Get("name").(string),
+			TemplateOpts: buildTemplateOpts(d),
+		},
 	}
 	if d.Get("disable_rollback") != nil {
 		disableRollback := d.Get("disable_rollback").(bool)
@@ -195,6 +213,11 @@ func resourceOrchestrationStackV1Create(ctx context.Context, d *schema.ResourceD
 		createOpts.Timeout = d.Get("timeout").(int)
 	}
 
+	createOpts.Files, err = resourceOrchestrationStackV1Files(d, createOpts.TemplateOpts, createOpts.EnvironmentOpts)
+	if err != nil {
+		return diag.Errorf("Error resolving openstack_orchestration_stack_v1 files: %s", err)
+	}
+
 	log.Printf("[DEBUG] Creating openstack_orchestration_stack_v1")
 	stack, err := stacks.Create(orchestrationClient, createOpts).Extract()
 	if err != nil {
@@ -211,14 +234,20 @@ func resourceOrchestrationStackV1Create(ctx context.Context, d *schema.ResourceD
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForStateContext(ctx)
+	// Store the ID now, so that a failed stack is tainted.
+	d.SetId(stack.ID)
+
+	v, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
+		// Keep the failure detail in the state.
+		if s, ok := v.(*stacks.RetrievedStack); ok && s != nil {
+			d.Set("status", s.Status)
+			d.Set("status_reason", orchestrationStackV1StatusReason(orchestrationClient, s))
+		}
 		return diag.Errorf(
 			"Error waiting for openstack_orchestration_stack_v1 %s to become ready: %s", stack.ID, err)
 	}
 
-	// Store the ID now
-	d.SetId(stack.ID)
 	log.Printf("[INFO] openstack_orchestration_stack_v1 %s create complete", stack.ID)
 
 	return resourceOrchestrationStackV1Read(ctx, d, meta)
@@ -243,7 +272,7 @@ func resourceOrchestrationStackV1Read(ctx context.Context, d *schema.ResourceDat
 	d.Set("disable_rollback", stack.DisableRollback)
 	d.Set("notification_topics", stack.NotificationTopics)
 	d.Set("status", stack.Status)
-	d.Set("status_reason", stack.StatusReason)
+	d.Set("status_reason", orchestrationStackV1StatusReason(orchestrationClient, stack))
 	d.Set("template_description", stack.TemplateDescription)
 	d.Set("timeout", stack.Timeout)
 
@@ -301,8 +330,10 @@ func resourceOrchestrationStackV1Update(ctx context.Context, d *schema.ResourceD
 		return diag.Errorf("Error creating OpenStack Orchestration client: %s", err)
 	}
 
-	updateOpts := &stacks.UpdateOpts{
-		TemplateOpts: buildTemplateOpts(d),
+	updateOpts := &orchestrationStackV1UpdateOpts{
+		UpdateOpts: stacks.UpdateOpts{
+			TemplateOpts: buildTemplateOpts(d),
+		},
 	}
 	env := buildEnvironmentOpts(d)
 	if env != nil {
@@ -323,6 +354,13 @@ func resourceOrchestrationStackV1Update(ctx context.Context, d *schema.ResourceD
 		updateOpts.Tags = tags
 	}
 
+	// The files are always sent, so that the changes of the nested templates
+	// are applied.
+	updateOpts.Files, err = resourceOrchestrationStackV1Files(d, updateOpts.TemplateOpts, updateOpts.EnvironmentOpts)
+	if err != nil {
+		return diag.Errorf("Error resolving openstack_orchestration_stack_v1 files: %s", err)
+	}
+
 	stack, err := stacks.Find(orchestrationClient, d.Id()).Extract()
 	if err != nil {
 		return diag.Errorf("Error retrieving openstack_orchestration_stack_v1 %s before Update:  %s", d.Id(), err)
@@ -375,3 +413,58 @@ func resourceOrchestrationStackV1Delete(ctx context.Context, d *schema.ResourceD
 	log.Printf("[INFO] openstack_orchestration_stack_v1 %s delete complete", d.Id())
 	return nil
 }
+
+// resourceOrchestrationStackV1CustomizeDiff resolves the files referenced by
+// the template and environment, so that the changes of the nested templates
+// are detected.
+func resourceOrchestrationStackV1CustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for _, k := range []string{"template_opts", "environment_opts", "files"} {
+		if !diff.NewValueKnown(k) {
+			return diff.SetNewComputed("resolved_files")
+		}
+	}
+
+	template := &stacks.Template{
+		TE: buildTE(diff.Get("template_opts").(map[string]interface{})),
+	}
+
+	var environment *stacks.Environment
+	if v := diff.Get("environment_opts").(map[string]interface{}); len(v) > 0 {
+		environment = &stacks.Environment{
+			TE: buildTE(v),
+		}
+	}
+
+	resolvedFiles, err := orchestrationStackV1Files(template, environment, expandToMapStringString(diff.Get("files").(map[string]interface{})))
+	if err != nil {
+		return fmt.Errorf("Error resolving openstack_orchestration_stack_v1 files: %s", err)
+	}
+
+	if !reflect.DeepEqual(resolvedFiles, expandToMapStringString(diff.Get("resolved_files").(map[string]interface{}))) {
+		return diff.SetNew("resolved_files", resolvedFiles)
+	}
+
+	return nil
+}
+
+// resourceOrchestrationStackV1Files returns the files section of a stack,
+// made of the resolved files and the files provided by the user.
+func resourceOrchestrationStackV1Files(d *schema.ResourceData, template *stacks.Template, environment *stacks.Environment) (map[string]string, error) {
+	userFiles := expandToMapStringString(d.Get("files").(map[string]interface{}))
+
+	resolvedFiles, err := orchestrationStackV1Files(template, environment, userFiles)
+	if err != nil {
+		return nil, err
+	}
+	d.Set("resolved_files", resolvedFiles)
+
+	files := make(map[string]string, len(resolvedFiles)+len(userFiles))
+	for k, v := range resolvedFiles {
+		files[k] = v
+	}
+	for k, v := range userFiles {
+		files[k] = v
+	}
+
+	return files, nil
+}
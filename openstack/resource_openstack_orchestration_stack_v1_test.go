@@ -136,6 +136,37 @@ func TestAccOrchestrationV1Stack_outputs(t *testing.T) {
 	})
 }
 
+func TestAccOrchestrationV1Stack_files(t *testing.T) {
+	var stack stacks.RetrievedStack
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckOrchestrationV1StackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrchestrationV1StackFiles("foo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrchestrationV1StackExists("openstack_orchestration_stack_v1.stack_6", &stack),
+					resource.TestCheckResourceAttr("openstack_orchestration_stack_v1.stack_6", "files.value.txt", "foo"),
+					resource.TestCheckResourceAttr("openstack_orchestration_stack_v1.stack_6", "outputs.0.output_value", "foo"),
+				),
+			},
+			{
+				Config: testAccOrchestrationV1StackFiles("bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrchestrationV1StackExists("openstack_orchestration_stack_v1.stack_6", &stack),
+					resource.TestCheckResourceAttr("openstack_orchestration_stack_v1.stack_6", "files.value.txt", "bar"),
+					resource.TestCheckResourceAttr("openstack_orchestration_stack_v1.stack_6", "outputs.0.output_value", "bar"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckOrchestrationV1StackDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	orchestrationClient, err := config.OrchestrationV1Client(osRegionName)
@@ -296,3 +327,18 @@ resource "openstack_orchestration_stack_v1" "stack_5" {
   disable_rollback = true
 }
 `
+
+func testAccOrchestrationV1StackFiles(value string) string {
+	return fmt.Sprintf(`
+resource "openstack_orchestration_stack_v1" "stack_6" {
+  name = "stack_6"
+  template_opts = {
+	Bin = "heat_template_version: 2016-10-14\nresources:\n  test_res:\n    type: OS::Heat::TestResource\n    properties:\n      value: {get_file: value.txt}\noutputs:\n  value:\n    value: {get_attr: [test_res, output]}\n"
+  }
+  files = {
+    "value.txt" = "%s"
+  }
+  disable_rollback = true
+}
+`, value)
+}
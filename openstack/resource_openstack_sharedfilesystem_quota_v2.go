@@ -0,0 +1,280 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSharedFilesystemQuotaV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSharedFilesystemQuotaV2Create,
+		ReadContext:   resourceSharedFilesystemQuotaV2Read,
+		UpdateContext: resourceSharedFilesystemQuotaV2Update,
+		DeleteContext: resourceSharedFilesystemQuotaV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"share_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"shares": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+
+			"gigabytes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+
+			"snapshots": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+
+			"snapshot_gigabytes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+
+			"share_networks": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+
+			"share_groups": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+
+			"share_group_snapshots": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+		},
+	}
+}
+
+func resourceSharedFilesystemQuotaV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2QuotaMicroversion
+
+	projectID := d.Get("project_id").(string)
+	shareType := d.Get("share_type").(string)
+
+	var updateOpts sharedFilesystemQuotaV2UpdateOpts
+
+	if v, ok := d.GetOkExists("shares"); ok {
+		shares := v.(int)
+		updateOpts.Shares = &shares
+	}
+
+	if v, ok := d.GetOkExists("gigabytes"); ok {
+		gigabytes := v.(int)
+		updateOpts.Gigabytes = &gigabytes
+	}
+
+	if v, ok := d.GetOkExists("snapshots"); ok {
+		snapshots := v.(int)
+		updateOpts.Snapshots = &snapshots
+	}
+
+	if v, ok := d.GetOkExists("snapshot_gigabytes"); ok {
+		snapshotGigabytes := v.(int)
+		updateOpts.SnapshotGigabytes = &snapshotGigabytes
+	}
+
+	if v, ok := d.GetOkExists("share_networks"); ok {
+		shareNetworks := v.(int)
+		updateOpts.ShareNetworks = &shareNetworks
+	}
+
+	if v, ok := d.GetOkExists("share_groups"); ok {
+		shareGroups := v.(int)
+		updateOpts.ShareGroups = &shareGroups
+	}
+
+	if v, ok := d.GetOkExists("share_group_snapshots"); ok {
+		shareGroupSnapshots := v.(int)
+		updateOpts.ShareGroupSnapshots = &shareGroupSnapshots
+	}
+
+	log.Printf("[DEBUG] openstack_sharedfilesystem_quota_v2 create options: %#v", updateOpts)
+
+	q, err := sharedFilesystemQuotaV2Update(sfsClient, projectID, shareType, updateOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating openstack_sharedfilesystem_quota_v2: %s", err)
+	}
+
+	id := projectID
+	if shareType != "" {
+		id += "/" + shareType
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Created openstack_sharedfilesystem_quota_v2 %#v", q)
+
+	return resourceSharedFilesystemQuotaV2Read(ctx, d, meta)
+}
+
+func resourceSharedFilesystemQuotaV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	sfsClient, err := config.SharedfilesystemV2Client(region)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2QuotaMicroversion
+
+	// Parse the project_id and the share_type from the resource id that is
+	// either <project_id> or <project_id>/<share_type>.
+	projectID, shareType := parseSharedFilesystemQuotaV2ID(d.Id())
+
+	q, err := sharedFilesystemQuotaV2Get(sfsClient, projectID, shareType).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_sharedfilesystem_quota_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_sharedfilesystem_quota_v2 %s: %#v", d.Id(), q)
+
+	d.Set("project_id", projectID)
+	d.Set("share_type", shareType)
+	d.Set("region", region)
+	d.Set("shares", q.Shares)
+	d.Set("gigabytes", q.Gigabytes)
+	d.Set("snapshots", q.Snapshots)
+	d.Set("snapshot_gigabytes", q.SnapshotGigabytes)
+	d.Set("share_networks", q.ShareNetworks)
+	d.Set("share_groups", q.ShareGroups)
+	d.Set("share_group_snapshots", q.ShareGroupSnapshots)
+
+	return nil
+}
+
+func resourceSharedFilesystemQuotaV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2QuotaMicroversion
+
+	var (
+		hasChange  bool
+		updateOpts sharedFilesystemQuotaV2UpdateOpts
+	)
+
+	if d.HasChange("shares") {
+		hasChange = true
+		shares := d.Get("shares").(int)
+		updateOpts.Shares = &shares
+	}
+
+	if d.HasChange("gigabytes") {
+		hasChange = true
+		gigabytes := d.Get("gigabytes").(int)
+		updateOpts.Gigabytes = &gigabytes
+	}
+
+	if d.HasChange("snapshots") {
+		hasChange = true
+		snapshots := d.Get("snapshots").(int)
+		updateOpts.Snapshots = &snapshots
+	}
+
+	if d.HasChange("snapshot_gigabytes") {
+		hasChange = true
+		snapshotGigabytes := d.Get("snapshot_gigabytes").(int)
+		updateOpts.SnapshotGigabytes = &snapshotGigabytes
+	}
+
+	if d.HasChange("share_networks") {
+		hasChange = true
+		shareNetworks := d.Get("share_networks").(int)
+		updateOpts.ShareNetworks = &shareNetworks
+	}
+
+	if d.HasChange("share_groups") {
+		hasChange = true
+		shareGroups := d.Get("share_groups").(int)
+		updateOpts.ShareGroups = &shareGroups
+	}
+
+	if d.HasChange("share_group_snapshots") {
+		hasChange = true
+		shareGroupSnapshots := d.Get("share_group_snapshots").(int)
+		updateOpts.ShareGroupSnapshots = &shareGroupSnapshots
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] openstack_sharedfilesystem_quota_v2 %s update options: %#v", d.Id(), updateOpts)
+		projectID, shareType := parseSharedFilesystemQuotaV2ID(d.Id())
+		_, err = sharedFilesystemQuotaV2Update(sfsClient, projectID, shareType, updateOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error updating openstack_sharedfilesystem_quota_v2: %s", err)
+		}
+	}
+
+	return resourceSharedFilesystemQuotaV2Read(ctx, d, meta)
+}
+
+func resourceSharedFilesystemQuotaV2Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2QuotaMicroversion
+
+	// Deleting the quota resets it to the default values.
+	projectID, shareType := parseSharedFilesystemQuotaV2ID(d.Id())
+	if err := sharedFilesystemQuotaV2Delete(sfsClient, projectID, shareType).ExtractErr(); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_sharedfilesystem_quota_v2"))
+	}
+
+	return nil
+}
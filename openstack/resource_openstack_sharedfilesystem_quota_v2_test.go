@@ -0,0 +1,117 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/projects"
+)
+
+func TestAccSFSV2Quota_basic(t *testing.T) {
+	var project projects.Project
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckSFS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckIdentityV3ProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSFSV2QuotaBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3ProjectExists("openstack_identity_project_v3.project_1", &project),
+					resource.TestCheckResourceAttr(
+						"openstack_sharedfilesystem_quota_v2.quota_1", "shares", "10"),
+					resource.TestCheckResourceAttr(
+						"openstack_sharedfilesystem_quota_v2.quota_1", "gigabytes", "100"),
+					resource.TestCheckResourceAttr(
+						"openstack_sharedfilesystem_quota_v2.quota_1", "snapshots", "20"),
+					resource.TestCheckResourceAttr(
+						"openstack_sharedfilesystem_quota_v2.quota_1", "snapshot_gigabytes", "200"),
+					resource.TestCheckResourceAttr(
+						"openstack_sharedfilesystem_quota_v2.quota_1", "share_networks", "5"),
+					resource.TestCheckResourceAttr(
+						"openstack_sharedfilesystem_quota_v2.quota_1", "share_groups", "5"),
+					resource.TestCheckResourceAttr(
+						"openstack_sharedfilesystem_quota_v2.quota_2", "share_type", "dhss_false"),
+					resource.TestCheckResourceAttr(
+						"openstack_sharedfilesystem_quota_v2.quota_2", "shares", "5"),
+					resource.TestCheckResourceAttr(
+						"openstack_sharedfilesystem_quota_v2.quota_2", "gigabytes", "50"),
+				),
+			},
+			{
+				Config: testAccSFSV2QuotaUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3ProjectExists("openstack_identity_project_v3.project_1", &project),
+					resource.TestCheckResourceAttr(
+						"openstack_sharedfilesystem_quota_v2.quota_1", "shares", "20"),
+					resource.TestCheckResourceAttr(
+						"openstack_sharedfilesystem_quota_v2.quota_1", "gigabytes", "-1"),
+					resource.TestCheckResourceAttr(
+						"openstack_sharedfilesystem_quota_v2.quota_1", "snapshots", "40"),
+					resource.TestCheckResourceAttr(
+						"openstack_sharedfilesystem_quota_v2.quota_1", "snapshot_gigabytes", "400"),
+					resource.TestCheckResourceAttr(
+						"openstack_sharedfilesystem_quota_v2.quota_1", "share_networks", "10"),
+					resource.TestCheckResourceAttr(
+						"openstack_sharedfilesystem_quota_v2.quota_1", "share_groups", "10"),
+					resource.TestCheckResourceAttr(
+						"openstack_sharedfilesystem_quota_v2.quota_2", "shares", "-1"),
+					resource.TestCheckResourceAttr(
+						"openstack_sharedfilesystem_quota_v2.quota_2", "gigabytes", "100"),
+				),
+			},
+		},
+	})
+}
+
+const testAccSFSV2QuotaBasic = `
+resource "openstack_identity_project_v3" "project_1" {
+  name = "project_1"
+}
+
+resource "openstack_sharedfilesystem_quota_v2" "quota_1" {
+  project_id         = "${openstack_identity_project_v3.project_1.id}"
+  shares             = 10
+  gigabytes          = 100
+  snapshots          = 20
+  snapshot_gigabytes = 200
+  share_networks     = 5
+  share_groups       = 5
+}
+
+resource "openstack_sharedfilesystem_quota_v2" "quota_2" {
+  project_id = "${openstack_identity_project_v3.project_1.id}"
+  share_type = "dhss_false"
+  shares     = 5
+  gigabytes  = 50
+}
+`
+
+const testAccSFSV2QuotaUpdate = `
+resource "openstack_identity_project_v3" "project_1" {
+  name = "project_1"
+}
+
+resource "openstack_sharedfilesystem_quota_v2" "quota_1" {
+  project_id         = "${openstack_identity_project_v3.project_1.id}"
+  shares             = 20
+  gigabytes          = -1
+  snapshots          = 40
+  snapshot_gigabytes = 400
+  share_networks     = 10
+  share_groups       = 10
+}
+
+resource "openstack_sharedfilesystem_quota_v2" "quota_2" {
+  project_id = "${openstack_identity_project_v3.project_1.id}"
+  share_type = "dhss_false"
+  shares     = -1
+  gigabytes  = 100
+}
+`
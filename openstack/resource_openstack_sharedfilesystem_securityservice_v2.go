@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/gophercloud/gophercloud/openstack/sharedfilesystems/apiversions"
 	"github.com/gophercloud/gophercloud/openstack/sharedfilesystems/v2/securityservices"
 )
 
@@ -235,6 +236,24 @@ func resourceSharedFilesystemSecurityServiceV2Update(ctx context.Context, d *sch
 		updateOpts.Password = &password
 	}
 
+	// Since microversion 2.63 the user and the password of a security service
+	// can be updated even when it is used by a share network with shares.
+	if d.HasChanges("user", "password") {
+		apiInfo, err := apiversions.Get(sfsClient, "v2").Extract()
+		if err != nil {
+			return diag.Errorf("Unable to query API endpoint for openstack_sharedfilesystem_securityservice_v2: %s", err)
+		}
+
+		compatible, err := compatibleMicroversion("min", sharedFilesystemV2SecurityServiceUpdateMicroversion, apiInfo.Version)
+		if err != nil {
+			return diag.Errorf("Error comparing microversions for openstack_sharedfilesystem_securityservice_v2 %s: %s", d.Id(), err)
+		}
+
+		if compatible {
+			sfsClient.Microversion = sharedFilesystemV2SecurityServiceUpdateMicroversion
+		}
+	}
+
 	_, err = securityservices.Update(sfsClient, d.Id(), updateOpts).Extract()
 	if err != nil {
 		return diag.Errorf("Error updating openstack_sharedfilesystem_securityservice_v2 %s: %s", d.Id(), err)
@@ -22,6 +22,7 @@ func resourceSharedFilesystemShareAccessV2() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceSharedFilesystemShareAccessV2Create,
 		ReadContext:   resourceSharedFilesystemShareAccessV2Read,
+		UpdateContext: resourceSharedFilesystemShareAccessV2Update,
 		DeleteContext: resourceSharedFilesystemShareAccessV2Delete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceSharedFilesystemShareAccessV2Import,
@@ -71,6 +72,12 @@ func resourceSharedFilesystemShareAccessV2() *schema.Resource {
 				}, false),
 			},
 
+			"access_metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
 			"access_key": {
 				Type:      schema.TypeString,
 				Computed:  true,
@@ -95,10 +102,21 @@ func resourceSharedFilesystemShareAccessV2Create(ctx context.Context, d *schema.
 
 	shareID := d.Get("share_id").(string)
 
-	grantOpts := shares.GrantAccessOpts{
-		AccessType:  accessType,
-		AccessTo:    d.Get("access_to").(string),
-		AccessLevel: d.Get("access_level").(string),
+	grantOpts := sharedFilesystemShareAccessV2GrantOpts{
+		GrantAccessOpts: shares.GrantAccessOpts{
+			AccessType:  accessType,
+			AccessTo:    d.Get("access_to").(string),
+			AccessLevel: d.Get("access_level").(string),
+		},
+		Metadata: expandToMapStringString(d.Get("access_metadata").(map[string]interface{})),
+	}
+
+	// The access rule metadata is available since 2.45. A copy of the client
+	// is used, since the access list API used to wait for the access rule is
+	// no longer available in 2.45.
+	grantClient := *sfsClient
+	if len(grantOpts.Metadata) > 0 {
+		grantClient.Microversion = sharedFilesystemV2SharedAccessRulesMicroversion
 	}
 
 	log.Printf("[DEBUG] openstack_sharedfilesystem_share_access_v2 create options: %#v", grantOpts)
@@ -107,7 +125,7 @@ func resourceSharedFilesystemShareAccessV2Create(ctx context.Context, d *schema.
 
 	var access *shares.AccessRight
 	err = resource.Retry(timeout, func() *resource.RetryError {
-		access, err = shares.GrantAccess(sfsClient, shareID, grantOpts).Extract()
+		access, err = shares.GrantAccess(&grantClient, shareID, grantOpts).Extract()
 		if err != nil {
 			return checkForRetryableError(err)
 		}
@@ -171,6 +189,32 @@ func resourceSharedFilesystemShareAccessV2Read(ctx context.Context, d *schema.Re
 		sfsClient.Microversion = sharedFilesystemV2SharedAccessMinMicroversion
 	}
 
+	// The access rules API returns the access rule metadata since 2.45.
+	compatible, err = compatibleMicroversion("min", sharedFilesystemV2SharedAccessRulesMicroversion, apiInfo.Version)
+	if err != nil {
+		return diag.Errorf("Error comparing microversions for openstack_sharedfilesystem_share_access_v2 %s: %s", d.Id(), err)
+	}
+
+	if compatible {
+		sfsClient.Microversion = sharedFilesystemV2SharedAccessRulesMicroversion
+
+		access, err := sharedFilesystemShareAccessV2Get(sfsClient, d.Id()).Extract()
+		if err != nil {
+			return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_sharedfilesystem_share_access_v2"))
+		}
+
+		log.Printf("[DEBUG] Retrieved openstack_sharedfilesystem_share_access_v2 %s: %#v", d.Id(), access)
+
+		d.Set("access_type", access.AccessType)
+		d.Set("access_to", access.AccessTo)
+		d.Set("access_level", access.AccessLevel)
+		d.Set("access_key", access.AccessKey)
+		d.Set("access_metadata", access.Metadata)
+		d.Set("region", GetRegion(d, config))
+
+		return nil
+	}
+
 	shareID := d.Get("share_id").(string)
 	access, err := shares.ListAccessRights(sfsClient, shareID).Extract()
 	if err != nil {
@@ -200,6 +244,46 @@ func resourceSharedFilesystemShareAccessV2Read(ctx context.Context, d *schema.Re
 	return nil
 }
 
+func resourceSharedFilesystemShareAccessV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2SharedAccessRulesMicroversion
+
+	if d.HasChange("access_metadata") {
+		o, n := d.GetChange("access_metadata")
+		oldMetadata := o.(map[string]interface{})
+		newMetadata := n.(map[string]interface{})
+
+		for oldKey := range oldMetadata {
+			if _, ok := newMetadata[oldKey]; ok {
+				continue
+			}
+
+			err := sharedFilesystemShareAccessV2DeleteMetadatum(sfsClient, d.Id(), oldKey).ExtractErr()
+			if err != nil {
+				return diag.Errorf("Error deleting openstack_sharedfilesystem_share_access_v2 %s metadata %s: %s", d.Id(), oldKey, err)
+			}
+		}
+
+		if len(newMetadata) > 0 {
+			metadata := expandToMapStringString(newMetadata)
+
+			log.Printf("[DEBUG] Updating the following items in metadata for openstack_sharedfilesystem_share_access_v2 %s: %v", d.Id(), metadata)
+
+			err := sharedFilesystemShareAccessV2UpdateMetadata(sfsClient, d.Id(), metadata).ExtractErr()
+			if err != nil {
+				return diag.Errorf("Error updating openstack_sharedfilesystem_share_access_v2 %s metadata: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceSharedFilesystemShareAccessV2Read(ctx, d, meta)
+}
+
 func resourceSharedFilesystemShareAccessV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
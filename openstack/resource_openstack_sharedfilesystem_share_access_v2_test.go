@@ -64,6 +64,40 @@ func TestAccSFSV2ShareAccess_basic(t *testing.T) {
 	})
 }
 
+func TestAccSFSV2ShareAccess_metadata(t *testing.T) {
+	var shareAccess shares.AccessRight
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSFS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSFSV2ShareAccessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSFSV2ShareAccessConfigMetadata(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareAccessExists("openstack_sharedfilesystem_share_access_v2.share_access_1", &shareAccess),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_access_v2.share_access_1", "access_metadata.%", "2"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_access_v2.share_access_1", "access_metadata.key1", "value1"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_access_v2.share_access_1", "access_metadata.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccSFSV2ShareAccessConfigMetadataUpdate(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareAccessExists("openstack_sharedfilesystem_share_access_v2.share_access_1", &shareAccess),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_access_v2.share_access_1", "access_metadata.%", "2"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_access_v2.share_access_1", "access_metadata.key1", "value1_updated"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_access_v2.share_access_1", "access_metadata.key3", "value3"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckSFSV2ShareAccessDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	sfsClient, err := config.SharedfilesystemV2Client(osRegionName)
@@ -206,3 +240,39 @@ resource "openstack_sharedfilesystem_share_access_v2" "share_access_2" {
 }
 `, testAccSFSV2ShareAccessConfig)
 }
+
+func testAccSFSV2ShareAccessConfigMetadata() string {
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_sharedfilesystem_share_access_v2" "share_access_1" {
+  share_id     = "${openstack_sharedfilesystem_share_v2.share_1.id}"
+  access_type  = "ip"
+  access_to    = "192.168.199.10"
+  access_level = "rw"
+
+  access_metadata = {
+    key1 = "value1"
+    key2 = "value2"
+  }
+}
+`, testAccSFSV2ShareAccessConfig)
+}
+
+func testAccSFSV2ShareAccessConfigMetadataUpdate() string {
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_sharedfilesystem_share_access_v2" "share_access_1" {
+  share_id     = "${openstack_sharedfilesystem_share_v2.share_1.id}"
+  access_type  = "ip"
+  access_to    = "192.168.199.10"
+  access_level = "rw"
+
+  access_metadata = {
+    key1 = "value1_updated"
+    key3 = "value3"
+  }
+}
+`, testAccSFSV2ShareAccessConfig)
+}
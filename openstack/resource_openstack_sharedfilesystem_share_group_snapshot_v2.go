@@ -0,0 +1,240 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud/openstack/sharedfilesystems/v2/errors"
+)
+
+func resourceSharedFilesystemShareGroupSnapshotV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSharedFilesystemShareGroupSnapshotV2Create,
+		ReadContext:   resourceSharedFilesystemShareGroupSnapshotV2Read,
+		UpdateContext: resourceSharedFilesystemShareGroupSnapshotV2Update,
+		DeleteContext: resourceSharedFilesystemShareGroupSnapshotV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"share_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"members": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"share_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceSharedFilesystemShareGroupSnapshotV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareGroupMicroversion
+
+	createOpts := sharedFilesystemShareGroupSnapshotV2CreateOpts{
+		ShareGroupID: d.Get("share_group_id").(string),
+		Name:         d.Get("name").(string),
+		Description:  d.Get("description").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_sharedfilesystem_share_group_snapshot_v2 create options: %#v", createOpts)
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+
+	var snapshot *sharedFilesystemShareGroupSnapshotV2
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		snapshot, err = sharedFilesystemShareGroupSnapshotV2Create(sfsClient, createOpts).Extract()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		detailedErr := errors.ErrorDetails{}
+		e := errors.ExtractErrorInto(err, &detailedErr)
+		if e != nil {
+			return diag.Errorf("Error creating openstack_sharedfilesystem_share_group_snapshot_v2: %s: %s", err, e)
+		}
+		for k, msg := range detailedErr {
+			return diag.Errorf("Error creating openstack_sharedfilesystem_share_group_snapshot_v2: %s (%d): %s", k, msg.Code, msg.Message)
+		}
+	}
+
+	d.SetId(snapshot.ID)
+
+	err = waitForSharedFilesystemShareGroupSnapshotV2(ctx, sfsClient, snapshot.ID, []string{"available"}, []string{"creating"}, timeout)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceSharedFilesystemShareGroupSnapshotV2Read(ctx, d, meta)
+}
+
+func resourceSharedFilesystemShareGroupSnapshotV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareGroupMicroversion
+
+	snapshot, err := sharedFilesystemShareGroupSnapshotV2Get(sfsClient, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_sharedfilesystem_share_group_snapshot_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_sharedfilesystem_share_group_snapshot_v2 %s: %#v", d.Id(), snapshot)
+
+	d.Set("share_group_id", snapshot.ShareGroupID)
+	d.Set("name", snapshot.Name)
+	d.Set("description", snapshot.Description)
+	d.Set("status", snapshot.Status)
+	d.Set("project_id", snapshot.ProjectID)
+	d.Set("region", GetRegion(d, config))
+
+	if err := d.Set("members", flattenSharedFilesystemShareGroupSnapshotV2Members(snapshot.Members)); err != nil {
+		log.Printf("[DEBUG] Unable to set openstack_sharedfilesystem_share_group_snapshot_v2 %s members: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceSharedFilesystemShareGroupSnapshotV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareGroupMicroversion
+
+	var updateOpts sharedFilesystemShareGroupSnapshotV2UpdateOpts
+
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if updateOpts != (sharedFilesystemShareGroupSnapshotV2UpdateOpts{}) {
+		log.Printf("[DEBUG] openstack_sharedfilesystem_share_group_snapshot_v2 %s update options: %#v", d.Id(), updateOpts)
+		_, err = sharedFilesystemShareGroupSnapshotV2Update(sfsClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error updating openstack_sharedfilesystem_share_group_snapshot_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceSharedFilesystemShareGroupSnapshotV2Read(ctx, d, meta)
+}
+
+func resourceSharedFilesystemShareGroupSnapshotV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareGroupMicroversion
+
+	timeout := d.Timeout(schema.TimeoutDelete)
+
+	log.Printf("[DEBUG] Attempting to delete openstack_sharedfilesystem_share_group_snapshot_v2 %s", d.Id())
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		err = sharedFilesystemShareGroupSnapshotV2Delete(sfsClient, d.Id()).ExtractErr()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		e := CheckDeleted(d, err, "")
+		if e == nil {
+			return nil
+		}
+		detailedErr := errors.ErrorDetails{}
+		e = errors.ExtractErrorInto(err, &detailedErr)
+		if e != nil {
+			return diag.Errorf("Error deleting openstack_sharedfilesystem_share_group_snapshot_v2 %s: %s: %s", d.Id(), err, e)
+		}
+		for k, msg := range detailedErr {
+			return diag.Errorf("Error deleting openstack_sharedfilesystem_share_group_snapshot_v2 %s: %s (%d): %s", d.Id(), k, msg.Code, msg.Message)
+		}
+	}
+
+	err = waitForSharedFilesystemShareGroupSnapshotV2(ctx, sfsClient, d.Id(), []string{"deleted"}, []string{"deleting", "available"}, timeout)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,268 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud/openstack/sharedfilesystems/v2/errors"
+)
+
+func resourceSharedFilesystemShareGroupV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSharedFilesystemShareGroupV2Create,
+		ReadContext:   resourceSharedFilesystemShareGroupV2Read,
+		UpdateContext: resourceSharedFilesystemShareGroupV2Update,
+		DeleteContext: resourceSharedFilesystemShareGroupV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"share_group_type_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"share_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"share_network_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"share_server_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"consistent_snapshot_support": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSharedFilesystemShareGroupV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareGroupMicroversion
+
+	createOpts := sharedFilesystemShareGroupV2CreateOpts{
+		Name:             d.Get("name").(string),
+		Description:      d.Get("description").(string),
+		ShareGroupTypeID: d.Get("share_group_type_id").(string),
+		ShareTypes:       expandToStringSlice(d.Get("share_types").(*schema.Set).List()),
+		ShareNetworkID:   d.Get("share_network_id").(string),
+		AvailabilityZone: d.Get("availability_zone").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_sharedfilesystem_share_group_v2 create options: %#v", createOpts)
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+
+	var shareGroup *sharedFilesystemShareGroupV2
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		shareGroup, err = sharedFilesystemShareGroupV2Create(sfsClient, createOpts).Extract()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		detailedErr := errors.ErrorDetails{}
+		e := errors.ExtractErrorInto(err, &detailedErr)
+		if e != nil {
+			return diag.Errorf("Error creating openstack_sharedfilesystem_share_group_v2: %s: %s", err, e)
+		}
+		for k, msg := range detailedErr {
+			return diag.Errorf("Error creating openstack_sharedfilesystem_share_group_v2: %s (%d): %s", k, msg.Code, msg.Message)
+		}
+	}
+
+	d.SetId(shareGroup.ID)
+
+	err = waitForSharedFilesystemShareGroupV2(ctx, sfsClient, shareGroup.ID, []string{"available"}, []string{"creating"}, timeout)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceSharedFilesystemShareGroupV2Read(ctx, d, meta)
+}
+
+func resourceSharedFilesystemShareGroupV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareGroupMicroversion
+
+	shareGroup, err := sharedFilesystemShareGroupV2Get(sfsClient, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_sharedfilesystem_share_group_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_sharedfilesystem_share_group_v2 %s: %#v", d.Id(), shareGroup)
+
+	d.Set("name", shareGroup.Name)
+	d.Set("description", shareGroup.Description)
+	d.Set("share_group_type_id", shareGroup.ShareGroupTypeID)
+	d.Set("share_types", shareGroup.ShareTypes)
+	d.Set("share_network_id", shareGroup.ShareNetworkID)
+	d.Set("availability_zone", shareGroup.AvailabilityZone)
+	d.Set("status", shareGroup.Status)
+	d.Set("project_id", shareGroup.ProjectID)
+	d.Set("share_server_id", shareGroup.ShareServerID)
+	d.Set("host", shareGroup.Host)
+	d.Set("consistent_snapshot_support", shareGroup.ConsistentSnapshotSupport)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceSharedFilesystemShareGroupV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareGroupMicroversion
+
+	var updateOpts sharedFilesystemShareGroupV2UpdateOpts
+
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if updateOpts != (sharedFilesystemShareGroupV2UpdateOpts{}) {
+		log.Printf("[DEBUG] openstack_sharedfilesystem_share_group_v2 %s update options: %#v", d.Id(), updateOpts)
+		_, err = sharedFilesystemShareGroupV2Update(sfsClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error updating openstack_sharedfilesystem_share_group_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceSharedFilesystemShareGroupV2Read(ctx, d, meta)
+}
+
+func resourceSharedFilesystemShareGroupV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareGroupMicroversion
+
+	timeout := d.Timeout(schema.TimeoutDelete)
+
+	// A share group can only be deleted, when it has no member shares.
+	err = waitForSharedFilesystemShareGroupV2Members(ctx, sfsClient, d.Id(), timeout)
+	if err != nil {
+		return diag.Errorf("Error deleting openstack_sharedfilesystem_share_group_v2 %s: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Attempting to delete openstack_sharedfilesystem_share_group_v2 %s", d.Id())
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		err = sharedFilesystemShareGroupV2Delete(sfsClient, d.Id()).ExtractErr()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		e := CheckDeleted(d, err, "")
+		if e == nil {
+			return nil
+		}
+		detailedErr := errors.ErrorDetails{}
+		e = errors.ExtractErrorInto(err, &detailedErr)
+		if e != nil {
+			return diag.Errorf("Error deleting openstack_sharedfilesystem_share_group_v2 %s: %s: %s", d.Id(), err, e)
+		}
+		for k, msg := range detailedErr {
+			return diag.Errorf("Error deleting openstack_sharedfilesystem_share_group_v2 %s: %s (%d): %s", d.Id(), k, msg.Code, msg.Message)
+		}
+	}
+
+	err = waitForSharedFilesystemShareGroupV2(ctx, sfsClient, d.Id(), []string{"deleted"}, []string{"deleting", "available"}, timeout)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSFSV2ShareGroup_basic(t *testing.T) {
+	var shareGroup sharedFilesystemShareGroupV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSFS(t)
+			testAccPreCheckSFSShareGroup(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSFSV2ShareGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSFSV2ShareGroupConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareGroupExists("openstack_sharedfilesystem_share_group_v2.share_group_1", &shareGroup),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_group_v2.share_group_1", "name", "share_group_1"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_group_v2.share_group_1", "description", "share group description"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_group_v2.share_group_1", "share_group_type_id", osSfsShareGroupTypeID),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_group_v2.share_group_1", "status", "available"),
+				),
+			},
+			{
+				Config: testAccSFSV2ShareGroupConfigUpdate(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareGroupExists("openstack_sharedfilesystem_share_group_v2.share_group_1", &shareGroup),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_group_v2.share_group_1", "name", "share_group_updated"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_group_v2.share_group_1", "description", ""),
+					resource.TestCheckResourceAttrPair(
+						"openstack_sharedfilesystem_share_v2.share_1", "share_group_id",
+						"openstack_sharedfilesystem_share_group_v2.share_group_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_sharedfilesystem_share_group_snapshot_v2.share_group_snapshot_1", "share_group_id",
+						"openstack_sharedfilesystem_share_group_v2.share_group_1", "id"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_group_snapshot_v2.share_group_snapshot_1", "status", "available"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_group_snapshot_v2.share_group_snapshot_1", "members.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_sharedfilesystem_share_group_snapshot_v2.share_group_snapshot_1", "members.0.share_id",
+						"openstack_sharedfilesystem_share_v2.share_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSFSV2ShareGroupDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareGroupMicroversion
+
+	for _, rs := range s.RootModule().Resources {
+		switch rs.Type {
+		case "openstack_sharedfilesystem_share_group_v2":
+			_, err := sharedFilesystemShareGroupV2Get(sfsClient, rs.Primary.ID).Extract()
+			if err == nil {
+				return fmt.Errorf("Manila share group still exists: %s", rs.Primary.ID)
+			}
+		case "openstack_sharedfilesystem_share_group_snapshot_v2":
+			_, err := sharedFilesystemShareGroupSnapshotV2Get(sfsClient, rs.Primary.ID).Extract()
+			if err == nil {
+				return fmt.Errorf("Manila share group snapshot still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSFSV2ShareGroupExists(n string, shareGroup *sharedFilesystemShareGroupV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		sfsClient, err := config.SharedfilesystemV2Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+		}
+
+		sfsClient.Microversion = sharedFilesystemV2ShareGroupMicroversion
+
+		found, err := sharedFilesystemShareGroupV2Get(sfsClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return fmt.Errorf("Unable to get %s share group: %s", rs.Primary.ID, err)
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Share group not found")
+		}
+
+		*shareGroup = *found
+
+		return nil
+	}
+}
+
+func testAccSFSV2ShareGroupConfigBasic() string {
+	return fmt.Sprintf(`
+resource "openstack_sharedfilesystem_share_group_v2" "share_group_1" {
+  name                = "share_group_1"
+  description         = "share group description"
+  share_group_type_id = "%s"
+}
+`, osSfsShareGroupTypeID)
+}
+
+func testAccSFSV2ShareGroupConfigUpdate() string {
+	return fmt.Sprintf(`
+resource "openstack_sharedfilesystem_share_group_v2" "share_group_1" {
+  name                = "share_group_updated"
+  share_group_type_id = "%s"
+}
+
+resource "openstack_sharedfilesystem_share_v2" "share_1" {
+  name           = "nfs_share"
+  share_proto    = "NFS"
+  share_type     = "dhss_false"
+  size           = 1
+  share_group_id = "${openstack_sharedfilesystem_share_group_v2.share_group_1.id}"
+}
+
+resource "openstack_sharedfilesystem_share_group_snapshot_v2" "share_group_snapshot_1" {
+  name           = "share_group_snapshot_1"
+  share_group_id = "${openstack_sharedfilesystem_share_v2.share_1.share_group_id}"
+}
+`, osSfsShareGroupTypeID)
+}
@@ -0,0 +1,299 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/sharedfilesystems/v2/errors"
+)
+
+func resourceSharedFilesystemShareReplicaV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSharedFilesystemShareReplicaV2Create,
+		ReadContext:   resourceSharedFilesystemShareReplicaV2Read,
+		UpdateContext: resourceSharedFilesystemShareReplicaV2Update,
+		DeleteContext: resourceSharedFilesystemShareReplicaV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"share_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"promote": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"replica_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"share_network_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"share_server_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"export_locations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"preferred": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceSharedFilesystemShareReplicaV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareReplicaMicroversion
+
+	createOpts := sharedFilesystemShareReplicaV2CreateOpts{
+		ShareID:          d.Get("share_id").(string),
+		AvailabilityZone: d.Get("availability_zone").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_sharedfilesystem_share_replica_v2 create options: %#v", createOpts)
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+
+	var replica *sharedFilesystemShareReplicaV2
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		replica, err = sharedFilesystemShareReplicaV2Create(sfsClient, createOpts).Extract()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		detailedErr := errors.ErrorDetails{}
+		e := errors.ExtractErrorInto(err, &detailedErr)
+		if e != nil {
+			return diag.Errorf("Error creating openstack_sharedfilesystem_share_replica_v2: %s: %s", err, e)
+		}
+		for k, msg := range detailedErr {
+			return diag.Errorf("Error creating openstack_sharedfilesystem_share_replica_v2: %s (%d): %s", k, msg.Code, msg.Message)
+		}
+	}
+
+	d.SetId(replica.ID)
+
+	// Wait for the replica to become available and to be synchronized with
+	// the active replica.
+	pending := []string{"creating", "out_of_sync"}
+	err = waitForSharedFilesystemShareReplicaV2(ctx, sfsClient, replica.ID, []string{"in_sync", "active"}, pending, timeout)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("promote").(bool) {
+		err = resourceSharedFilesystemShareReplicaV2Promote(ctx, sfsClient, replica.ID, timeout)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceSharedFilesystemShareReplicaV2Read(ctx, d, meta)
+}
+
+func resourceSharedFilesystemShareReplicaV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareReplicaMicroversion
+
+	replica, err := sharedFilesystemShareReplicaV2Get(sfsClient, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_sharedfilesystem_share_replica_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_sharedfilesystem_share_replica_v2 %s: %#v", d.Id(), replica)
+
+	exportLocations, err := sharedFilesystemShareReplicaV2ListExportLocations(sfsClient, d.Id()).Extract()
+	if err != nil {
+		return diag.Errorf("Failed to retrieve openstack_sharedfilesystem_share_replica_v2 %s export_locations: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_sharedfilesystem_share_replica_v2 %s export_locations: %#v", d.Id(), exportLocations)
+
+	if err = d.Set("export_locations", flattenSharedFilesystemShareReplicaV2ExportLocations(exportLocations)); err != nil {
+		log.Printf("[DEBUG] Unable to set openstack_sharedfilesystem_share_replica_v2 %s export_locations: %s", d.Id(), err)
+	}
+
+	d.Set("share_id", replica.ShareID)
+	d.Set("availability_zone", replica.AvailabilityZone)
+	d.Set("status", replica.Status)
+	d.Set("replica_state", replica.ReplicaState)
+	d.Set("share_network_id", replica.ShareNetworkID)
+	d.Set("share_server_id", replica.ShareServerID)
+	d.Set("host", replica.Host)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceSharedFilesystemShareReplicaV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareReplicaMicroversion
+
+	// A replica can't be demoted, another replica has to be promoted instead.
+	if d.HasChange("promote") && d.Get("promote").(bool) {
+		err = resourceSharedFilesystemShareReplicaV2Promote(ctx, sfsClient, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceSharedFilesystemShareReplicaV2Read(ctx, d, meta)
+}
+
+func resourceSharedFilesystemShareReplicaV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareReplicaMicroversion
+
+	timeout := d.Timeout(schema.TimeoutDelete)
+
+	log.Printf("[DEBUG] Attempting to delete openstack_sharedfilesystem_share_replica_v2 %s", d.Id())
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		err = sharedFilesystemShareReplicaV2Delete(sfsClient, d.Id()).ExtractErr()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		e := CheckDeleted(d, err, "")
+		if e == nil {
+			return nil
+		}
+		detailedErr := errors.ErrorDetails{}
+		e = errors.ExtractErrorInto(err, &detailedErr)
+		if e != nil {
+			return diag.Errorf("Error deleting openstack_sharedfilesystem_share_replica_v2 %s: %s: %s", d.Id(), err, e)
+		}
+		for k, msg := range detailedErr {
+			return diag.Errorf("Error deleting openstack_sharedfilesystem_share_replica_v2 %s: %s (%d): %s", d.Id(), k, msg.Code, msg.Message)
+		}
+	}
+
+	pending := []string{"deleting", "available", "in_sync", "out_of_sync", "active"}
+	err = waitForSharedFilesystemShareReplicaV2(ctx, sfsClient, d.Id(), []string{"deleted"}, pending, timeout)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceSharedFilesystemShareReplicaV2Promote(ctx context.Context, sfsClient *gophercloud.ServiceClient, id string, timeout time.Duration) error {
+	replica, err := sharedFilesystemShareReplicaV2Get(sfsClient, id).Extract()
+	if err != nil {
+		return err
+	}
+
+	if replica.ReplicaState == "active" {
+		log.Printf("[DEBUG] openstack_sharedfilesystem_share_replica_v2 %s is already the active replica", id)
+		return nil
+	}
+
+	log.Printf("[DEBUG] Promoting openstack_sharedfilesystem_share_replica_v2 %s", id)
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		err := sharedFilesystemShareReplicaV2Promote(sfsClient, id).ExtractErr()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		detailedErr := errors.ErrorDetails{}
+		e := errors.ExtractErrorInto(err, &detailedErr)
+		if e != nil {
+			return fmt.Errorf("Error promoting openstack_sharedfilesystem_share_replica_v2 %s: %s: %s", id, err, e)
+		}
+		for k, msg := range detailedErr {
+			return fmt.Errorf("Error promoting openstack_sharedfilesystem_share_replica_v2 %s: %s (%d): %s", id, k, msg.Code, msg.Message)
+		}
+	}
+
+	pending := []string{"replication_change", "in_sync", "out_of_sync"}
+	return waitForSharedFilesystemShareReplicaV2(ctx, sfsClient, id, []string{"active"}, pending, timeout)
+}
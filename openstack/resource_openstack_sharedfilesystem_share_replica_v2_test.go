@@ -0,0 +1,110 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSFSV2ShareReplica_basic(t *testing.T) {
+	var replica sharedFilesystemShareReplicaV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSFS(t)
+			testAccPreCheckSFSReplication(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSFSV2ShareReplicaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSFSV2ShareReplicaConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareReplicaExists("openstack_sharedfilesystem_share_replica_v2.replica_1", &replica),
+					resource.TestCheckResourceAttrPair(
+						"openstack_sharedfilesystem_share_replica_v2.replica_1", "share_id",
+						"openstack_sharedfilesystem_share_v2.share_1", "id"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_replica_v2.replica_1", "status", "available"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_replica_v2.replica_1", "replica_state", "in_sync"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSFSV2ShareReplicaDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareReplicaMicroversion
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_sharedfilesystem_share_replica_v2" {
+			continue
+		}
+
+		_, err := sharedFilesystemShareReplicaV2Get(sfsClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Manila share replica still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSFSV2ShareReplicaExists(n string, replica *sharedFilesystemShareReplicaV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		sfsClient, err := config.SharedfilesystemV2Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+		}
+
+		sfsClient.Microversion = sharedFilesystemV2ShareReplicaMicroversion
+
+		found, err := sharedFilesystemShareReplicaV2Get(sfsClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Share replica not found")
+		}
+
+		*replica = *found
+
+		return nil
+	}
+}
+
+func testAccSFSV2ShareReplicaConfigBasic() string {
+	return fmt.Sprintf(`
+resource "openstack_sharedfilesystem_share_v2" "share_1" {
+  name        = "nfs_share"
+  description = "test share description"
+  share_proto = "NFS"
+  share_type  = "%s"
+  size        = 1
+}
+
+resource "openstack_sharedfilesystem_share_replica_v2" "replica_1" {
+  share_id = "${openstack_sharedfilesystem_share_v2.share_1.id}"
+}
+`, osSfsReplicationShareType)
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -76,6 +77,12 @@ func resourceSharedFilesystemShareV2() *schema.Resource {
 				ValidateFunc: validation.IntAtLeast(1),
 			},
 
+			"allow_shrink": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"share_type": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -89,12 +96,24 @@ func resourceSharedFilesystemShareV2() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"share_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
 			"is_public": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
 			},
 
+			"revert_to_snapshot_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
 			"metadata": {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -119,6 +138,10 @@ func resourceSharedFilesystemShareV2() *schema.Resource {
 				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"path": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -127,10 +150,23 @@ func resourceSharedFilesystemShareV2() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"is_admin_only": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"share_instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
 
+			"preferred_export_location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"has_replicas": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -156,6 +192,12 @@ func resourceSharedFilesystemShareV2() *schema.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: customdiff.Sequence(
+			func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return sharedFilesystemShareV2SizeCustomizeDiff(diff)
+			},
+		),
 	}
 }
 
@@ -178,22 +220,29 @@ func resourceSharedFilesystemShareV2Create(ctx context.Context, d *schema.Resour
 		}
 	}
 
-	createOpts := shares.CreateOpts{
-		Name:             d.Get("name").(string),
-		Description:      d.Get("description").(string),
-		ShareProto:       d.Get("share_proto").(string),
-		Size:             d.Get("size").(int),
-		SnapshotID:       d.Get("snapshot_id").(string),
-		IsPublic:         &isPublic,
-		Metadata:         metadata,
-		ShareNetworkID:   d.Get("share_network_id").(string),
-		AvailabilityZone: d.Get("availability_zone").(string),
+	createOpts := sharedFilesystemShareV2CreateOpts{
+		CreateOpts: shares.CreateOpts{
+			Name:             d.Get("name").(string),
+			Description:      d.Get("description").(string),
+			ShareProto:       d.Get("share_proto").(string),
+			Size:             d.Get("size").(int),
+			SnapshotID:       d.Get("snapshot_id").(string),
+			IsPublic:         &isPublic,
+			Metadata:         metadata,
+			ShareNetworkID:   d.Get("share_network_id").(string),
+			AvailabilityZone: d.Get("availability_zone").(string),
+		},
+		ShareGroupID: d.Get("share_group_id").(string),
 	}
 
 	if v, ok := d.GetOkExists("share_type"); ok {
 		createOpts.ShareType = v.(string)
 	}
 
+	if createOpts.ShareGroupID != "" {
+		sfsClient.Microversion = sharedFilesystemV2ShareGroupMicroversion
+	}
+
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 
 	timeout := d.Timeout(schema.TimeoutCreate)
@@ -253,16 +302,11 @@ func resourceSharedFilesystemShareV2Read(ctx context.Context, d *schema.Resource
 
 	log.Printf("[DEBUG] Retrieved share's export_locations %s: %#v", d.Id(), exportLocationsRaw)
 
-	exportLocations := make([]map[string]string, 0, len(exportLocationsRaw))
-	for _, v := range exportLocationsRaw {
-		exportLocations = append(exportLocations, map[string]string{
-			"path":      v.Path,
-			"preferred": fmt.Sprint(v.Preferred),
-		})
-	}
+	exportLocations := flattenSharedFilesystemShareV2ExportLocations(exportLocationsRaw)
 	if err = d.Set("export_locations", exportLocations); err != nil {
 		log.Printf("[DEBUG] Unable to set export_locations: %s", err)
 	}
+	d.Set("preferred_export_location", sharedFilesystemShareV2PreferredExportLocation(exportLocationsRaw))
 
 	d.Set("name", share.Name)
 	d.Set("description", share.Description)
@@ -270,6 +314,24 @@ func resourceSharedFilesystemShareV2Read(ctx context.Context, d *schema.Resource
 	d.Set("size", share.Size)
 	d.Set("share_type", share.ShareTypeName)
 	d.Set("snapshot_id", share.SnapshotID)
+
+	// The share group is only returned since 2.31. When the supported
+	// microversion is unknown, it's only queried for a share group in the
+	// state.
+	shareGroupSupported, err := sharedFilesystemV2SupportsMicroversion(sfsClient, sharedFilesystemV2ShareGroupIDMicroversion)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to determine the share group support of %s share: %s", d.Id(), err)
+		shareGroupSupported = d.Get("share_group_id").(string) != ""
+	}
+	if shareGroupSupported {
+		shareGroupClient := *sfsClient
+		shareGroupClient.Microversion = sharedFilesystemV2ShareGroupIDMicroversion
+		shareGroupID, err := sharedFilesystemShareV2ShareGroupID(&shareGroupClient, d.Id())
+		if err != nil {
+			return diag.Errorf("Error retrieving share group of %s share: %s", d.Id(), err)
+		}
+		d.Set("share_group_id", shareGroupID)
+	}
 	d.Set("is_public", share.IsPublic)
 	d.Set("all_metadata", share.Metadata)
 	d.Set("share_network_id", share.ShareNetworkID)
@@ -296,6 +358,15 @@ func resourceSharedFilesystemShareV2Update(ctx context.Context, d *schema.Resour
 
 	timeout := d.Timeout(schema.TimeoutUpdate)
 
+	if d.HasChange("revert_to_snapshot_id") {
+		if snapshotID := d.Get("revert_to_snapshot_id").(string); snapshotID != "" {
+			err = resourceSharedFilesystemShareV2Revert(ctx, sfsClient, d.Id(), snapshotID, timeout)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
 	var updateOpts shares.UpdateOpts
 
 	if d.HasChange("name") {
@@ -346,28 +417,32 @@ func resourceSharedFilesystemShareV2Update(ctx context.Context, d *schema.Resour
 	}
 
 	if d.HasChange("size") {
-		var pending []string
+		// Wait for share to become active before continuing
+		err = waitForSFV2Share(ctx, sfsClient, d.Id(), "available", []string{"creating", "manage_starting", "extending", "shrinking"}, timeout)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		var pending string
 		oldSize, newSize := d.GetChange("size")
 
 		if newSize.(int) > oldSize.(int) {
-			pending = append(pending, "extending")
+			pending = "extending"
 			resizeOpts := shares.ExtendOpts{NewSize: newSize.(int)}
 			log.Printf("[DEBUG] Resizing share %s with options: %#v", d.Id(), resizeOpts)
 			err = resource.Retry(timeout, func() *resource.RetryError {
 				err := shares.Extend(sfsClient, d.Id(), resizeOpts).Err
-				log.Printf("[DEBUG] Resizing share %s with options: %#v", d.Id(), resizeOpts)
 				if err != nil {
 					return checkForRetryableError(err)
 				}
 				return nil
 			})
-		} else if newSize.(int) < oldSize.(int) {
-			pending = append(pending, "shrinking")
+		} else {
+			pending = "shrinking"
 			resizeOpts := shares.ShrinkOpts{NewSize: newSize.(int)}
 			log.Printf("[DEBUG] Resizing share %s with options: %#v", d.Id(), resizeOpts)
 			err = resource.Retry(timeout, func() *resource.RetryError {
 				err := shares.Shrink(sfsClient, d.Id(), resizeOpts).Err
-				log.Printf("[DEBUG] Resizing share %s with options: %#v", d.Id(), resizeOpts)
 				if err != nil {
 					return checkForRetryableError(err)
 				}
@@ -386,8 +461,8 @@ func resourceSharedFilesystemShareV2Update(ctx context.Context, d *schema.Resour
 			}
 		}
 
-		// Wait for share to become active before continuing
-		err = waitForSFV2Share(ctx, sfsClient, d.Id(), "available", pending, timeout)
+		// Wait for share to be resized before continuing
+		err = waitForSFV2ShareResize(ctx, sfsClient, d.Id(), newSize.(int), pending, timeout)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -454,9 +529,19 @@ func resourceSharedFilesystemShareV2Delete(ctx context.Context, d *schema.Resour
 
 	timeout := d.Timeout(schema.TimeoutDelete)
 
+	// A share of a share group can only be deleted with its share group ID.
+	shareGroupID := d.Get("share_group_id").(string)
+	if shareGroupID != "" {
+		sfsClient.Microversion = sharedFilesystemV2ShareGroupMicroversion
+	}
+
 	log.Printf("[DEBUG] Attempting to delete share %s", d.Id())
 	err = resource.Retry(timeout, func() *resource.RetryError {
-		err = shares.Delete(sfsClient, d.Id()).ExtractErr()
+		if shareGroupID != "" {
+			err = sharedFilesystemShareV2Delete(sfsClient, d.Id(), shareGroupID).ExtractErr()
+		} else {
+			err = shares.Delete(sfsClient, d.Id()).ExtractErr()
+		}
 		if err != nil {
 			return checkForRetryableError(err)
 		}
@@ -488,6 +573,47 @@ func resourceSharedFilesystemShareV2Delete(ctx context.Context, d *schema.Resour
 	return nil
 }
 
+func resourceSharedFilesystemShareV2Revert(ctx context.Context, sfsClient *gophercloud.ServiceClient, id string, snapshotID string, timeout time.Duration) error {
+	// Reverting to a snapshot appeared in 2.27.
+	revertClient := *sfsClient
+	revertClient.Microversion = sharedFilesystemV2ShareRevertMicroversion
+
+	// Wait for share to become active before continuing
+	err := waitForSFV2Share(ctx, &revertClient, id, "available", []string{"creating", "manage_starting", "extending", "shrinking", "reverting"}, timeout)
+	if err != nil {
+		return err
+	}
+
+	err = sharedFilesystemShareV2CheckRevert(&revertClient, id, snapshotID)
+	if err != nil {
+		return fmt.Errorf("Unable to revert %s share to %s snapshot: %s", id, snapshotID, err)
+	}
+
+	revertOpts := shares.RevertOpts{SnapshotID: snapshotID}
+	log.Printf("[DEBUG] Reverting share %s with options: %#v", id, revertOpts)
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		err := shares.Revert(&revertClient, id, revertOpts).ExtractErr()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		detailedErr := errors.ErrorDetails{}
+		e := errors.ExtractErrorInto(err, &detailedErr)
+		if e != nil {
+			return fmt.Errorf("Unable to revert %s share to %s snapshot: %s: %s", id, snapshotID, err, e)
+		}
+		for k, msg := range detailedErr {
+			return fmt.Errorf("Unable to revert %s share to %s snapshot: %s (%d): %s", id, snapshotID, k, msg.Code, msg.Message)
+		}
+	}
+
+	// Wait for share to become active before continuing
+	return waitForSFV2Share(ctx, &revertClient, id, "available", []string{"reverting"}, timeout)
+}
+
 // Full list of the share statuses: https://developer.openstack.org/api-ref/shared-file-system/#shares
 func waitForSFV2Share(ctx context.Context, sfsClient *gophercloud.ServiceClient, id string, target string, pending []string, timeout time.Duration) error {
 	log.Printf("[DEBUG] Waiting for share %s to become %s.", id, target)
@@ -522,6 +648,33 @@ func waitForSFV2Share(ctx context.Context, sfsClient *gophercloud.ServiceClient,
 	return nil
 }
 
+// waitForSFV2ShareResize waits for the share to become available with the
+// requested size.
+func waitForSFV2ShareResize(ctx context.Context, sfsClient *gophercloud.ServiceClient, id string, size int, pending string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for share %s to be resized to %d GB.", id, size)
+
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{"available"},
+		Pending:    []string{pending},
+		Refresh:    sharedFilesystemShareV2ResizeRefreshFunc(sfsClient, id, size),
+		Timeout:    timeout,
+		Delay:      1 * time.Second,
+		MinTimeout: 1 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		errorMessage := fmt.Sprintf("Error waiting for share %s to be resized to %d GB", id, size)
+		msg := resourceSFSV2ShareManilaMessage(sfsClient, id)
+		if msg == nil {
+			return fmt.Errorf("%s: %s", errorMessage, err)
+		}
+		return fmt.Errorf("%s: %s: the latest manila message (%s): %s", errorMessage, err, msg.CreatedAt, msg.UserMessage)
+	}
+
+	return nil
+}
+
 func resourceSFV2ShareRefreshFunc(sfsClient *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		share, err := shares.Get(sfsClient, id).Extract()
@@ -51,16 +51,16 @@ func TestAccSFSV2Share_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_v2.share_1", "size", "2"),
 				),
 			},
-			/*			resource.TestStep{
-						Config: testAccSFSV2ShareConfig_shrink,
-						Check: resource.ComposeTestCheckFunc(
-							testAccCheckSFSV2ShareExists("openstack_sharedfilesystem_share_v2.share_1", &share),
-							resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_v2.share_1", "name", "nfs_share_shrunk"),
-							resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_v2.share_1", "is_public", "false"),
-							resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_v2.share_1", "share_proto", "NFS"),
-							resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_v2.share_1", "size", "1"),
-						),
-					},*/
+			{
+				Config: testAccSFSV2ShareConfigShrink,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareExists("openstack_sharedfilesystem_share_v2.share_1", &share),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_v2.share_1", "name", "nfs_share_shrunk"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_v2.share_1", "is_public", "false"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_v2.share_1", "share_proto", "NFS"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_v2.share_1", "size", "1"),
+				),
+			},
 		},
 	})
 }
@@ -272,14 +272,15 @@ resource "openstack_sharedfilesystem_share_v2" "share_1" {
 }
 `
 
-//const testAccSFSV2ShareConfigShrink = `
-//resource "openstack_sharedfilesystem_share_v2" "share_1" {
-//  name             = "nfs_share_shrunk"
-//  share_proto      = "NFS"
-//  share_type       = "dhss_false"
-//  size             = 1
-//}
-//`
+const testAccSFSV2ShareConfigShrink = `
+resource "openstack_sharedfilesystem_share_v2" "share_1" {
+  name             = "nfs_share_shrunk"
+  share_proto      = "NFS"
+  share_type       = "dhss_false"
+  size             = 1
+  allow_shrink     = true
+}
+`
 
 const testAccSFSV2ShareConfigMetadataUpdate = `
 resource "openstack_sharedfilesystem_share_v2" "share_1" {
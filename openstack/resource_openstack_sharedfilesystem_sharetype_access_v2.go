@@ -0,0 +1,135 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud/openstack/sharedfilesystems/v2/sharetypes"
+)
+
+func resourceSharedFilesystemShareTypeAccessV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSharedFilesystemShareTypeAccessV2Create,
+		ReadContext:   resourceSharedFilesystemShareTypeAccessV2Read,
+		DeleteContext: resourceSharedFilesystemShareTypeAccessV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"share_type_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceSharedFilesystemShareTypeAccessV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2MinMicroversion
+
+	projectID := d.Get("project_id").(string)
+	shareTypeID := d.Get("share_type_id").(string)
+
+	accessOpts := sharetypes.AccessOpts{
+		Project: projectID,
+	}
+
+	if err := sharetypes.AddAccess(sfsClient, shareTypeID, accessOpts).ExtractErr(); err != nil {
+		return diag.Errorf("Error creating openstack_sharedfilesystem_sharetype_access_v2: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", shareTypeID, projectID))
+
+	return resourceSharedFilesystemShareTypeAccessV2Read(ctx, d, meta)
+}
+
+func resourceSharedFilesystemShareTypeAccessV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2MinMicroversion
+
+	shareTypeID, projectID, err := parseSharedFilesystemShareTypeAccessV2ID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	allAccesses, err := sharetypes.ShowAccess(sfsClient, shareTypeID).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_sharedfilesystem_sharetype_access_v2"))
+	}
+
+	found := false
+	for _, access := range allAccesses {
+		if access.ProjectID == projectID {
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		log.Printf("[DEBUG] Unable to find openstack_sharedfilesystem_sharetype_access_v2 %s", d.Id())
+		d.SetId("")
+
+		return nil
+	}
+
+	d.Set("region", GetRegion(d, config))
+	d.Set("project_id", projectID)
+	d.Set("share_type_id", shareTypeID)
+
+	return nil
+}
+
+func resourceSharedFilesystemShareTypeAccessV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2MinMicroversion
+
+	shareTypeID, projectID, err := parseSharedFilesystemShareTypeAccessV2ID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accessOpts := sharetypes.AccessOpts{
+		Project: projectID,
+	}
+
+	if err := sharetypes.RemoveAccess(sfsClient, shareTypeID, accessOpts).ExtractErr(); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_sharedfilesystem_sharetype_access_v2"))
+	}
+
+	return nil
+}
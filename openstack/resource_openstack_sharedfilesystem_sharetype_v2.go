@@ -0,0 +1,213 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud/openstack/sharedfilesystems/v2/sharetypes"
+)
+
+func resourceSharedFilesystemShareTypeV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSharedFilesystemShareTypeV2Create,
+		ReadContext:   resourceSharedFilesystemShareTypeV2Read,
+		UpdateContext: resourceSharedFilesystemShareTypeV2Update,
+		DeleteContext: resourceSharedFilesystemShareTypeV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"is_public": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"driver_handles_share_servers": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+
+			"extra_specs": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateSharedFilesystemShareTypeV2ExtraSpecs,
+			},
+		},
+	}
+}
+
+func resourceSharedFilesystemShareTypeV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareTypeMicroversion
+
+	name := d.Get("name").(string)
+	createOpts := sharedFilesystemShareTypeV2CreateOpts{
+		Name:        name,
+		Description: d.Get("description").(string),
+		IsPublic:    d.Get("is_public").(bool),
+		ExtraSpecs: expandSharedFilesystemShareTypeV2ExtraSpecs(
+			d.Get("extra_specs").(map[string]interface{}),
+			d.Get("driver_handles_share_servers").(bool),
+		),
+	}
+
+	log.Printf("[DEBUG] openstack_sharedfilesystem_sharetype_v2 create options: %#v", createOpts)
+	shareType, err := sharedFilesystemShareTypeV2Create(sfsClient, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating openstack_sharedfilesystem_sharetype_v2 %s: %s", name, err)
+	}
+
+	d.SetId(shareType.ID)
+
+	return resourceSharedFilesystemShareTypeV2Read(ctx, d, meta)
+}
+
+func resourceSharedFilesystemShareTypeV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareTypeMicroversion
+
+	shareType, err := sharedFilesystemShareTypeV2Get(sfsClient, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_sharedfilesystem_sharetype_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_sharedfilesystem_sharetype_v2 %s: %#v", d.Id(), shareType)
+
+	dhss, extraSpecs, err := flattenSharedFilesystemShareTypeV2ExtraSpecs(shareType.ExtraSpecs)
+	if err != nil {
+		return diag.Errorf("Error reading extra_specs for openstack_sharedfilesystem_sharetype_v2 %s: %s", d.Id(), err)
+	}
+
+	d.Set("name", shareType.Name)
+	d.Set("description", shareType.Description)
+	d.Set("is_public", shareType.IsPublic)
+	d.Set("driver_handles_share_servers", dhss)
+	d.Set("region", GetRegion(d, config))
+
+	if err := d.Set("extra_specs", extraSpecs); err != nil {
+		log.Printf("[WARN] Unable to set extra_specs for openstack_sharedfilesystem_sharetype_v2 %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceSharedFilesystemShareTypeV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	// Updating a share type appeared in 2.50.
+	sfsClient.Microversion = sharedFilesystemV2ShareTypeUpdateMicroversion
+
+	hasChange := false
+	var updateOpts sharedFilesystemShareTypeV2UpdateOpts
+
+	if d.HasChange("name") {
+		hasChange = true
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+
+	if d.HasChange("description") {
+		hasChange = true
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if d.HasChange("is_public") {
+		hasChange = true
+		isPublic := d.Get("is_public").(bool)
+		updateOpts.IsPublic = &isPublic
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] openstack_sharedfilesystem_sharetype_v2 %s update options: %#v", d.Id(), updateOpts)
+		_, err = sharedFilesystemShareTypeV2Update(sfsClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error updating openstack_sharedfilesystem_sharetype_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("extra_specs") {
+		o, n := d.GetChange("extra_specs")
+		oldExtraSpecs := o.(map[string]interface{})
+		newExtraSpecs := n.(map[string]interface{})
+
+		// Delete only the removed extra specs.
+		for oldKey := range oldExtraSpecs {
+			if _, ok := newExtraSpecs[oldKey]; ok {
+				continue
+			}
+
+			if err := sharetypes.UnsetExtraSpecs(sfsClient, d.Id(), oldKey).ExtractErr(); err != nil {
+				return diag.Errorf("Error deleting extra_spec %s from openstack_sharedfilesystem_sharetype_v2 %s: %s", oldKey, d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChanges("extra_specs", "driver_handles_share_servers") {
+		extraSpecs := expandSharedFilesystemShareTypeV2ExtraSpecs(
+			d.Get("extra_specs").(map[string]interface{}),
+			d.Get("driver_handles_share_servers").(bool),
+		)
+
+		setOpts := sharetypes.SetExtraSpecsOpts{ExtraSpecs: extraSpecs}
+		if _, err := sharetypes.SetExtraSpecs(sfsClient, d.Id(), setOpts).Extract(); err != nil {
+			return diag.Errorf("Error setting extra_specs for openstack_sharedfilesystem_sharetype_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceSharedFilesystemShareTypeV2Read(ctx, d, meta)
+}
+
+func resourceSharedFilesystemShareTypeV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareTypeMicroversion
+
+	err = sharetypes.Delete(sfsClient, d.Id()).ExtractErr()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_sharedfilesystem_sharetype_v2"))
+	}
+
+	return nil
+}
@@ -73,6 +73,41 @@ func TestAccGroupV2_update(t *testing.T) {
 	})
 }
 
+func TestAccGroupV2_updateEndpoints(t *testing.T) {
+	var group endpointgroups.EndpointGroup
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckVPN(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckEndpointGroupV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEndpointGroupV2Endpoints("10.3.0.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointGroupV2Exists(
+						"openstack_vpnaas_endpoint_group_v2.group_1", &group),
+					resource.TestCheckResourceAttr("openstack_vpnaas_endpoint_group_v2.group_1", "endpoints.#", "3"),
+					resource.TestCheckTypeSetElemAttr("openstack_vpnaas_endpoint_group_v2.group_1", "endpoints.*", "10.3.0.0/24"),
+					testAccCheckEndpoints("openstack_vpnaas_endpoint_group_v2.group_1", &group.Endpoints),
+				),
+			},
+			{
+				Config: testAccEndpointGroupV2Endpoints("10.5.0.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointGroupV2Exists(
+						"openstack_vpnaas_endpoint_group_v2.group_1", &group),
+					resource.TestCheckResourceAttr("openstack_vpnaas_endpoint_group_v2.group_1", "endpoints.#", "3"),
+					resource.TestCheckTypeSetElemAttr("openstack_vpnaas_endpoint_group_v2.group_1", "endpoints.*", "10.5.0.0/24"),
+					testAccCheckEndpoints("openstack_vpnaas_endpoint_group_v2.group_1", &group.Endpoints),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckEndpointGroupV2Destroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	networkingClient, err := config.NetworkingV2Client(osRegionName)
@@ -176,3 +211,17 @@ const testAccEndpointGroupV2Update = `
 			"10.3.0.0/24",]
 	}
 `
+
+func testAccEndpointGroupV2Endpoints(endpoint string) string {
+	return fmt.Sprintf(`
+	resource "openstack_vpnaas_endpoint_group_v2" "group_1" {
+		name = "Group 1"
+		type = "cidr"
+		endpoints = ["10.2.0.0/24", "%s", "10.4.0.0/24"]
+
+		lifecycle {
+			create_before_destroy = true
+		}
+	}
+	`, endpoint)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/vpnaas/ikepolicies"
@@ -43,14 +44,16 @@ func resourceIKEPolicyV2() *schema.Resource {
 				Optional: true,
 			},
 			"auth_algorithm": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "sha1",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "sha1",
+				ValidateFunc: validation.StringInSlice(vpnaasPolicyV2AuthAlgorithms, false),
 			},
 			"encryption_algorithm": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "aes-128",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "aes-128",
+				ValidateFunc: validation.StringInSlice(vpnaasPolicyV2EncryptionAlgorithms, false),
 			},
 			"pfs": {
 				Type:     schema.TypeString,
@@ -58,9 +61,10 @@ func resourceIKEPolicyV2() *schema.Resource {
 				Default:  "group5",
 			},
 			"phase1_negotiation_mode": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "main",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "main",
+				ValidateFunc: validation.StringInSlice(vpnaasIKEPolicyV2Phase1NegotiationModes, false),
 			},
 			"ike_version": {
 				Type:     schema.TypeString,
@@ -232,8 +236,8 @@ func resourceIKEPolicyV2Update(ctx context.Context, d *schema.ResourceData, meta
 		opts.EncryptionAlgorithm = resourceIKEPolicyV2EncryptionAlgorithm(d.Get("encryption_algorithm").(string))
 		hasChange = true
 	}
-	if d.HasChange("phase_1_negotiation_mode") {
-		opts.Phase1NegotiationMode = resourceIKEPolicyV2Phase1NegotiationMode(d.Get("phase_1_negotiation_mode").(string))
+	if d.HasChange("phase1_negotiation_mode") {
+		opts.Phase1NegotiationMode = resourceIKEPolicyV2Phase1NegotiationMode(d.Get("phase1_negotiation_mode").(string))
 		hasChange = true
 	}
 	if d.HasChange("ike_version") {
@@ -250,7 +254,7 @@ func resourceIKEPolicyV2Update(ctx context.Context, d *schema.ResourceData, meta
 	log.Printf("[DEBUG] Updating IKE policy with id %s: %#v", d.Id(), opts)
 
 	if hasChange {
-		err = ikepolicies.Update(networkingClient, d.Id(), opts).Err
+		err = ikepolicies.Update(networkingClient, d.Id(), IKEPolicyUpdateOpts{opts}).Err
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -327,35 +331,11 @@ func waitForIKEPolicyUpdate(networkingClient *gophercloud.ServiceClient, id stri
 }
 
 func resourceIKEPolicyV2AuthAlgorithm(v string) ikepolicies.AuthAlgorithm {
-	var authAlgorithm ikepolicies.AuthAlgorithm
-	switch v {
-	case "sha1":
-		authAlgorithm = ikepolicies.AuthAlgorithmSHA1
-	case "sha256":
-		authAlgorithm = ikepolicies.AuthAlgorithmSHA256
-	case "sha384":
-		authAlgorithm = ikepolicies.AuthAlgorithmSHA384
-	case "sha512":
-		authAlgorithm = ikepolicies.AuthAlgorithmSHA512
-	}
-
-	return authAlgorithm
+	return ikepolicies.AuthAlgorithm(v)
 }
 
 func resourceIKEPolicyV2EncryptionAlgorithm(v string) ikepolicies.EncryptionAlgorithm {
-	var encryptionAlgorithm ikepolicies.EncryptionAlgorithm
-	switch v {
-	case "3des":
-		encryptionAlgorithm = ikepolicies.EncryptionAlgorithm3DES
-	case "aes-128":
-		encryptionAlgorithm = ikepolicies.EncryptionAlgorithmAES128
-	case "aes-192":
-		encryptionAlgorithm = ikepolicies.EncryptionAlgorithmAES192
-	case "aes-256":
-		encryptionAlgorithm = ikepolicies.EncryptionAlgorithmAES256
-	}
-
-	return encryptionAlgorithm
+	return ikepolicies.EncryptionAlgorithm(v)
 }
 
 func resourceIKEPolicyV2PFS(v string) ikepolicies.PFS {
@@ -383,12 +363,7 @@ func resourceIKEPolicyV2IKEVersion(v string) ikepolicies.IKEVersion {
 }
 
 func resourceIKEPolicyV2Phase1NegotiationMode(v string) ikepolicies.Phase1NegotiationMode {
-	var phase1NegotiationMode ikepolicies.Phase1NegotiationMode
-	switch v {
-	case "main":
-		phase1NegotiationMode = ikepolicies.Phase1NegotiationModeMain
-	}
-	return phase1NegotiationMode
+	return ikepolicies.Phase1NegotiationMode(v)
 }
 
 func resourceIKEPolicyV2Unit(v string) ikepolicies.Unit {
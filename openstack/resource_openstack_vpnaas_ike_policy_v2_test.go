@@ -86,6 +86,19 @@ func TestAccIKEPolicyV2_Update(t *testing.T) {
 					resource.TestCheckResourceAttrPtr("openstack_vpnaas_ike_policy_v2.policy_1", "name", &policy.Name),
 				),
 			},
+			{
+				Config: testAccIKEPolicyV2UpdateAlgorithms,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIKEPolicyV2Exists(
+						"openstack_vpnaas_ike_policy_v2.policy_1", &policy),
+					resource.TestCheckResourceAttr("openstack_vpnaas_ike_policy_v2.policy_1", "auth_algorithm", "sha512"),
+					resource.TestCheckResourceAttr("openstack_vpnaas_ike_policy_v2.policy_1", "encryption_algorithm", "aes-256-gcm-16"),
+					resource.TestCheckResourceAttr("openstack_vpnaas_ike_policy_v2.policy_1", "phase1_negotiation_mode", "aggressive"),
+					resource.TestCheckResourceAttrPtr("openstack_vpnaas_ike_policy_v2.policy_1", "auth_algorithm", &policy.AuthAlgorithm),
+					resource.TestCheckResourceAttrPtr("openstack_vpnaas_ike_policy_v2.policy_1", "encryption_algorithm", &policy.EncryptionAlgorithm),
+					resource.TestCheckResourceAttrPtr("openstack_vpnaas_ike_policy_v2.policy_1", "phase1_negotiation_mode", &policy.Phase1NegotiationMode),
+				),
+			},
 		},
 	})
 }
@@ -182,6 +195,15 @@ resource "openstack_vpnaas_ike_policy_v2" "policy_1" {
 }
 `
 
+const testAccIKEPolicyV2UpdateAlgorithms = `
+resource "openstack_vpnaas_ike_policy_v2" "policy_1" {
+	name = "updatedname"
+	auth_algorithm = "sha512"
+	encryption_algorithm = "aes-256-gcm-16"
+	phase1_negotiation_mode = "aggressive"
+}
+`
+
 const testAccIKEPolicyV2WithLifetime = `
 resource "openstack_vpnaas_ike_policy_v2" "policy_1" {
 	auth_algorithm = "sha256"
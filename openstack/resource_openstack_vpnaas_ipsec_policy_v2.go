@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/vpnaas/ipsecpolicies"
@@ -39,9 +40,10 @@ func resourceIPSecPolicyV2() *schema.Resource {
 				Optional: true,
 			},
 			"auth_algorithm": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(vpnaasPolicyV2AuthAlgorithms, false),
 			},
 			"encapsulation_mode": {
 				Type:     schema.TypeString,
@@ -54,9 +56,10 @@ func resourceIPSecPolicyV2() *schema.Resource {
 				Computed: true,
 			},
 			"encryption_algorithm": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(vpnaasPolicyV2EncryptionAlgorithms, false),
 			},
 			"description": {
 				Type:     schema.TypeString,
@@ -360,32 +363,10 @@ func resourceIPSecPolicyV2PFS(pfsString string) ipsecpolicies.PFS {
 	return pfs
 }
 func resourceIPSecPolicyV2EncryptionAlgorithm(encryptionAlgo string) ipsecpolicies.EncryptionAlgorithm {
-	var alg ipsecpolicies.EncryptionAlgorithm
-	switch encryptionAlgo {
-	case "3des":
-		alg = ipsecpolicies.EncryptionAlgorithm3DES
-	case "aes-128":
-		alg = ipsecpolicies.EncryptionAlgorithmAES128
-	case "aes-256":
-		alg = ipsecpolicies.EncryptionAlgorithmAES256
-	case "aes-192":
-		alg = ipsecpolicies.EncryptionAlgorithmAES192
-	}
-	return alg
+	return ipsecpolicies.EncryptionAlgorithm(encryptionAlgo)
 }
 func resourceIPSecPolicyV2AuthAlgorithm(authAlgo string) ipsecpolicies.AuthAlgorithm {
-	var alg ipsecpolicies.AuthAlgorithm
-	switch authAlgo {
-	case "sha1":
-		alg = ipsecpolicies.AuthAlgorithmSHA1
-	case "sha256":
-		alg = ipsecpolicies.AuthAlgorithmSHA256
-	case "sha384":
-		alg = ipsecpolicies.AuthAlgorithmSHA384
-	case "sha512":
-		alg = ipsecpolicies.AuthAlgorithmSHA512
-	}
-	return alg
+	return ipsecpolicies.AuthAlgorithm(authAlgo)
 }
 func resourceIPSecPolicyV2EncapsulationMode(encMode string) ipsecpolicies.EncapsulationMode {
 	var mode ipsecpolicies.EncapsulationMode
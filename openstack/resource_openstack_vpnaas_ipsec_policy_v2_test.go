@@ -93,6 +93,17 @@ func TestAccIPSecPolicyV2_Update(t *testing.T) {
 					resource.TestCheckResourceAttrPtr("openstack_vpnaas_ipsec_policy_v2.policy_1", "name", &policy.Name),
 				),
 			},
+			{
+				Config: testAccIPSecPolicyV2UpdateAlgorithms,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPSecPolicyV2Exists(
+						"openstack_vpnaas_ipsec_policy_v2.policy_1", &policy),
+					resource.TestCheckResourceAttr("openstack_vpnaas_ipsec_policy_v2.policy_1", "auth_algorithm", "sha384"),
+					resource.TestCheckResourceAttr("openstack_vpnaas_ipsec_policy_v2.policy_1", "encryption_algorithm", "aes-256-gcm-16"),
+					resource.TestCheckResourceAttrPtr("openstack_vpnaas_ipsec_policy_v2.policy_1", "auth_algorithm", &policy.AuthAlgorithm),
+					resource.TestCheckResourceAttrPtr("openstack_vpnaas_ipsec_policy_v2.policy_1", "encryption_algorithm", &policy.EncryptionAlgorithm),
+				),
+			},
 		},
 	})
 }
@@ -223,6 +234,14 @@ resource "openstack_vpnaas_ipsec_policy_v2" "policy_1" {
 }
 `
 
+const testAccIPSecPolicyV2UpdateAlgorithms = `
+resource "openstack_vpnaas_ipsec_policy_v2" "policy_1" {
+	name = "updatedname"
+	auth_algorithm = "sha384"
+	encryption_algorithm = "aes-256-gcm-16"
+}
+`
+
 const testAccIPSecPolicyV2WithLifetime = `
 resource "openstack_vpnaas_ipsec_policy_v2" "policy_1" {
 	auth_algorithm = "sha256"
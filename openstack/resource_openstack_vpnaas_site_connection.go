@@ -139,6 +139,11 @@ func resourceSiteConnectionV2() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"wait_for_active": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -334,7 +339,7 @@ func resourceSiteConnectionV2Update(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if d.HasChange("peer_cidrs") {
-		opts.PeerCIDRs = d.Get("peer_cidrs").([]string)
+		opts.PeerCIDRs = expandToStringSlice(d.Get("peer_cidrs").([]interface{}))
 		hasChange = true
 	}
 
@@ -358,7 +363,7 @@ func resourceSiteConnectionV2Update(ctx context.Context, d *schema.ResourceData,
 			Pending:    []string{"PENDING_UPDATE"},
 			Target:     []string{"UPDATED"},
 			Refresh:    waitForSiteConnectionUpdate(networkingClient, conn.ID),
-			Timeout:    d.Timeout(schema.TimeoutCreate),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
 			Delay:      0,
 			MinTimeout: 2 * time.Second,
 		}
@@ -368,6 +373,23 @@ func resourceSiteConnectionV2Update(ctx context.Context, d *schema.ResourceData,
 			return diag.FromErr(err)
 		}
 
+		// Wait for the tunnel to be renegotiated.
+		if d.Get("wait_for_active").(bool) {
+			stateConf := &resource.StateChangeConf{
+				Pending:    []string{"PENDING_CREATE", "PENDING_UPDATE", "BUILD", "DOWN"},
+				Target:     []string{"ACTIVE"},
+				Refresh:    waitForSiteConnectionActive(networkingClient, conn.ID),
+				Timeout:    d.Timeout(schema.TimeoutUpdate),
+				Delay:      0,
+				MinTimeout: 2 * time.Second,
+			}
+			_, err = stateConf.WaitForStateContext(ctx)
+
+			if err != nil {
+				return diag.Errorf("Error waiting for site connection %s to become active: %s", d.Id(), err)
+			}
+		}
+
 		log.Printf("[DEBUG] Updated connection with id %s", d.Id())
 	}
 
@@ -441,6 +463,21 @@ func waitForSiteConnectionUpdate(networkingClient *gophercloud.ServiceClient, id
 	}
 }
 
+func waitForSiteConnectionActive(networkingClient *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		conn, err := siteconnections.Get(networkingClient, id).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		if conn.Status == "ERROR" {
+			return conn, conn.Status, fmt.Errorf("site connection %s is in an error state", id)
+		}
+
+		return conn, conn.Status, nil
+	}
+}
+
 func resourceSiteConnectionV2Initiator(initatorString string) siteconnections.Initiator {
 	var ini siteconnections.Initiator
 	switch initatorString {
@@ -42,6 +42,21 @@ func TestAccSiteConnectionV2_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("openstack_vpnaas_site_connection_v2.conn_1", "dpd.0.interval", strconv.Itoa(conn.DPD.Interval)),
 				),
 			},
+			{
+				Config: testAccSiteConnectionV2Update(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSiteConnectionV2Exists(
+						"openstack_vpnaas_site_connection_v2.conn_1", &conn),
+					resource.TestCheckResourceAttr("openstack_vpnaas_site_connection_v2.conn_1", "psk", "secret_update"),
+					resource.TestCheckResourceAttr("openstack_vpnaas_site_connection_v2.conn_1", "mtu", "1400"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_vpnaas_site_connection_v2.conn_1", "peer_ep_group_id",
+						"openstack_vpnaas_endpoint_group_v2.group_3", "id"),
+					resource.TestCheckResourceAttr("openstack_vpnaas_site_connection_v2.conn_1", "dpd.0.action", "hold"),
+					resource.TestCheckResourceAttr("openstack_vpnaas_site_connection_v2.conn_1", "dpd.0.timeout", "60"),
+					resource.TestCheckResourceAttr("openstack_vpnaas_site_connection_v2.conn_1", "dpd.0.interval", "30"),
+				),
+			},
 		},
 	})
 }
@@ -158,3 +173,71 @@ func testAccSiteConnectionV2Basic() string {
 	}
 	`, osExtGwID)
 }
+
+func testAccSiteConnectionV2Update() string {
+	return fmt.Sprintf(`
+	resource "openstack_networking_network_v2" "network_1" {
+		name           = "tf_test_network"
+  		admin_state_up = "true"
+	}
+
+	resource "openstack_networking_subnet_v2" "subnet_1" {
+  		network_id = "${openstack_networking_network_v2.network_1.id}"
+  		cidr       = "192.168.199.0/24"
+  		ip_version = 4
+	}
+
+	resource "openstack_networking_router_v2" "router_1" {
+  		name             = "my_router"
+  		external_network_id = "%s"
+	}
+
+	resource "openstack_networking_router_interface_v2" "router_interface_1" {
+  		router_id = "${openstack_networking_router_v2.router_1.id}"
+  		subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+	}
+
+	resource "openstack_vpnaas_service_v2" "service_1" {
+		router_id = "${openstack_networking_router_v2.router_1.id}",
+		admin_state_up = "false"
+	}
+
+	resource "openstack_vpnaas_ipsec_policy_v2" "policy_1" {
+	}
+
+	resource "openstack_vpnaas_ike_policy_v2" "policy_2" {
+	}
+
+	resource "openstack_vpnaas_endpoint_group_v2" "group_1" {
+		type = "cidr"
+		endpoints = ["10.0.0.24/24", "10.0.0.25/24"]
+	}
+	resource "openstack_vpnaas_endpoint_group_v2" "group_3" {
+		type = "cidr"
+		endpoints = ["10.0.1.24/24"]
+	}
+	resource "openstack_vpnaas_endpoint_group_v2" "group_2" {
+		type = "subnet"
+		endpoints = [ "${openstack_networking_subnet_v2.subnet_1.id}" ]
+	}
+
+	resource "openstack_vpnaas_site_connection_v2" "conn_1" {
+		name = "connection_1"
+		ikepolicy_id = "${openstack_vpnaas_ike_policy_v2.policy_2.id}"
+		ipsecpolicy_id = "${openstack_vpnaas_ipsec_policy_v2.policy_1.id}"
+		vpnservice_id = "${openstack_vpnaas_service_v2.service_1.id}"
+		psk = "secret_update"
+		mtu = 1400
+		peer_address = "192.168.10.1"
+		peer_id = "192.168.10.1"
+		local_ep_group_id = "${openstack_vpnaas_endpoint_group_v2.group_2.id}"
+		peer_ep_group_id = "${openstack_vpnaas_endpoint_group_v2.group_3.id}"
+		dpd {
+			action   = "hold"
+			timeout  = 60
+			interval = 30
+		}
+		depends_on = ["openstack_networking_router_interface_v2.router_interface_1"]
+	}
+	`, osExtGwID)
+}
@@ -0,0 +1,95 @@
+package openstack
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// sharedFilesystemQuotaV2 represents the Manila quota of a project or of a
+// share type of a project.
+type sharedFilesystemQuotaV2 struct {
+	Shares              int `json:"shares"`
+	Gigabytes           int `json:"gigabytes"`
+	Snapshots           int `json:"snapshots"`
+	SnapshotGigabytes   int `json:"snapshot_gigabytes"`
+	ShareNetworks       int `json:"share_networks"`
+	ShareGroups         int `json:"share_groups"`
+	ShareGroupSnapshots int `json:"share_group_snapshots"`
+}
+
+// sharedFilesystemQuotaV2UpdateOpts represents the attributes used when
+// updating a quota.
+type sharedFilesystemQuotaV2UpdateOpts struct {
+	Shares              *int `json:"shares,omitempty"`
+	Gigabytes           *int `json:"gigabytes,omitempty"`
+	Snapshots           *int `json:"snapshots,omitempty"`
+	SnapshotGigabytes   *int `json:"snapshot_gigabytes,omitempty"`
+	ShareNetworks       *int `json:"share_networks,omitempty"`
+	ShareGroups         *int `json:"share_groups,omitempty"`
+	ShareGroupSnapshots *int `json:"share_group_snapshots,omitempty"`
+}
+
+type sharedFilesystemQuotaV2Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets a sharedFilesystemQuotaV2Result as a quota.
+func (r sharedFilesystemQuotaV2Result) Extract() (*sharedFilesystemQuotaV2, error) {
+	var s struct {
+		QuotaSet *sharedFilesystemQuotaV2 `json:"quota_set"`
+	}
+	err := r.ExtractInto(&s)
+	return s.QuotaSet, err
+}
+
+// sharedFilesystemQuotaV2URL returns the URL of the quota of a project, which
+// is scoped to a share type, when shareType is not empty.
+func sharedFilesystemQuotaV2URL(client *gophercloud.ServiceClient, projectID, shareType string) string {
+	u := client.ServiceURL("quota-sets", projectID)
+	if shareType != "" {
+		u += "?" + url.Values{"share_type": {shareType}}.Encode()
+	}
+
+	return u
+}
+
+func sharedFilesystemQuotaV2Get(client *gophercloud.ServiceClient, projectID, shareType string) (r sharedFilesystemQuotaV2Result) {
+	resp, err := client.Get(sharedFilesystemQuotaV2URL(client, projectID, shareType), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func sharedFilesystemQuotaV2Update(client *gophercloud.ServiceClient, projectID, shareType string, opts sharedFilesystemQuotaV2UpdateOpts) (r sharedFilesystemQuotaV2Result) {
+	b, err := gophercloud.BuildRequestBody(opts, "quota_set")
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Put(sharedFilesystemQuotaV2URL(client, projectID, shareType), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// sharedFilesystemQuotaV2Delete resets the quota to the default values.
+func sharedFilesystemQuotaV2Delete(client *gophercloud.ServiceClient, projectID, shareType string) (r gophercloud.ErrResult) {
+	resp, err := client.Delete(sharedFilesystemQuotaV2URL(client, projectID, shareType), &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// parseSharedFilesystemQuotaV2ID parses the <project_id> or the
+// <project_id>/<share_type> ID of the quota.
+func parseSharedFilesystemQuotaV2ID(id string) (string, string) {
+	idParts := strings.SplitN(id, "/", 2)
+	if len(idParts) == 1 {
+		return idParts[0], ""
+	}
+
+	return idParts[0], idParts[1]
+}
@@ -7,6 +7,63 @@ import (
 	"github.com/gophercloud/gophercloud/openstack/sharedfilesystems/v2/shares"
 )
 
+// sharedFilesystemShareAccessV2 represents an access rule including its
+// metadata, which is returned by the access rules API since 2.45.
+type sharedFilesystemShareAccessV2 struct {
+	shares.AccessRight
+	Metadata map[string]string `json:"metadata"`
+}
+
+// sharedFilesystemShareAccessV2GrantOpts represents the attributes used when
+// granting an access rule.
+type sharedFilesystemShareAccessV2GrantOpts struct {
+	shares.GrantAccessOpts
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// ToGrantAccessMap assembles a request body based on the contents of a
+// sharedFilesystemShareAccessV2GrantOpts.
+// It overrides shares.ToGrantAccessMap to add the metadata field.
+func (opts sharedFilesystemShareAccessV2GrantOpts) ToGrantAccessMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "allow_access")
+}
+
+type sharedFilesystemShareAccessV2Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets a sharedFilesystemShareAccessV2Result as an access rule.
+func (r sharedFilesystemShareAccessV2Result) Extract() (*sharedFilesystemShareAccessV2, error) {
+	var s struct {
+		Access *sharedFilesystemShareAccessV2 `json:"access"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Access, err
+}
+
+func sharedFilesystemShareAccessV2Get(client *gophercloud.ServiceClient, id string) (r sharedFilesystemShareAccessV2Result) {
+	resp, err := client.Get(client.ServiceURL("share-access-rules", id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func sharedFilesystemShareAccessV2UpdateMetadata(client *gophercloud.ServiceClient, id string, metadata map[string]string) (r gophercloud.ErrResult) {
+	b := map[string]interface{}{"metadata": metadata}
+	resp, err := client.Put(client.ServiceURL("share-access-rules", id, "metadata"), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func sharedFilesystemShareAccessV2DeleteMetadatum(client *gophercloud.ServiceClient, id, key string) (r gophercloud.ErrResult) {
+	resp, err := client.Delete(client.ServiceURL("share-access-rules", id, "metadata", key), &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
 func sharedFilesystemShareAccessV2StateRefreshFunc(client *gophercloud.ServiceClient, shareID string, accessID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		access, err := shares.ListAccessRights(client, shareID).Extract()
@@ -0,0 +1,151 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// sharedFilesystemShareGroupSnapshotV2 represents a Manila share group
+// snapshot.
+type sharedFilesystemShareGroupSnapshotV2 struct {
+	ID           string                                       `json:"id"`
+	Name         string                                       `json:"name"`
+	Description  string                                       `json:"description"`
+	Status       string                                       `json:"status"`
+	ShareGroupID string                                       `json:"share_group_id"`
+	ProjectID    string                                       `json:"project_id"`
+	Members      []sharedFilesystemShareGroupSnapshotV2Member `json:"members"`
+}
+
+// sharedFilesystemShareGroupSnapshotV2Member represents the snapshot of a
+// member share of a share group.
+type sharedFilesystemShareGroupSnapshotV2Member struct {
+	ID      string `json:"id"`
+	ShareID string `json:"share_id"`
+	Size    int    `json:"size"`
+}
+
+// sharedFilesystemShareGroupSnapshotV2CreateOpts represents the attributes
+// used when creating a new share group snapshot.
+type sharedFilesystemShareGroupSnapshotV2CreateOpts struct {
+	ShareGroupID string `json:"share_group_id" required:"true"`
+	Name         string `json:"name,omitempty"`
+	Description  string `json:"description,omitempty"`
+}
+
+// sharedFilesystemShareGroupSnapshotV2UpdateOpts represents the attributes
+// used when updating an existing share group snapshot.
+type sharedFilesystemShareGroupSnapshotV2UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+type sharedFilesystemShareGroupSnapshotV2Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets a sharedFilesystemShareGroupSnapshotV2Result as a share
+// group snapshot.
+func (r sharedFilesystemShareGroupSnapshotV2Result) Extract() (*sharedFilesystemShareGroupSnapshotV2, error) {
+	var s struct {
+		ShareGroupSnapshot *sharedFilesystemShareGroupSnapshotV2 `json:"share_group_snapshot"`
+	}
+	err := r.ExtractInto(&s)
+	return s.ShareGroupSnapshot, err
+}
+
+func sharedFilesystemShareGroupSnapshotV2Create(client *gophercloud.ServiceClient, opts sharedFilesystemShareGroupSnapshotV2CreateOpts) (r sharedFilesystemShareGroupSnapshotV2Result) {
+	b, err := gophercloud.BuildRequestBody(opts, "share_group_snapshot")
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(client.ServiceURL("share-group-snapshots"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func sharedFilesystemShareGroupSnapshotV2Get(client *gophercloud.ServiceClient, id string) (r sharedFilesystemShareGroupSnapshotV2Result) {
+	resp, err := client.Get(client.ServiceURL("share-group-snapshots", id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func sharedFilesystemShareGroupSnapshotV2Update(client *gophercloud.ServiceClient, id string, opts sharedFilesystemShareGroupSnapshotV2UpdateOpts) (r sharedFilesystemShareGroupSnapshotV2Result) {
+	b, err := gophercloud.BuildRequestBody(opts, "share_group_snapshot")
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Put(client.ServiceURL("share-group-snapshots", id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func sharedFilesystemShareGroupSnapshotV2Delete(client *gophercloud.ServiceClient, id string) (r gophercloud.ErrResult) {
+	resp, err := client.Delete(client.ServiceURL("share-group-snapshots", id), &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func flattenSharedFilesystemShareGroupSnapshotV2Members(members []sharedFilesystemShareGroupSnapshotV2Member) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(members))
+	for _, v := range members {
+		res = append(res, map[string]interface{}{
+			"id":       v.ID,
+			"share_id": v.ShareID,
+			"size":     v.Size,
+		})
+	}
+
+	return res
+}
+
+// sharedFilesystemShareGroupSnapshotV2StateRefreshFunc reports the status of
+// a share group snapshot. A deleted snapshot is reported as "deleted".
+func sharedFilesystemShareGroupSnapshotV2StateRefreshFunc(client *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		snapshot, err := sharedFilesystemShareGroupSnapshotV2Get(client, id).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return snapshot, "deleted", nil
+			}
+			return nil, "", err
+		}
+
+		return snapshot, snapshot.Status, nil
+	}
+}
+
+// Full list of the share group snapshot statuses:
+// https://docs.openstack.org/api-ref/shared-file-system/#share-group-snapshots-since-api-v2-31
+func waitForSharedFilesystemShareGroupSnapshotV2(ctx context.Context, client *gophercloud.ServiceClient, id string, target []string, pending []string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for share group snapshot %s to become %v.", id, target)
+
+	stateConf := &resource.StateChangeConf{
+		Target:     target,
+		Pending:    pending,
+		Refresh:    sharedFilesystemShareGroupSnapshotV2StateRefreshFunc(client, id),
+		Timeout:    timeout,
+		Delay:      1 * time.Second,
+		MinTimeout: 1 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("Error waiting for share group snapshot %s to become %v: %s", id, target, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,193 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/sharedfilesystems/v2/shares"
+)
+
+// sharedFilesystemShareGroupV2 represents a Manila share group.
+type sharedFilesystemShareGroupV2 struct {
+	ID                        string   `json:"id"`
+	Name                      string   `json:"name"`
+	Description               string   `json:"description"`
+	Status                    string   `json:"status"`
+	ShareGroupTypeID          string   `json:"share_group_type_id"`
+	ShareTypes                []string `json:"share_types"`
+	ShareNetworkID            string   `json:"share_network_id"`
+	AvailabilityZone          string   `json:"availability_zone"`
+	ProjectID                 string   `json:"project_id"`
+	ShareServerID             string   `json:"share_server_id"`
+	Host                      string   `json:"host"`
+	ConsistentSnapshotSupport string   `json:"consistent_snapshot_support"`
+}
+
+// sharedFilesystemShareGroupV2CreateOpts represents the attributes used when
+// creating a new share group.
+type sharedFilesystemShareGroupV2CreateOpts struct {
+	Name             string   `json:"name,omitempty"`
+	Description      string   `json:"description,omitempty"`
+	ShareGroupTypeID string   `json:"share_group_type_id,omitempty"`
+	ShareTypes       []string `json:"share_types,omitempty"`
+	ShareNetworkID   string   `json:"share_network_id,omitempty"`
+	AvailabilityZone string   `json:"availability_zone,omitempty"`
+}
+
+// sharedFilesystemShareGroupV2UpdateOpts represents the attributes used when
+// updating an existing share group.
+type sharedFilesystemShareGroupV2UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+type sharedFilesystemShareGroupV2Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets a sharedFilesystemShareGroupV2Result as a share group.
+func (r sharedFilesystemShareGroupV2Result) Extract() (*sharedFilesystemShareGroupV2, error) {
+	var s struct {
+		ShareGroup *sharedFilesystemShareGroupV2 `json:"share_group"`
+	}
+	err := r.ExtractInto(&s)
+	return s.ShareGroup, err
+}
+
+func sharedFilesystemShareGroupV2Create(client *gophercloud.ServiceClient, opts sharedFilesystemShareGroupV2CreateOpts) (r sharedFilesystemShareGroupV2Result) {
+	b, err := gophercloud.BuildRequestBody(opts, "share_group")
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(client.ServiceURL("share-groups"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func sharedFilesystemShareGroupV2Get(client *gophercloud.ServiceClient, id string) (r sharedFilesystemShareGroupV2Result) {
+	resp, err := client.Get(client.ServiceURL("share-groups", id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func sharedFilesystemShareGroupV2Update(client *gophercloud.ServiceClient, id string, opts sharedFilesystemShareGroupV2UpdateOpts) (r sharedFilesystemShareGroupV2Result) {
+	b, err := gophercloud.BuildRequestBody(opts, "share_group")
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Put(client.ServiceURL("share-groups", id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func sharedFilesystemShareGroupV2Delete(client *gophercloud.ServiceClient, id string) (r gophercloud.ErrResult) {
+	resp, err := client.Delete(client.ServiceURL("share-groups", id), &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// sharedFilesystemShareGroupV2StateRefreshFunc reports the status of a share
+// group. A deleted share group is reported as "deleted".
+func sharedFilesystemShareGroupV2StateRefreshFunc(client *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		shareGroup, err := sharedFilesystemShareGroupV2Get(client, id).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return shareGroup, "deleted", nil
+			}
+			return nil, "", err
+		}
+
+		return shareGroup, shareGroup.Status, nil
+	}
+}
+
+// Full list of the share group statuses:
+// https://docs.openstack.org/api-ref/shared-file-system/#share-groups-since-api-v2-31
+func waitForSharedFilesystemShareGroupV2(ctx context.Context, client *gophercloud.ServiceClient, id string, target []string, pending []string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for share group %s to become %v.", id, target)
+
+	stateConf := &resource.StateChangeConf{
+		Target:     target,
+		Pending:    pending,
+		Refresh:    sharedFilesystemShareGroupV2StateRefreshFunc(client, id),
+		Timeout:    timeout,
+		Delay:      1 * time.Second,
+		MinTimeout: 1 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("Error waiting for share group %s to become %v: %s", id, target, err)
+	}
+
+	return nil
+}
+
+// sharedFilesystemShareGroupV2MembersRefreshFunc reports whether a share
+// group still has member shares. Member shares, which are not being deleted,
+// are reported as an error, since they would block the share group deletion.
+func sharedFilesystemShareGroupV2MembersRefreshFunc(client *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		allPages, err := shares.ListDetail(client, shares.ListOpts{ShareGroupID: id}).AllPages()
+		if err != nil {
+			return nil, "", err
+		}
+
+		allShares, err := shares.ExtractShares(allPages)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if len(allShares) == 0 {
+			return allShares, "empty", nil
+		}
+
+		var members []string
+		for _, share := range allShares {
+			if share.Status != "deleting" {
+				members = append(members, share.ID)
+			}
+		}
+
+		if len(members) > 0 {
+			return allShares, "", fmt.Errorf("share group %s still has member shares, which must be deleted first: %s", id, strings.Join(members, ", "))
+		}
+
+		return allShares, "deleting", nil
+	}
+}
+
+// waitForSharedFilesystemShareGroupV2Members waits for the member shares of a
+// share group to be deleted.
+func waitForSharedFilesystemShareGroupV2Members(ctx context.Context, client *gophercloud.ServiceClient, id string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for the member shares of share group %s to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{"empty"},
+		Pending:    []string{"deleting"},
+		Refresh:    sharedFilesystemShareGroupV2MembersRefreshFunc(client, id),
+		Timeout:    timeout,
+		Delay:      1 * time.Second,
+		MinTimeout: 1 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("Error waiting for the member shares of share group %s to be deleted: %s", id, err)
+	}
+
+	return nil
+}
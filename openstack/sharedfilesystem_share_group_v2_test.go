@@ -0,0 +1,41 @@
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestSharedFilesystemShareGroupV2MembersRefreshFunc(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var members string
+	th.Mux.HandleFunc("/shares/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestFormValues(t, r, map[string]string{"share_group_id": "group-1"})
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"shares": [%s]}`, members)
+	})
+
+	refresh := sharedFilesystemShareGroupV2MembersRefreshFunc(thclient.ServiceClient(), "group-1")
+
+	members = `{"id": "share-1", "status": "deleting"}`
+	_, actual, err := refresh()
+	assert.NoError(t, err)
+	assert.Equal(t, "deleting", actual)
+
+	members = ""
+	_, actual, err = refresh()
+	assert.NoError(t, err)
+	assert.Equal(t, "empty", actual)
+
+	members = `{"id": "share-1", "status": "deleting"}, {"id": "share-2", "status": "available"}`
+	_, _, err = refresh()
+	assert.EqualError(t, err, "share group group-1 still has member shares, which must be deleted first: share-2")
+}
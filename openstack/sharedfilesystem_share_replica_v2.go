@@ -0,0 +1,166 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// sharedFilesystemShareReplicaV2 represents a Manila share replica.
+type sharedFilesystemShareReplicaV2 struct {
+	ID                  string `json:"id"`
+	ShareID             string `json:"share_id"`
+	AvailabilityZone    string `json:"availability_zone"`
+	Status              string `json:"status"`
+	ReplicaState        string `json:"replica_state"`
+	ShareNetworkID      string `json:"share_network_id"`
+	ShareServerID       string `json:"share_server_id"`
+	Host                string `json:"host"`
+	CastRulesToReadonly bool   `json:"cast_rules_to_readonly"`
+}
+
+// sharedFilesystemShareReplicaV2ExportLocation represents an export location
+// of a Manila share replica.
+type sharedFilesystemShareReplicaV2ExportLocation struct {
+	ID           string `json:"id"`
+	Path         string `json:"path"`
+	Preferred    bool   `json:"preferred"`
+	ReplicaState string `json:"replica_state"`
+}
+
+// sharedFilesystemShareReplicaV2CreateOpts represents the attributes used
+// when creating a new share replica.
+type sharedFilesystemShareReplicaV2CreateOpts struct {
+	ShareID          string `json:"share_id" required:"true"`
+	AvailabilityZone string `json:"availability_zone,omitempty"`
+}
+
+type sharedFilesystemShareReplicaV2Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets a sharedFilesystemShareReplicaV2Result as a share
+// replica.
+func (r sharedFilesystemShareReplicaV2Result) Extract() (*sharedFilesystemShareReplicaV2, error) {
+	var s struct {
+		ShareReplica *sharedFilesystemShareReplicaV2 `json:"share_replica"`
+	}
+	err := r.ExtractInto(&s)
+	return s.ShareReplica, err
+}
+
+type sharedFilesystemShareReplicaV2ExportLocationsResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a sharedFilesystemShareReplicaV2ExportLocationsResult as
+// a list of export locations.
+func (r sharedFilesystemShareReplicaV2ExportLocationsResult) Extract() ([]sharedFilesystemShareReplicaV2ExportLocation, error) {
+	var s struct {
+		ExportLocations []sharedFilesystemShareReplicaV2ExportLocation `json:"export_locations"`
+	}
+	err := r.ExtractInto(&s)
+	return s.ExportLocations, err
+}
+
+func sharedFilesystemShareReplicaV2Create(client *gophercloud.ServiceClient, opts sharedFilesystemShareReplicaV2CreateOpts) (r sharedFilesystemShareReplicaV2Result) {
+	b, err := gophercloud.BuildRequestBody(opts, "share_replica")
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(client.ServiceURL("share-replicas"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func sharedFilesystemShareReplicaV2Get(client *gophercloud.ServiceClient, id string) (r sharedFilesystemShareReplicaV2Result) {
+	resp, err := client.Get(client.ServiceURL("share-replicas", id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func sharedFilesystemShareReplicaV2Delete(client *gophercloud.ServiceClient, id string) (r gophercloud.ErrResult) {
+	resp, err := client.Delete(client.ServiceURL("share-replicas", id), &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// sharedFilesystemShareReplicaV2Promote makes the share replica the active
+// replica of its share.
+func sharedFilesystemShareReplicaV2Promote(client *gophercloud.ServiceClient, id string) (r gophercloud.ErrResult) {
+	b := map[string]interface{}{"promote": nil}
+	resp, err := client.Post(client.ServiceURL("share-replicas", id, "action"), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func sharedFilesystemShareReplicaV2ListExportLocations(client *gophercloud.ServiceClient, id string) (r sharedFilesystemShareReplicaV2ExportLocationsResult) {
+	resp, err := client.Get(client.ServiceURL("share-replicas", id, "export-locations"), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func flattenSharedFilesystemShareReplicaV2ExportLocations(exportLocations []sharedFilesystemShareReplicaV2ExportLocation) []map[string]string {
+	res := make([]map[string]string, 0, len(exportLocations))
+	for _, v := range exportLocations {
+		res = append(res, map[string]string{
+			"path":      v.Path,
+			"preferred": fmt.Sprint(v.Preferred),
+		})
+	}
+
+	return res
+}
+
+// sharedFilesystemShareReplicaV2StateRefreshFunc reports the status of a
+// share replica, or its replica_state once the status is available.
+func sharedFilesystemShareReplicaV2StateRefreshFunc(client *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		replica, err := sharedFilesystemShareReplicaV2Get(client, id).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return replica, "deleted", nil
+			}
+			return nil, "", err
+		}
+
+		if replica.Status != "available" {
+			return replica, replica.Status, nil
+		}
+
+		return replica, replica.ReplicaState, nil
+	}
+}
+
+// Full list of the share replica statuses and states:
+// https://docs.openstack.org/api-ref/shared-file-system/#share-replicas-since-api-v2-11
+func waitForSharedFilesystemShareReplicaV2(ctx context.Context, client *gophercloud.ServiceClient, id string, target []string, pending []string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for share replica %s to become %v.", id, target)
+
+	stateConf := &resource.StateChangeConf{
+		Target:     target,
+		Pending:    pending,
+		Refresh:    sharedFilesystemShareReplicaV2StateRefreshFunc(client, id),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("Error waiting for share replica %s to become %v: %s", id, target, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,202 @@
+package openstack
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/sharedfilesystems/v2/shares"
+	"github.com/gophercloud/gophercloud/openstack/sharedfilesystems/v2/snapshots"
+)
+
+// sharedFilesystemShareV2RevertToSnapshotSupport returns whether the share
+// type of a share supports reverting to a snapshot.
+// The client must have at least the 2.27 microversion set.
+func sharedFilesystemShareV2RevertToSnapshotSupport(client *gophercloud.ServiceClient, id string) (bool, error) {
+	var s struct {
+		Share struct {
+			RevertToSnapshotSupport bool `json:"revert_to_snapshot_support"`
+		} `json:"share"`
+	}
+
+	err := shares.Get(client, id).ExtractInto(&s)
+	if err != nil {
+		return false, err
+	}
+
+	return s.Share.RevertToSnapshotSupport, nil
+}
+
+// sharedFilesystemShareV2LatestSnapshot returns the most recent snapshot of
+// the list, or nil if the list is empty.
+func sharedFilesystemShareV2LatestSnapshot(allSnapshots []snapshots.Snapshot) *snapshots.Snapshot {
+	var latest *snapshots.Snapshot
+	for i := range allSnapshots {
+		if latest == nil || allSnapshots[i].CreatedAt.After(latest.CreatedAt) {
+			latest = &allSnapshots[i]
+		}
+	}
+
+	return latest
+}
+
+// sharedFilesystemShareV2CheckRevert ensures that a share can be reverted to
+// the snapshot, since Manila only supports reverting to the most recent
+// snapshot of a share, whose share type has the revert_to_snapshot_support
+// capability.
+func sharedFilesystemShareV2CheckRevert(client *gophercloud.ServiceClient, id string, snapshotID string) error {
+	supported, err := sharedFilesystemShareV2RevertToSnapshotSupport(client, id)
+	if err != nil {
+		return fmt.Errorf("Error retrieving share %s: %s", id, err)
+	}
+	if !supported {
+		return fmt.Errorf("The share type of share %s doesn't support reverting to a snapshot", id)
+	}
+
+	snapshot, err := snapshots.Get(client, snapshotID).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving snapshot %s: %s", snapshotID, err)
+	}
+	if snapshot.ShareID != id {
+		return fmt.Errorf("Snapshot %s doesn't belong to share %s", snapshotID, id)
+	}
+
+	allPages, err := snapshots.ListDetail(client, snapshots.ListOpts{ShareID: id}).AllPages()
+	if err != nil {
+		return fmt.Errorf("Error listing snapshots of share %s: %s", id, err)
+	}
+
+	allSnapshots, err := snapshots.ExtractSnapshots(allPages)
+	if err != nil {
+		return fmt.Errorf("Error extracting snapshots of share %s: %s", id, err)
+	}
+
+	latest := sharedFilesystemShareV2LatestSnapshot(allSnapshots)
+	if latest != nil && latest.ID != snapshotID {
+		return fmt.Errorf("Snapshot %s isn't the most recent snapshot of share %s, the share can only be reverted to snapshot %s", snapshotID, id, latest.ID)
+	}
+
+	return nil
+}
+
+// sharedFilesystemShareV2SizeCustomizeDiff ensures that the size of an
+// existing share is only decreased, when allow_shrink is true.
+func sharedFilesystemShareV2SizeCustomizeDiff(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" || !diff.HasChange("size") {
+		return nil
+	}
+
+	o, n := diff.GetChange("size")
+	if n.(int) < o.(int) && !diff.Get("allow_shrink").(bool) {
+		return fmt.Errorf("size can only be decreased from %d to %d if allow_shrink is true", o.(int), n.(int))
+	}
+
+	return nil
+}
+
+// sharedFilesystemShareV2ResizeRefreshFunc reports the status of a share
+// being resized. The resize error statuses, as well as an available share,
+// which doesn't have the requested size, are reported as errors.
+func sharedFilesystemShareV2ResizeRefreshFunc(client *gophercloud.ServiceClient, id string, size int) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		share, err := shares.Get(client, id).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		switch share.Status {
+		case "shrinking_possible_data_loss_error":
+			return share, share.Status, fmt.Errorf("share %s can't be shrunk to %d GB, its used space exceeds the new size (status: %s)", id, size, share.Status)
+		case "extending_error", "shrinking_error":
+			return share, share.Status, fmt.Errorf("share %s failed to be resized to %d GB (status: %s)", id, size, share.Status)
+		case "available":
+			if share.Size != size {
+				return share, share.Status, fmt.Errorf("share %s is available, but its size is %d GB instead of %d GB", id, share.Size, size)
+			}
+		}
+
+		return share, share.Status, nil
+	}
+}
+
+// sharedFilesystemShareV2Delete deletes a share, which is a member of a share
+// group. Manila requires the share group ID to be set in this case.
+func sharedFilesystemShareV2Delete(client *gophercloud.ServiceClient, id string, shareGroupID string) (r gophercloud.ErrResult) {
+	u := client.ServiceURL("shares", id) + "?" + url.Values{"share_group_id": {shareGroupID}}.Encode()
+	resp, err := client.Delete(u, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// sharedFilesystemShareV2ShareGroupID returns the ID of the share group of a
+// share. The client must have at least the 2.31 microversion set.
+func sharedFilesystemShareV2ShareGroupID(client *gophercloud.ServiceClient, id string) (string, error) {
+	var s struct {
+		Share struct {
+			ShareGroupID string `json:"share_group_id"`
+		} `json:"share"`
+	}
+
+	err := shares.Get(client, id).ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+
+	return s.Share.ShareGroupID, nil
+}
+
+// sharedFilesystemShareV2CreateOpts represents the attributes used when
+// creating a new share.
+type sharedFilesystemShareV2CreateOpts struct {
+	shares.CreateOpts
+	ShareGroupID string `json:"share_group_id,omitempty"`
+}
+
+// ToShareCreateMap casts a sharedFilesystemShareV2CreateOpts struct to a map.
+// It overrides shares.ToShareCreateMap to add the share_group_id field.
+func (opts sharedFilesystemShareV2CreateOpts) ToShareCreateMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "share")
+}
+
+func flattenSharedFilesystemShareV2ExportLocations(exportLocations []shares.ExportLocation) []map[string]string {
+	res := make([]map[string]string, 0, len(exportLocations))
+	for _, v := range exportLocations {
+		res = append(res, map[string]string{
+			"id":                v.ID,
+			"path":              v.Path,
+			"preferred":         fmt.Sprint(v.Preferred),
+			"is_admin_only":     fmt.Sprint(v.IsAdminOnly),
+			"share_instance_id": v.ShareInstanceID,
+		})
+	}
+
+	return res
+}
+
+// sharedFilesystemShareV2PreferredExportLocation returns the path of the
+// preferred export location, which is not admin only. If there is no
+// preferred export location, the first one, which is not admin only, is
+// returned.
+func sharedFilesystemShareV2PreferredExportLocation(exportLocations []shares.ExportLocation) string {
+	var path string
+	for _, v := range exportLocations {
+		if v.IsAdminOnly {
+			continue
+		}
+
+		if v.Preferred {
+			return v.Path
+		}
+
+		if path == "" {
+			path = v.Path
+		}
+	}
+
+	return path
+}
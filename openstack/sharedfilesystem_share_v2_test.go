@@ -0,0 +1,131 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gophercloud/gophercloud/openstack/sharedfilesystems/v2/shares"
+	"github.com/gophercloud/gophercloud/openstack/sharedfilesystems/v2/snapshots"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestSharedFilesystemShareV2LatestSnapshot(t *testing.T) {
+	now := time.Now()
+	allSnapshots := []snapshots.Snapshot{
+		{ID: "snapshot-1", CreatedAt: now.Add(-2 * time.Hour)},
+		{ID: "snapshot-3", CreatedAt: now},
+		{ID: "snapshot-2", CreatedAt: now.Add(-1 * time.Hour)},
+	}
+
+	assert.Equal(t, "snapshot-3", sharedFilesystemShareV2LatestSnapshot(allSnapshots).ID)
+	assert.Nil(t, sharedFilesystemShareV2LatestSnapshot(nil))
+}
+
+func TestSharedFilesystemShareV2ResizeRefreshFunc(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var status string
+	var size int
+	th.Mux.HandleFunc("/shares/share-1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"share": {"id": "share-1", "status": "%s", "size": %d}}`, status, size)
+	})
+
+	refresh := sharedFilesystemShareV2ResizeRefreshFunc(thclient.ServiceClient(), "share-1", 1)
+
+	status, size = "shrinking", 2
+	_, actual, err := refresh()
+	assert.NoError(t, err)
+	assert.Equal(t, "shrinking", actual)
+
+	status, size = "available", 1
+	_, actual, err = refresh()
+	assert.NoError(t, err)
+	assert.Equal(t, "available", actual)
+
+	status, size = "available", 2
+	_, _, err = refresh()
+	assert.Error(t, err)
+
+	status, size = "shrinking_possible_data_loss_error", 2
+	_, _, err = refresh()
+	assert.EqualError(t, err, "share share-1 can't be shrunk to 1 GB, its used space exceeds the new size (status: shrinking_possible_data_loss_error)")
+}
+
+func TestSharedFilesystemShareV2CreateOpts(t *testing.T) {
+	createOpts := sharedFilesystemShareV2CreateOpts{
+		CreateOpts: shares.CreateOpts{
+			Name:       "share_1",
+			ShareProto: "NFS",
+			Size:       1,
+		},
+		ShareGroupID: "group-1",
+	}
+
+	expected := map[string]interface{}{
+		"share": map[string]interface{}{
+			"name":           "share_1",
+			"share_proto":    "NFS",
+			"size":           float64(1),
+			"share_group_id": "group-1",
+		},
+	}
+
+	actual, err := createOpts.ToShareCreateMap()
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestSharedFilesystemShareV2PreferredExportLocation(t *testing.T) {
+	exportLocations := []shares.ExportLocation{
+		{Path: "10.0.0.1:/admin", IsAdminOnly: true, Preferred: true},
+		{Path: "10.0.0.2:/share"},
+		{Path: "10.0.0.3:/share", Preferred: true},
+	}
+
+	assert.Equal(t, "10.0.0.3:/share", sharedFilesystemShareV2PreferredExportLocation(exportLocations))
+	assert.Equal(t, "10.0.0.2:/share", sharedFilesystemShareV2PreferredExportLocation(exportLocations[:2]))
+	assert.Equal(t, "", sharedFilesystemShareV2PreferredExportLocation(exportLocations[:1]))
+}
+
+func TestSharedFilesystemShareV2ReadShareGroupID(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"versions": [{"id": "v2.0", "min_version": "2.0", "version": "2.40"}]}`)
+	})
+	th.Mux.HandleFunc("/shares/share-1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		if r.Header.Get("X-OpenStack-Manila-API-Version") == sharedFilesystemV2ShareGroupIDMicroversion {
+			fmt.Fprint(w, `{"share": {"id": "share-1", "share_group_id": "group-1"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"share": {"id": "share-1", "name": "share_1", "size": 1}}`)
+	})
+	th.Mux.HandleFunc("/shares/share-1/export_locations", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"export_locations": []}`)
+	})
+
+	config := testAccUnitConfig("sharev2")
+
+	d := resourceSharedFilesystemShareV2().Data(&terraform.InstanceState{ID: "share-1"})
+
+	diags := resourceSharedFilesystemShareV2Read(context.Background(), d, config)
+
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "group-1", d.Get("share_group_id"))
+	assert.Equal(t, "share_1", d.Get("name"))
+}
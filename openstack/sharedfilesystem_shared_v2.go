@@ -1,9 +1,58 @@
 package openstack
 
+import (
+	"fmt"
+	"sync"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/sharedfilesystems/apiversions"
+)
+
 const (
 	sharedFilesystemV2MinMicroversion = "2.7"
 
-	sharedFilesystemV2SecurityServiceOUMicroversion = "2.44"
-	sharedFilesystemV2SharedAccessCephXMicroversion = "2.13"
-	sharedFilesystemV2SharedAccessMinMicroversion   = "2.21"
+	sharedFilesystemV2SecurityServiceOUMicroversion     = "2.44"
+	sharedFilesystemV2SecurityServiceUpdateMicroversion = "2.63"
+	sharedFilesystemV2SharedAccessCephXMicroversion     = "2.13"
+	sharedFilesystemV2SharedAccessMinMicroversion       = "2.21"
+	sharedFilesystemV2SharedAccessRulesMicroversion     = "2.45"
+	sharedFilesystemV2ShareGroupMicroversion            = "2.55"
+	sharedFilesystemV2ShareGroupIDMicroversion          = "2.31"
+	sharedFilesystemV2QuotaMicroversion                 = "2.40"
+	sharedFilesystemV2ShareRevertMicroversion           = "2.27"
+	sharedFilesystemV2ShareReplicaMicroversion          = "2.56"
+	sharedFilesystemV2ShareTypeMicroversion             = "2.41"
+	sharedFilesystemV2ShareTypeUpdateMicroversion       = "2.50"
 )
+
+// sharedFilesystemV2MaxMicroversions caches the highest microversion supported
+// by each shared file system endpoint.
+var sharedFilesystemV2MaxMicroversions sync.Map
+
+// sharedFilesystemV2MaxMicroversion returns the highest microversion supported
+// by the shared file system API, which is read from the v2 version document.
+func sharedFilesystemV2MaxMicroversion(sfsClient *gophercloud.ServiceClient) (string, error) {
+	if v, ok := sharedFilesystemV2MaxMicroversions.Load(sfsClient.Endpoint); ok {
+		return v.(string), nil
+	}
+
+	version, err := apiversions.Get(sfsClient, "v2").Extract()
+	if err != nil {
+		return "", fmt.Errorf("Unable to query shared file system API version: %s", err)
+	}
+
+	sharedFilesystemV2MaxMicroversions.Store(sfsClient.Endpoint, version.Version)
+
+	return version.Version, nil
+}
+
+// sharedFilesystemV2SupportsMicroversion reports whether the shared file
+// system API supports the required microversion.
+func sharedFilesystemV2SupportsMicroversion(sfsClient *gophercloud.ServiceClient, required string) (bool, error) {
+	maxVersion, err := sharedFilesystemV2MaxMicroversion(sfsClient)
+	if err != nil {
+		return false, err
+	}
+
+	return compatibleMicroversion("min", required, maxVersion)
+}
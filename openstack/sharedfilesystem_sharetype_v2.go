@@ -0,0 +1,139 @@
+package openstack
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// sharedFilesystemShareTypeV2DHSSExtraSpec is the required extra spec of a
+// share type, which is managed with the driver_handles_share_servers argument.
+const sharedFilesystemShareTypeV2DHSSExtraSpec = "driver_handles_share_servers"
+
+// sharedFilesystemShareTypeV2 represents a Manila share type including its
+// description, which is not exposed by gophercloud.
+type sharedFilesystemShareTypeV2 struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	IsPublic    bool              `json:"share_type_access:is_public"`
+	ExtraSpecs  map[string]string `json:"extra_specs"`
+}
+
+// sharedFilesystemShareTypeV2CreateOpts represents the attributes used when
+// creating a new share type.
+type sharedFilesystemShareTypeV2CreateOpts struct {
+	Name        string                 `json:"name" required:"true"`
+	Description string                 `json:"description,omitempty"`
+	IsPublic    bool                   `json:"share_type_access:is_public"`
+	ExtraSpecs  map[string]interface{} `json:"extra_specs" required:"true"`
+}
+
+// sharedFilesystemShareTypeV2UpdateOpts represents the attributes used when
+// updating an existing share type.
+type sharedFilesystemShareTypeV2UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	IsPublic    *bool   `json:"share_type_access:is_public,omitempty"`
+}
+
+type sharedFilesystemShareTypeV2Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets a sharedFilesystemShareTypeV2Result as a share type.
+func (r sharedFilesystemShareTypeV2Result) Extract() (*sharedFilesystemShareTypeV2, error) {
+	var s struct {
+		ShareType *sharedFilesystemShareTypeV2 `json:"share_type"`
+	}
+	err := r.ExtractInto(&s)
+	return s.ShareType, err
+}
+
+func sharedFilesystemShareTypeV2Create(client *gophercloud.ServiceClient, opts sharedFilesystemShareTypeV2CreateOpts) (r sharedFilesystemShareTypeV2Result) {
+	b, err := gophercloud.BuildRequestBody(opts, "share_type")
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(client.ServiceURL("types"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func sharedFilesystemShareTypeV2Get(client *gophercloud.ServiceClient, id string) (r sharedFilesystemShareTypeV2Result) {
+	resp, err := client.Get(client.ServiceURL("types", id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func sharedFilesystemShareTypeV2Update(client *gophercloud.ServiceClient, id string, opts sharedFilesystemShareTypeV2UpdateOpts) (r sharedFilesystemShareTypeV2Result) {
+	b, err := gophercloud.BuildRequestBody(opts, "share_type")
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Put(client.ServiceURL("types", id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// expandSharedFilesystemShareTypeV2ExtraSpecs returns the extra specs of a
+// share type including the required driver_handles_share_servers extra spec.
+func expandSharedFilesystemShareTypeV2ExtraSpecs(raw map[string]interface{}, dhss bool) map[string]interface{} {
+	extraSpecs := make(map[string]interface{}, len(raw)+1)
+	for k, v := range raw {
+		extraSpecs[k] = v.(string)
+	}
+
+	extraSpecs[sharedFilesystemShareTypeV2DHSSExtraSpec] = strconv.FormatBool(dhss)
+
+	return extraSpecs
+}
+
+// flattenSharedFilesystemShareTypeV2ExtraSpecs splits the extra specs of a
+// share type into the driver_handles_share_servers extra spec and the rest.
+func flattenSharedFilesystemShareTypeV2ExtraSpecs(extraSpecs map[string]string) (bool, map[string]string, error) {
+	var dhss bool
+	res := make(map[string]string, len(extraSpecs))
+
+	for k, v := range extraSpecs {
+		if k != sharedFilesystemShareTypeV2DHSSExtraSpec {
+			res[k] = v
+			continue
+		}
+
+		var err error
+		dhss, err = strconv.ParseBool(strings.TrimSpace(v))
+		if err != nil {
+			return false, nil, fmt.Errorf("Invalid %s extra spec value %q: %s", k, v, err)
+		}
+	}
+
+	return dhss, res, nil
+}
+
+// validateSharedFilesystemShareTypeV2ExtraSpecs ensures that the required
+// extra spec is not set in the extra_specs map.
+func validateSharedFilesystemShareTypeV2ExtraSpecs(v interface{}, k string) (ws []string, errs []error) {
+	if _, ok := v.(map[string]interface{})[sharedFilesystemShareTypeV2DHSSExtraSpec]; ok {
+		errs = append(errs, fmt.Errorf("%s must be set with the driver_handles_share_servers argument instead of %s", sharedFilesystemShareTypeV2DHSSExtraSpec, k))
+	}
+
+	return
+}
+
+func parseSharedFilesystemShareTypeAccessV2ID(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("Unable to determine openstack_sharedfilesystem_sharetype_access_v2 ID from raw ID: %s", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
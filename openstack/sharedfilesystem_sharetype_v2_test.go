@@ -0,0 +1,39 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandSharedFilesystemShareTypeV2ExtraSpecs(t *testing.T) {
+	raw := map[string]interface{}{
+		"snapshot_support": "True",
+		"replication_type": "readable",
+	}
+
+	expected := map[string]interface{}{
+		"driver_handles_share_servers": "false",
+		"snapshot_support":             "True",
+		"replication_type":             "readable",
+	}
+
+	assert.Equal(t, expected, expandSharedFilesystemShareTypeV2ExtraSpecs(raw, false))
+}
+
+func TestFlattenSharedFilesystemShareTypeV2ExtraSpecs(t *testing.T) {
+	extraSpecs := map[string]string{
+		"driver_handles_share_servers": "True",
+		"snapshot_support":             "True",
+	}
+
+	dhss, actual, err := flattenSharedFilesystemShareTypeV2ExtraSpecs(extraSpecs)
+	assert.NoError(t, err)
+	assert.True(t, dhss)
+	assert.Equal(t, map[string]string{"snapshot_support": "True"}, actual)
+
+	_, _, err = flattenSharedFilesystemShareTypeV2ExtraSpecs(map[string]string{
+		"driver_handles_share_servers": "foo",
+	})
+	assert.Error(t, err)
+}
@@ -1,6 +1,9 @@
 package openstack
 
 import (
+	"encoding/base64"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/subnetpools"
@@ -44,6 +47,29 @@ type IKEPolicyCreateOpts struct {
 	ValueSpecs map[string]string `json:"value_specs,omitempty"`
 }
 
+// IKEPolicyUpdateOpts represents the attributes used when updating an IKE policy.
+type IKEPolicyUpdateOpts struct {
+	ikepolicies.UpdateOpts
+}
+
+// ToPolicyUpdateMap casts an UpdateOpts struct to a map.
+// It overrides ikepolicies.ToPolicyUpdateMap to send the phase1_negotiation_mode
+// key expected by Neutron.
+func (opts IKEPolicyUpdateOpts) ToPolicyUpdateMap() (map[string]interface{}, error) {
+	b, err := opts.UpdateOpts.ToPolicyUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	m := b["ikepolicy"].(map[string]interface{})
+	if v, ok := m["phase_1_negotiation_mode"]; ok {
+		delete(m, "phase_1_negotiation_mode")
+		m["phase1_negotiation_mode"] = v
+	}
+
+	return b, nil
+}
+
 // IKEPolicyLifetimeCreateOpts represents the attributes used when creating a new lifetime for an IKE policy.
 type IKEPolicyLifetimeCreateOpts struct {
 	ikepolicies.LifetimeCreateOpts
@@ -124,3 +150,35 @@ type SiteConnectionCreateOpts struct {
 	siteconnections.CreateOpts
 	ValueSpecs map[string]string `json:"value_specs,omitempty"`
 }
+
+// ServerRebuildOpts represents the attributes used when rebuilding a server.
+type ServerRebuildOpts struct {
+	servers.RebuildOpts
+	UserData []byte `json:"-"`
+}
+
+// ToServerRebuildMap casts a RebuildOpts struct to a map.
+// It overrides servers.ToServerRebuildMap to add the user_data field, which
+// requires compute API microversion 2.57. Empty user data removes it from
+// the server.
+func (opts ServerRebuildOpts) ToServerRebuildMap() (map[string]interface{}, error) {
+	b, err := opts.RebuildOpts.ToServerRebuildMap()
+	if err != nil {
+		return nil, err
+	}
+
+	m := b["rebuild"].(map[string]interface{})
+	if len(opts.UserData) == 0 {
+		m["user_data"] = nil
+		return b, nil
+	}
+
+	// Like servers.CreateOpts, only encode user data, which isn't base64 yet.
+	if _, err := base64.StdEncoding.DecodeString(string(opts.UserData)); err != nil {
+		m["user_data"] = base64.StdEncoding.EncodeToString(opts.UserData)
+	} else {
+		m["user_data"] = string(opts.UserData)
+	}
+
+	return b, nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -135,14 +136,59 @@ func expandObjectReadTags(d *schema.ResourceData, tags []string) {
 	}
 }
 
-func expandObjectUpdateTags(d *schema.ResourceData) []string {
+func expandObjectUpdateTags(d *schema.ResourceData, defaultTags []string) []string {
 	allTags := d.Get("all_tags").(*schema.Set)
 	oldTagsRaw, newTagsRaw := d.GetChange("tags")
 	oldTags, newTags := oldTagsRaw.(*schema.Set), newTagsRaw.(*schema.Set)
 
 	allTagsWithoutOld := allTags.Difference(oldTags)
 
-	return expandToStringSlice(allTagsWithoutOld.Union(newTags).List())
+	// A new tag in the "key=value" format replaces the existing tag with the
+	// same key, e.g. a default tag of the provider.
+	newKeys := make(map[string]bool, newTags.Len())
+	for _, tag := range newTags.List() {
+		newKeys[expandObjectTagKey(tag.(string))] = true
+	}
+	for _, tag := range allTagsWithoutOld.List() {
+		if newKeys[expandObjectTagKey(tag.(string))] {
+			allTagsWithoutOld.Remove(tag)
+		}
+	}
+
+	return expandObjectDefaultTags(defaultTags, expandToStringSlice(allTagsWithoutOld.Union(newTags).List()))
+}
+
+// expandObjectCreateTags returns the configured tags merged with the default
+// tags of the provider.
+func expandObjectCreateTags(d *schema.ResourceData, defaultTags []string) []string {
+	return expandObjectDefaultTags(defaultTags, expandObjectTags(d))
+}
+
+// expandObjectDefaultTags adds the default tags to the tags. A default tag in
+// the "key=value" format is skipped, when the tags already contain the key.
+func expandObjectDefaultTags(defaultTags []string, tags []string) []string {
+	keys := make(map[string]struct{}, len(tags))
+	for _, tag := range tags {
+		keys[expandObjectTagKey(tag)] = struct{}{}
+	}
+
+	for _, tag := range defaultTags {
+		if _, ok := keys[expandObjectTagKey(tag)]; ok {
+			continue
+		}
+		keys[expandObjectTagKey(tag)] = struct{}{}
+		tags = append(tags, tag)
+	}
+
+	return tags
+}
+
+func expandObjectTagKey(tag string) string {
+	if i := strings.Index(tag, "="); i >= 0 {
+		return tag[:i]
+	}
+
+	return tag
 }
 
 func expandObjectTags(d *schema.ResourceData) []string {
@@ -309,3 +355,35 @@ func mapDiffWithNilValues(oldMap, newMap map[string]interface{}) (output map[str
 
 	return
 }
+
+// runTasks runs the tasks concurrently, but not more than concurrency at once.
+// It waits for all tasks and returns the error of the first failed task in the
+// order of the tasks.
+func runTasks(tasks []func() error, concurrency int) error {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	errs := make([]error, len(tasks))
+	sem := make(chan struct{}, concurrency)
+
+	var wg sync.WaitGroup
+	for i, task := range tasks {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, task func() error) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			errs[i] = task()
+		}(i, task)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
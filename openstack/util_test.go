@@ -1,8 +1,14 @@
 package openstack
 
 import (
+	"fmt"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -81,3 +87,85 @@ func TestMapDiffWithNilValues(t *testing.T) {
 	assert.Equal(t, result["c"], "3")
 	assert.Equal(t, len(result), 3)
 }
+
+func TestExpandObjectDefaultTags(t *testing.T) {
+	defaultTags := []string{"cost-center=1234", "env=prod", "managed-by-terraform"}
+
+	actual := expandObjectDefaultTags(defaultTags, []string{"env=dev", "web"})
+	assert.Equal(t, []string{"env=dev", "web", "cost-center=1234", "managed-by-terraform"}, actual)
+
+	actual = expandObjectDefaultTags(defaultTags, []string{"managed-by-terraform"})
+	assert.Equal(t, []string{"managed-by-terraform", "cost-center=1234", "env=prod"}, actual)
+
+	actual = expandObjectDefaultTags(nil, []string{"web"})
+	assert.Equal(t, []string{"web"}, actual)
+}
+
+func TestRunTasks(t *testing.T) {
+	var running, maxRunning int32
+	var mu sync.Mutex
+	var done []int
+
+	tasks := make([]func() error, 10)
+	for i := range tasks {
+		i := i
+		tasks[i] = func() error {
+			n := atomic.AddInt32(&running, 1)
+			defer atomic.AddInt32(&running, -1)
+
+			mu.Lock()
+			if n > maxRunning {
+				maxRunning = n
+			}
+			done = append(done, i)
+			mu.Unlock()
+
+			time.Sleep(10 * time.Millisecond)
+
+			if i == 3 || i == 7 {
+				return fmt.Errorf("task %d failed", i)
+			}
+			return nil
+		}
+	}
+
+	err := runTasks(tasks, 3)
+
+	assert.EqualError(t, err, "task 3 failed")
+	assert.Len(t, done, 10)
+	assert.LessOrEqual(t, maxRunning, int32(3))
+	assert.NoError(t, runTasks(nil, 3))
+	assert.NoError(t, runTasks(tasks[:1], 0))
+}
+
+func TestExpandObjectUpdateTags(t *testing.T) {
+	r := resourceNetworkingNetworkV2()
+
+	state := &terraform.InstanceState{
+		ID: "network-1",
+		Attributes: map[string]string{
+			"tags.#":     "1",
+			"all_tags.#": "4",
+		},
+	}
+	state.Attributes[fmt.Sprintf("tags.%d", schema.HashString("web"))] = "web"
+	for _, tag := range []string{"web", "env=prod", "cost-center=1234", "external"} {
+		state.Attributes[fmt.Sprintf("all_tags.%d", schema.HashString(tag))] = tag
+	}
+
+	diff := &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"tags.#": {Old: "1", New: "2"},
+			fmt.Sprintf("tags.%d", schema.HashString("web")):     {Old: "web", New: "", NewRemoved: true},
+			fmt.Sprintf("tags.%d", schema.HashString("db")):      {Old: "", New: "db"},
+			fmt.Sprintf("tags.%d", schema.HashString("env=dev")): {Old: "", New: "env=dev"},
+		},
+	}
+
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+	assert.NoError(t, err)
+
+	defaultTags := []string{"cost-center=1234", "env=prod"}
+	expected := []string{"cost-center=1234", "db", "env=dev", "external"}
+	assert.ElementsMatch(t, expected, expandObjectUpdateTags(d, defaultTags))
+}
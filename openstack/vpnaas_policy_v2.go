@@ -0,0 +1,26 @@
+package openstack
+
+// vpnaasPolicyV2AuthAlgorithms contains the authentication algorithms
+// supported by the IKE and IPsec policies.
+var vpnaasPolicyV2AuthAlgorithms = []string{
+	"sha1", "sha256", "sha384", "sha512",
+}
+
+// vpnaasPolicyV2EncryptionAlgorithms contains the encryption algorithms
+// supported by the IKE and IPsec policies.
+var vpnaasPolicyV2EncryptionAlgorithms = []string{
+	"3des", "aes-128", "aes-192", "aes-256",
+	"aes-128-ctr", "aes-192-ctr", "aes-256-ctr",
+	"aes-128-ccm-8", "aes-192-ccm-8", "aes-256-ccm-8",
+	"aes-128-ccm-12", "aes-192-ccm-12", "aes-256-ccm-12",
+	"aes-128-ccm-16", "aes-192-ccm-16", "aes-256-ccm-16",
+	"aes-128-gcm-8", "aes-192-gcm-8", "aes-256-gcm-8",
+	"aes-128-gcm-12", "aes-192-gcm-12", "aes-256-gcm-12",
+	"aes-128-gcm-16", "aes-192-gcm-16", "aes-256-gcm-16",
+}
+
+// vpnaasIKEPolicyV2Phase1NegotiationModes contains the phase1 negotiation
+// modes supported by the IKE policies.
+var vpnaasIKEPolicyV2Phase1NegotiationModes = []string{
+	"main", "aggressive",
+}